@@ -7,6 +7,7 @@ import (
 
 	"github.com/justtrackio/gosoline/pkg/appctx"
 	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/db"
 	"github.com/justtrackio/gosoline/pkg/kernel"
 	"github.com/justtrackio/gosoline/pkg/log"
 )
@@ -119,10 +120,13 @@ func NewWithInterfaces(ctx context.Context, config cfg.GosoConf, logger log.Goso
 		}
 	}
 
-	kernelOptions := make([]kernel.Option, len(app.kernelOptions))
+	// the read replicas of the db clients are health checked whenever they are configured
+	kernelOptions := []kernel.Option{
+		kernel.WithModuleMultiFactory(db.ReplicaPoolModuleFactory),
+	}
 
 	for i := 0; i < len(app.kernelOptions); i++ {
-		kernelOptions[i] = app.kernelOptions[i](config)
+		kernelOptions = append(kernelOptions, app.kernelOptions[i](config))
 	}
 
 	return kernel.BuildKernel(ctx, config, logger, kernelOptions)
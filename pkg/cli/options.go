@@ -2,7 +2,9 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/justtrackio/gosoline/pkg/application"
@@ -14,6 +16,8 @@ import (
 type (
 	// Option configures a Cli before it parses input and runs the selected command.
 	Option func(cli *Cli)
+	// DocumentWriter writes a document describing the application, like the openapi document of an http server.
+	DocumentWriter func(ctx context.Context, config cfg.Config, logger log.Logger, writer io.Writer) error
 )
 
 // WithAppOptions adds gosoline application options that apply to every command.
@@ -89,3 +93,50 @@ func WithVersion(version string) Option {
 		})
 	}
 }
+
+// WithOpenApi registers a built-in openapi command that writes the openapi document to stdout or to the file given by
+// --output. Use httpserver.NewOpenApiDocumentWriter to write the document of the routes of an http server.
+func WithOpenApi(write DocumentWriter) Option {
+	type openApiFlags struct {
+		Output string `cfg:"output"`
+	}
+
+	return func(cli *Cli) {
+		cli.Cmd(Cmd{
+			Name:        "openapi",
+			Description: "Write the openapi document of the http server.",
+			Examples: []CmdExample{
+				{Description: "Write the document to a file", Args: "--output openapi.json"},
+			},
+			Flags: []Flag{
+				{Short: "o", Long: "output", Description: "file to write the document to instead of stdout"},
+			},
+			AppOptions: []application.Option{
+				application.WithModuleFactory("main", WithRunFunc(func(ctx context.Context, config cfg.Config, logger log.Logger) (kernelPkg.ModuleRunFunc, error) {
+					flags, err := UnmarshalFlags[openApiFlags](config)
+					if err != nil {
+						return nil, err
+					}
+
+					return func(ctx context.Context) error {
+						if flags.Output == "" {
+							return write(ctx, config, logger, os.Stdout)
+						}
+
+						file, err := os.Create(flags.Output)
+						if err != nil {
+							return fmt.Errorf("can not create file %s: %w", flags.Output, err)
+						}
+						err = write(ctx, config, logger, file)
+
+						if closeErr := file.Close(); closeErr != nil {
+							err = errors.Join(err, fmt.Errorf("can not close file %s: %w", flags.Output, closeErr))
+						}
+
+						return err
+					}, nil
+				})),
+			},
+		})
+	}
+}
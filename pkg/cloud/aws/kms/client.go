@@ -0,0 +1,78 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awsCfg "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	gosoAws "github.com/justtrackio/gosoline/pkg/cloud/aws"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+//go:generate go run github.com/vektra/mockery/v2 --name Client
+type Client interface {
+	Decrypt(ctx context.Context, params *kms.DecryptInput, optFns ...func(*kms.Options)) (*kms.DecryptOutput, error)
+	GenerateDataKey(ctx context.Context, params *kms.GenerateDataKeyInput, optFns ...func(*kms.Options)) (*kms.GenerateDataKeyOutput, error)
+}
+
+type ClientSettings struct {
+	gosoAws.ClientSettings
+}
+
+type ClientConfig struct {
+	Settings    ClientSettings
+	LoadOptions []func(options *awsCfg.LoadOptions) error
+}
+
+func (c ClientConfig) GetSettings() gosoAws.ClientSettings {
+	return c.Settings.ClientSettings
+}
+
+func (c ClientConfig) GetLoadOptions() []func(options *awsCfg.LoadOptions) error {
+	return c.LoadOptions
+}
+
+func (c ClientConfig) GetRetryOptions() []func(*retry.StandardOptions) {
+	return nil
+}
+
+type ClientOption func(cfg *ClientConfig)
+
+type clientAppCtxKey string
+
+func ProvideClient(ctx context.Context, config cfg.Config, logger log.Logger, name string, optFns ...ClientOption) (*kms.Client, error) {
+	return appctx.Provide(ctx, clientAppCtxKey(name), func() (*kms.Client, error) {
+		return NewClient(ctx, config, logger, name, optFns...)
+	})
+}
+
+func NewClient(ctx context.Context, config cfg.Config, logger log.Logger, name string, optFns ...ClientOption) (*kms.Client, error) {
+	clientCfg := &ClientConfig{}
+	if err := gosoAws.UnmarshalClientSettings(config, &clientCfg.Settings, "kms", name); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal KMS client settings: %w", err)
+	}
+
+	for _, opt := range optFns {
+		opt(clientCfg)
+	}
+
+	var err error
+	var awsConfig aws.Config
+
+	if awsConfig, err = gosoAws.DefaultClientConfig(ctx, config, logger, clientCfg); err != nil {
+		return nil, fmt.Errorf("can not initialize config: %w", err)
+	}
+
+	client := kms.NewFromConfig(awsConfig, func(options *kms.Options) {
+		options.BaseEndpoint = gosoAws.NilIfEmpty(clientCfg.Settings.Endpoint)
+	})
+
+	gosoAws.LogNewClientCreated(ctx, logger, "kms", name, clientCfg.Settings.ClientSettings)
+
+	return client, nil
+}
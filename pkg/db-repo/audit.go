@@ -0,0 +1,411 @@
+package db_repo
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/justtrackio/gosoline/pkg/subject"
+)
+
+const (
+	AuditAttributeModelId = "modelId"
+	AuditAttributeType    = "type"
+)
+
+type AuditSettings struct {
+	TableName   string                 `cfg:"table_name" default:"audit_log"`
+	Output      string                 `cfg:"output"`
+	Encoding    stream.EncodingType    `cfg:"encoding" default:"application/json"`
+	Compression stream.CompressionType `cfg:"compression" default:"none"`
+}
+
+// AuditChange is the value of a field before and after a change. Before is nil for created models, after is nil for
+// deleted ones.
+type AuditChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+type AuditChanges map[string]AuditChange
+
+// AuditRecord describes who created, updated, deleted or restored a model and which fields got changed. Without an output
+// configured, the records are inserted in the transaction of the change into a table which has to be created by a
+// migration, e.g. for mysql:
+//
+//	CREATE TABLE audit_log (
+//	    id               BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+//	    model_id         VARCHAR(255) NOT NULL,
+//	    entity_id        BIGINT UNSIGNED NOT NULL,
+//	    type             VARCHAR(32) NOT NULL,
+//	    subject          VARCHAR(255) NOT NULL,
+//	    authenticated_by VARCHAR(255) NOT NULL,
+//	    changes          MEDIUMTEXT NOT NULL,
+//	    created_at       DATETIME NOT NULL,
+//	    INDEX idx_model_entity (model_id, entity_id)
+//	);
+type AuditRecord struct {
+	Id              *uint           `gorm:"primary_key;AUTO_INCREMENT" db:"id" json:"-"`
+	ModelId         string          `db:"model_id" json:"modelId"`
+	EntityId        uint            `db:"entity_id" json:"entityId"`
+	Type            string          `db:"type" json:"type"`
+	Subject         string          `db:"subject" json:"subject"`
+	AuthenticatedBy string          `db:"authenticated_by" json:"authenticatedBy"`
+	Changes         json.RawMessage `db:"changes" json:"changes"`
+	CreatedAt       *time.Time      `db:"created_at" json:"createdAt"`
+}
+
+type auditRepository struct {
+	Repository
+
+	base      TransactionalRepository
+	logger    log.Logger
+	clock     clock.Clock
+	output    stream.Output
+	encoder   stream.MessageEncoder
+	modelId   mdl.ModelId
+	tableName string
+}
+
+// NewAuditRepository decorates the base repository to record every Create, Update, Delete and Restore together with the
+// subject.Subject of the context. The records are inserted into the audit table or, if configured, written to the
+// stream output after the transaction of the change got committed.
+func NewAuditRepository(ctx context.Context, config cfg.Config, logger log.Logger, base TransactionalRepository, name string) (*auditRepository, error) {
+	var err error
+	var settings *AuditSettings
+	var output stream.Output
+
+	if settings, err = ReadAuditSettings(config, name); err != nil {
+		return nil, err
+	}
+
+	if settings.Output != "" {
+		if output, _, err = stream.NewConfigurableOutput(ctx, config, logger, settings.Output); err != nil {
+			return nil, fmt.Errorf("can not create output %s for audit %s: %w", settings.Output, name, err)
+		}
+	}
+
+	encoder := stream.NewMessageEncoder(&stream.MessageEncoderSettings{
+		Encoding:    settings.Encoding,
+		Compression: settings.Compression,
+	})
+
+	return NewAuditRepositoryWithInterfaces(logger, base, clock.Provider, output, encoder, settings.TableName), nil
+}
+
+// NewAuditRepositoryWithInterfaces writes the records to the output or, if it is nil, into the table with the given name.
+func NewAuditRepositoryWithInterfaces(
+	logger log.Logger,
+	base TransactionalRepository,
+	clock clock.Clock,
+	output stream.Output,
+	encoder stream.MessageEncoder,
+	tableName string,
+) *auditRepository {
+	return &auditRepository{
+		Repository: base,
+		base:       base,
+		logger:     logger,
+		clock:      clock,
+		output:     output,
+		encoder:    encoder,
+		modelId:    base.GetMetadata().ModelId,
+		tableName:  tableName,
+	}
+}
+
+func (r *auditRepository) Create(ctx context.Context, value ModelBased) error {
+	return r.audit(ctx, Create, value, func(ctx context.Context, repo Repository) error {
+		return repo.Create(ctx, value)
+	})
+}
+
+func (r *auditRepository) Update(ctx context.Context, value ModelBased) error {
+	return r.audit(ctx, Update, value, func(ctx context.Context, repo Repository) error {
+		return repo.Update(ctx, value)
+	})
+}
+
+func (r *auditRepository) Delete(ctx context.Context, value ModelBased) error {
+	return r.audit(ctx, Delete, value, func(ctx context.Context, repo Repository) error {
+		return repo.Delete(ctx, value)
+	})
+}
+
+func (r *auditRepository) Restore(ctx context.Context, value ModelBased) error {
+	return r.audit(ctx, Restore, value, func(ctx context.Context, repo Repository) error {
+		softDeleteRepo, ok := repo.(SoftDeleteRepository)
+		if !ok {
+			return fmt.Errorf("can not restore model %s as the repository of type %T is not able to restore models", r.modelId, repo)
+		}
+
+		return softDeleteRepo.Restore(ctx, value)
+	})
+}
+
+// Transaction runs the do function in a transaction of the base repository. The changes done with the repository
+// handed to the do function are audited in the same transaction. Records for the output are written after the
+// transaction got committed. If the context carries a transaction of another repository already, it is joined and
+// the records for the output are written once the do function returns, as the commit is out of reach.
+func (r *auditRepository) Transaction(ctx context.Context, do func(ctx context.Context, repo Repository, tx *gorm.DB) error) error {
+	return r.transaction(ctx, func(ctx context.Context, repo Repository, tx *gorm.DB) error {
+		return do(ctx, r.withRepository(repo), tx)
+	})
+}
+
+// transaction runs the do function in a transaction of the base repository and writes the records collected for the
+// output after the transaction got committed. If the context carries a transaction of another repository already, it
+// is joined and the records are written once the do function returns, as the commit is out of reach.
+func (r *auditRepository) transaction(ctx context.Context, do func(ctx context.Context, repo Repository, tx *gorm.DB) error) error {
+	if _, ok := auditPendingFromContext(ctx); ok {
+		return r.base.Transaction(ctx, do)
+	}
+
+	pending := &auditPending{}
+	ctx = context.WithValue(ctx, auditPendingCtxKey{}, pending)
+
+	if err := r.base.Transaction(ctx, do); err != nil {
+		return err
+	}
+
+	// the changes are committed already, so there is no way to undo them if a record can't be written
+	for _, record := range pending.records {
+		if err := record.writer.writeRecord(ctx, record.record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// withRepository returns a copy of the audit repository which operates on the repository of a transaction.
+func (r *auditRepository) withRepository(repo Repository) *auditRepository {
+	txRepo := *r
+	txRepo.Repository = repo
+
+	return &txRepo
+}
+
+func (r *auditRepository) audit(ctx context.Context, typ string, value ModelBased, change func(ctx context.Context, repo Repository) error) error {
+	return r.transaction(ctx, func(ctx context.Context, repo Repository, tx *gorm.DB) error {
+		var err error
+		var record *AuditRecord
+		var before, after ModelBased
+
+		switch typ {
+		case Create:
+		case Restore:
+			if before, err = r.readDeleted(ctx, repo, value); err != nil {
+				return err
+			}
+		default:
+			if before, err = r.readBefore(ctx, repo, value); err != nil {
+				return err
+			}
+		}
+
+		if err = change(ctx, repo); err != nil {
+			return err
+		}
+
+		if typ != Delete {
+			after = value
+		}
+
+		if record, err = r.newRecord(ctx, typ, mdl.EmptyIfNil(value.GetId()), before, after); err != nil {
+			return err
+		}
+
+		if pending, ok := auditPendingFromContext(ctx); ok && r.output != nil {
+			// the transaction might have been opened by another audit repository, so each record is written by its own one
+			pending.records = append(pending.records, auditPendingRecord{
+				writer: r,
+				record: record,
+			})
+
+			return nil
+		}
+
+		if err = tx.Table(r.tableName).Create(record).Error; err != nil {
+			return fmt.Errorf("can not insert %s audit record for model %s with id %d into %s: %w", typ, r.modelId, record.EntityId, r.tableName, err)
+		}
+
+		return nil
+	})
+}
+
+func (r *auditRepository) writeRecord(ctx context.Context, record *AuditRecord) error {
+	msg, err := r.encoder.Encode(ctx, record, map[string]string{
+		AuditAttributeModelId: record.ModelId,
+		AuditAttributeType:    record.Type,
+	})
+	if err != nil {
+		return fmt.Errorf("can not encode %s audit record for model %s with id %d: %w", record.Type, r.modelId, record.EntityId, err)
+	}
+
+	if err = r.output.WriteOne(ctx, msg); err != nil {
+		return fmt.Errorf("can not write %s audit record for model %s with id %d: %w", record.Type, r.modelId, record.EntityId, err)
+	}
+
+	return nil
+}
+
+func (r *auditRepository) readBefore(ctx context.Context, repo Repository, value ModelBased) (ModelBased, error) {
+	before, ok := reflect.New(reflect.TypeOf(value).Elem()).Interface().(ModelBased)
+	if !ok {
+		return nil, fmt.Errorf("can not create a model of type %T", value)
+	}
+
+	if err := repo.Read(ctx, value.GetId(), before); err != nil {
+		return nil, fmt.Errorf("can not read model %s with id %d before the change: %w", r.modelId, mdl.EmptyIfNil(value.GetId()), err)
+	}
+
+	return before, nil
+}
+
+// readDeleted reads a soft deleted model, which is excluded by Read.
+func (r *auditRepository) readDeleted(ctx context.Context, repo Repository, value ModelBased) (ModelBased, error) {
+	id := mdl.EmptyIfNil(value.GetId())
+	models := reflect.New(reflect.SliceOf(reflect.TypeOf(value)))
+	qb := NewQueryBuilder()
+	qb.Where("id = ?", id)
+
+	if err := repo.Query(ctx, qb.IncludeDeleted(), models.Interface()); err != nil {
+		return nil, fmt.Errorf("can not read model %s with id %d before the restore: %w", r.modelId, id, err)
+	}
+
+	if models.Elem().Len() == 0 {
+		return nil, NewRecordNotFoundError(id, r.modelId.String(), gorm.ErrRecordNotFound)
+	}
+
+	before, ok := models.Elem().Index(0).Interface().(ModelBased)
+	if !ok {
+		return nil, fmt.Errorf("can not create a model of type %T", value)
+	}
+
+	return before, nil
+}
+
+func (r *auditRepository) newRecord(ctx context.Context, typ string, id uint, before ModelBased, after ModelBased) (*AuditRecord, error) {
+	var err error
+	var changes AuditChanges
+	var encodedChanges []byte
+
+	if changes, err = diffModels(before, after); err != nil {
+		return nil, fmt.Errorf("can not diff model %s with id %d: %w", r.modelId, id, err)
+	}
+
+	if encodedChanges, err = json.Marshal(changes); err != nil {
+		return nil, fmt.Errorf("can not marshal changes of model %s with id %d: %w", r.modelId, id, err)
+	}
+
+	now := r.clock.Now()
+	record := &AuditRecord{
+		ModelId:   r.modelId.String(),
+		EntityId:  id,
+		Type:      typ,
+		Changes:   encodedChanges,
+		CreatedAt: &now,
+	}
+
+	if sub, ok := subject.LookupSubject(ctx); ok {
+		record.Subject = sub.Name
+		record.AuthenticatedBy = sub.AuthenticatedBy
+	}
+
+	return record, nil
+}
+
+// diffModels compares the json representations of the models and returns the fields with a different value.
+func diffModels(before ModelBased, after ModelBased) (AuditChanges, error) {
+	var err error
+	var beforeFields, afterFields map[string]any
+
+	if beforeFields, err = modelFields(before); err != nil {
+		return nil, err
+	}
+
+	if afterFields, err = modelFields(after); err != nil {
+		return nil, err
+	}
+
+	changes := AuditChanges{}
+
+	for field, value := range beforeFields {
+		if afterValue, ok := afterFields[field]; !ok || !reflect.DeepEqual(value, afterValue) {
+			changes[field] = AuditChange{
+				Before: value,
+				After:  afterFields[field],
+			}
+		}
+	}
+
+	for field, value := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			changes[field] = AuditChange{
+				After: value,
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+func modelFields(model ModelBased) (map[string]any, error) {
+	fields := map[string]any{}
+
+	if model == nil {
+		return fields, nil
+	}
+
+	encoded, err := json.Marshal(model)
+	if err != nil {
+		return nil, fmt.Errorf("can not marshal model: %w", err)
+	}
+
+	if err = json.Unmarshal(encoded, &fields); err != nil {
+		return nil, fmt.Errorf("can not unmarshal model: %w", err)
+	}
+
+	return fields, nil
+}
+
+type auditPendingRecord struct {
+	writer *auditRepository
+	record *AuditRecord
+}
+
+type auditPending struct {
+	records []auditPendingRecord
+}
+
+type auditPendingCtxKey struct{}
+
+func auditPendingFromContext(ctx context.Context) (*auditPending, bool) {
+	pending, ok := ctx.Value(auditPendingCtxKey{}).(*auditPending)
+
+	return pending, ok
+}
+
+func AuditSettingsKey(name string) string {
+	return fmt.Sprintf("db_repo.audit.%s", name)
+}
+
+func ReadAuditSettings(config cfg.Config, name string) (*AuditSettings, error) {
+	key := AuditSettingsKey(name)
+
+	settings := &AuditSettings{}
+	if err := config.UnmarshalKey(key, settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal audit settings for key %q: %w", key, err)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,316 @@
+package db_repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	goSqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/db-repo"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	streamMocks "github.com/justtrackio/gosoline/pkg/stream/mocks"
+	"github.com/justtrackio/gosoline/pkg/subject"
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type auditTestModel struct {
+	db_repo.Model
+	Name string
+}
+
+func (m *auditTestModel) TableName() string {
+	return "my_test_models"
+}
+
+type AuditRepositoryTestSuite struct {
+	suite.Suite
+
+	now    time.Time
+	dbMock goSqlMock.Sqlmock
+	base   db_repo.TransactionalRepository
+	output *streamMocks.Output
+	repo   db_repo.Repository
+}
+
+func TestAuditRepository(t *testing.T) {
+	suite.Run(t, new(AuditRepositoryTestSuite))
+}
+
+func (s *AuditRepositoryTestSuite) SetupTest() {
+	s.now = time.Unix(1549964818, 0).UTC()
+
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	tracer := tracing.NewLocalTracer()
+	testClock := clock.NewFakeClockAt(s.now)
+
+	sqlDb, dbMock, err := goSqlMock.New()
+	s.NoError(err)
+
+	orm, err := db_repo.NewOrmWithInterfaces(sqlDb, db_repo.OrmSettings{
+		Driver: "mysql",
+	})
+	s.NoError(err)
+
+	encoder := stream.NewMessageEncoder(&stream.MessageEncoderSettings{})
+
+	s.dbMock = dbMock
+	s.base = db_repo.NewWithInterfaces(logger, tracer, orm, testClock, MyTestModelMetadata)
+	s.output = streamMocks.NewOutput(s.T())
+	s.repo = db_repo.NewAuditRepositoryWithInterfaces(logger, s.base, testClock, nil, encoder, "audit_log")
+}
+
+func (s *AuditRepositoryTestSuite) TestCreate() {
+	ctx := subject.ContextWithSubject(s.T().Context(), &subject.Subject{
+		Name:            "jane",
+		AuthenticatedBy: "apiKey",
+	})
+
+	s.dbMock.ExpectBegin()
+	s.dbMock.ExpectExec("INSERT INTO `my_test_models` \\(`id`,`updated_at`,`created_at`,`name`\\) VALUES \\(\\?,\\?,\\?,\\?\\)").
+		WithArgs(id1, &s.now, &s.now, "foo").
+		WillReturnResult(goSqlMock.NewResult(1, 1))
+
+	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at", "name"}).AddRow(id1, &s.now, &s.now, "foo")
+	s.dbMock.ExpectQuery("SELECT \\* FROM `my_test_models`").WithArgs(id1).WillReturnRows(rows)
+
+	s.dbMock.ExpectExec("INSERT INTO `audit_log` \\(`model_id`,`entity_id`,`type`,`subject`,`authenticated_by`,`changes`,`created_at`\\) VALUES \\(\\?,\\?,\\?,\\?,\\?,\\?,\\?\\)").
+		WithArgs(
+			MyTestModelMetadata.ModelId.String(),
+			*id1,
+			db_repo.Create,
+			"jane",
+			"apiKey",
+			[]byte(`{"CreatedAt":{"before":null,"after":"2019-02-12T09:46:58Z"},"Id":{"before":null,"after":1},"Name":{"before":null,"after":"foo"},"UpdatedAt":{"before":null,"after":"2019-02-12T09:46:58Z"}}`),
+			&s.now,
+		).
+		WillReturnResult(goSqlMock.NewResult(1, 1))
+	s.dbMock.ExpectCommit()
+
+	model := &auditTestModel{
+		Model: db_repo.Model{
+			Id: id1,
+		},
+		Name: "foo",
+	}
+
+	err := s.repo.Create(ctx, model)
+	s.NoError(err)
+	s.NoError(s.dbMock.ExpectationsWereMet())
+}
+
+func (s *AuditRepositoryTestSuite) TestUpdate() {
+	createdAt := s.now.Add(-time.Hour)
+
+	s.dbMock.ExpectBegin()
+
+	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at", "name"}).AddRow(id1, &createdAt, &createdAt, "foo")
+	s.dbMock.ExpectQuery("SELECT \\* FROM `my_test_models`").WillReturnRows(rows)
+
+	s.dbMock.ExpectExec("UPDATE `my_test_models`").
+		WithArgs(goSqlMock.AnyArg(), &createdAt, "bar", id1).
+		WillReturnResult(goSqlMock.NewResult(0, 1))
+
+	rows = goSqlMock.NewRows([]string{"id", "updated_at", "created_at", "name"}).AddRow(id1, &s.now, &createdAt, "bar")
+	s.dbMock.ExpectQuery("SELECT \\* FROM `my_test_models`").WithArgs(id1).WillReturnRows(rows)
+
+	s.dbMock.ExpectExec("INSERT INTO `audit_log`").
+		WithArgs(
+			MyTestModelMetadata.ModelId.String(),
+			*id1,
+			db_repo.Update,
+			"",
+			"",
+			[]byte(`{"Name":{"before":"foo","after":"bar"},"UpdatedAt":{"before":"2019-02-12T08:46:58Z","after":"2019-02-12T09:46:58Z"}}`),
+			&s.now,
+		).
+		WillReturnResult(goSqlMock.NewResult(1, 1))
+	s.dbMock.ExpectCommit()
+
+	model := &auditTestModel{
+		Model: db_repo.Model{
+			Id: id1,
+			Timestamps: db_repo.Timestamps{
+				CreatedAt: &createdAt,
+			},
+		},
+		Name: "bar",
+	}
+
+	err := s.repo.Update(s.T().Context(), model)
+	s.NoError(err)
+	s.NoError(s.dbMock.ExpectationsWereMet())
+}
+
+func (s *AuditRepositoryTestSuite) TestDeleteToOutput() {
+	encoder := stream.NewMessageEncoder(&stream.MessageEncoderSettings{})
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	repo := db_repo.NewAuditRepositoryWithInterfaces(logger, s.base, clock.NewFakeClockAt(s.now), s.output, encoder, "audit_log")
+
+	s.dbMock.ExpectBegin()
+
+	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at", "name"}).AddRow(id1, &s.now, &s.now, "foo")
+	s.dbMock.ExpectQuery("SELECT \\* FROM `my_test_models`").WillReturnRows(rows)
+
+	s.dbMock.ExpectExec("DELETE FROM `my_test_models`").WithArgs(id1).WillReturnResult(goSqlMock.NewResult(0, 1))
+	s.dbMock.ExpectCommit()
+
+	s.output.EXPECT().WriteOne(mock.Anything, &stream.Message{
+		Attributes: map[string]string{
+			"encoding":                    "application/json",
+			db_repo.AuditAttributeModelId: MyTestModelMetadata.ModelId.String(),
+			db_repo.AuditAttributeType:    db_repo.Delete,
+		},
+		Body: `{"modelId":"` + MyTestModelMetadata.ModelId.String() + `","entityId":1,"type":"delete","subject":"","authenticatedBy":"",` +
+			`"changes":{"CreatedAt":{"before":"2019-02-12T09:46:58Z","after":null},"Id":{"before":1,"after":null},"Name":{"before":"foo","after":null},"UpdatedAt":{"before":"2019-02-12T09:46:58Z","after":null}},` +
+			`"createdAt":"2019-02-12T09:46:58Z"}`,
+	}).Return(nil).Once()
+
+	model := &auditTestModel{
+		Model: db_repo.Model{
+			Id: id1,
+		},
+	}
+
+	err := repo.Delete(s.T().Context(), model)
+	s.NoError(err)
+	s.NoError(s.dbMock.ExpectationsWereMet())
+}
+
+func (s *AuditRepositoryTestSuite) TestDeleteRollbackOnAuditFailure() {
+	s.dbMock.ExpectBegin()
+
+	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at", "name"}).AddRow(id1, &s.now, &s.now, "foo")
+	s.dbMock.ExpectQuery("SELECT \\* FROM `my_test_models`").WillReturnRows(rows)
+
+	s.dbMock.ExpectExec("DELETE FROM `my_test_models`").WithArgs(id1).WillReturnResult(goSqlMock.NewResult(0, 1))
+	s.dbMock.ExpectExec("INSERT INTO `audit_log`").
+		WithArgs(goSqlMock.AnyArg(), goSqlMock.AnyArg(), goSqlMock.AnyArg(), goSqlMock.AnyArg(), goSqlMock.AnyArg(), goSqlMock.AnyArg(), goSqlMock.AnyArg()).
+		WillReturnError(assert.AnError)
+	s.dbMock.ExpectRollback()
+
+	model := &auditTestModel{
+		Model: db_repo.Model{
+			Id: id1,
+		},
+	}
+
+	err := s.repo.Delete(s.T().Context(), model)
+	s.ErrorIs(err, assert.AnError)
+	s.NoError(s.dbMock.ExpectationsWereMet())
+}
+
+func (s *AuditRepositoryTestSuite) TestRestore() {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	sqlDb, dbMock, err := goSqlMock.New()
+	s.NoError(err)
+
+	orm, err := db_repo.NewOrmWithInterfaces(sqlDb, db_repo.OrmSettings{
+		Driver: "mysql",
+	})
+	s.NoError(err)
+
+	testClock := clock.NewFakeClockAt(s.now)
+	encoder := stream.NewMessageEncoder(&stream.MessageEncoderSettings{})
+	base := db_repo.NewWithInterfaces(logger, tracing.NewLocalTracer(), orm, testClock, SoftDeleteModelMetadata)
+	repo := db_repo.NewAuditRepositoryWithInterfaces(logger, base, testClock, nil, encoder, "audit_log")
+
+	dbMock.ExpectBegin()
+
+	rows := goSqlMock.NewRows([]string{"id", "deleted_at"}).AddRow(id1, &s.now)
+	dbMock.ExpectQuery("SELECT \\* FROM `soft_delete_models` WHERE \\(id = \\?\\)").WithArgs(*id1).WillReturnRows(rows)
+
+	dbMock.ExpectExec("UPDATE `soft_delete_models` SET `deleted_at` = \\?").WithArgs(nil, id1).WillReturnResult(goSqlMock.NewResult(0, 1))
+
+	rows = goSqlMock.NewRows([]string{"id", "deleted_at"}).AddRow(id1, nil)
+	dbMock.ExpectQuery("SELECT \\* FROM `soft_delete_models`  WHERE `soft_delete_models`\\.`deleted_at` IS NULL").WithArgs(id1).WillReturnRows(rows)
+
+	dbMock.ExpectExec("INSERT INTO `audit_log`").
+		WithArgs(
+			SoftDeleteModelMetadata.ModelId.String(),
+			*id1,
+			db_repo.Restore,
+			"",
+			"",
+			[]byte(`{"DeletedAt":{"before":"2019-02-12T09:46:58Z","after":null}}`),
+			&s.now,
+		).
+		WillReturnResult(goSqlMock.NewResult(1, 1))
+	dbMock.ExpectCommit()
+
+	model := &SoftDeleteModel{
+		Model: db_repo.Model{
+			Id: id1,
+		},
+	}
+
+	err = repo.Restore(s.T().Context(), model)
+	s.NoError(err)
+	s.Nil(model.DeletedAt)
+	s.NoError(dbMock.ExpectationsWereMet())
+}
+
+func (s *AuditRepositoryTestSuite) TestTransactionToOutput() {
+	encoder := stream.NewMessageEncoder(&stream.MessageEncoderSettings{})
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	repo := db_repo.NewAuditRepositoryWithInterfaces(logger, s.base, clock.NewFakeClockAt(s.now), s.output, encoder, "audit_log")
+
+	s.dbMock.ExpectBegin()
+
+	for _, id := range []*uint{id1, id42} {
+		rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at", "name"}).AddRow(id, &s.now, &s.now, "foo")
+		s.dbMock.ExpectQuery("SELECT \\* FROM `my_test_models`").WillReturnRows(rows)
+		s.dbMock.ExpectExec("DELETE FROM `my_test_models`").WithArgs(id).WillReturnResult(goSqlMock.NewResult(0, 1))
+	}
+
+	s.dbMock.ExpectCommit()
+
+	written := 0
+	s.output.EXPECT().WriteOne(mock.Anything, mock.AnythingOfType("*stream.Message")).Run(func(_ context.Context, _ stream.WritableMessage) {
+		// the records are written after the commit only
+		s.NoError(s.dbMock.ExpectationsWereMet())
+		written++
+	}).Return(nil).Twice()
+
+	err := repo.Transaction(s.T().Context(), func(ctx context.Context, txRepo db_repo.Repository, _ *gorm.DB) error {
+		for _, id := range []*uint{id1, id42} {
+			if err := txRepo.Delete(ctx, &auditTestModel{Model: db_repo.Model{Id: id}}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	s.NoError(err)
+	s.Equal(2, written)
+}
+
+func (s *AuditRepositoryTestSuite) TestTransactionWithNestedOutput() {
+	encoder := stream.NewMessageEncoder(&stream.MessageEncoderSettings{})
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	outputRepo := db_repo.NewAuditRepositoryWithInterfaces(logger, s.base, clock.NewFakeClockAt(s.now), s.output, encoder, "audit_log")
+
+	s.dbMock.ExpectBegin()
+	s.dbMock.ExpectExec("INSERT INTO `my_test_models`").WithArgs(id1, &s.now, &s.now, "foo").WillReturnResult(goSqlMock.NewResult(1, 1))
+
+	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at", "name"}).AddRow(id1, &s.now, &s.now, "foo")
+	s.dbMock.ExpectQuery("SELECT \\* FROM `my_test_models`").WithArgs(id1).WillReturnRows(rows)
+	s.dbMock.ExpectCommit()
+
+	// the record of the nested repository is written to its output even though the transaction was opened by a
+	// repository writing its records into the table
+	s.output.EXPECT().WriteOne(mock.Anything, mock.MatchedBy(func(msg *stream.Message) bool {
+		return msg.Attributes[db_repo.AuditAttributeType] == db_repo.Create
+	})).Return(nil).Once()
+
+	err := s.repo.(db_repo.TransactionalRepository).Transaction(s.T().Context(), func(ctx context.Context, _ db_repo.Repository, _ *gorm.DB) error {
+		return outputRepo.Create(ctx, &auditTestModel{Model: db_repo.Model{Id: id1}, Name: "foo"})
+	})
+	s.NoError(err)
+	s.NoError(s.dbMock.ExpectationsWereMet())
+}
@@ -0,0 +1,95 @@
+package db_repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/fixtures"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type postgresOrmFixtureWriter struct {
+	logger   log.Logger
+	metadata *Metadata
+	repo     Repository
+}
+
+func PostgresOrmFixtureSetFactory[T any](metadata *Metadata, data fixtures.NamedFixtures[T], options ...fixtures.FixtureSetOption) fixtures.FixtureSetFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (fixtures.FixtureSet, error) {
+		var err error
+		var writer fixtures.FixtureWriter
+
+		if writer, err = NewPostgresOrmFixtureWriter(ctx, config, logger, metadata); err != nil {
+			return nil, fmt.Errorf("failed to create postgres orm fixture writer for %s: %w", metadata.ModelId.String(), err)
+		}
+
+		return fixtures.NewSimpleFixtureSet(data, writer, options...), nil
+	}
+}
+
+func NewPostgresOrmFixtureWriter(ctx context.Context, config cfg.Config, logger log.Logger, metadata *Metadata) (fixtures.FixtureWriter, error) {
+	if err := metadata.ModelId.PadFromConfig(config); err != nil {
+		return nil, fmt.Errorf("can not pad model id from config: %w", err)
+	}
+
+	identity, err := cfg.GetAppIdentity(config)
+	if err != nil {
+		return nil, fmt.Errorf("can not get app identity from config: %w", err)
+	}
+
+	repoSettings := Settings{
+		Identity:   identity,
+		Metadata:   *metadata,
+		ClientName: "default",
+	}
+
+	var dbSettings *db.Settings
+	var repo *repository
+
+	if dbSettings, err = db.ReadSettings(config, "default"); err != nil {
+		return nil, fmt.Errorf("can not create repo: %w", err)
+	}
+
+	if dbSettings.Parameters == nil {
+		dbSettings.Parameters = make(map[string]string)
+	}
+
+	// disables the foreign key triggers for the fixture connection, requires a superuser
+	dbSettings.Parameters["session_replication_role"] = "replica"
+
+	if repo, err = NewWithDbSettings(ctx, config, logger, dbSettings, repoSettings); err != nil {
+		return nil, fmt.Errorf("can not create repo: %w", err)
+	}
+
+	return NewPostgresOrmFixtureWriterWithInterfaces(logger, metadata, repo), nil
+}
+
+func NewPostgresOrmFixtureWriterWithInterfaces(logger log.Logger, metadata *Metadata, repo Repository) fixtures.FixtureWriter {
+	return &postgresOrmFixtureWriter{
+		logger:   logger,
+		metadata: metadata,
+		repo:     repo,
+	}
+}
+
+func (m *postgresOrmFixtureWriter) Write(ctx context.Context, fixtures []any) error {
+	var ok bool
+	var model ModelBased
+
+	for _, item := range fixtures {
+		if model, ok = item.(ModelBased); !ok {
+			return fmt.Errorf("assertion failed: %T is not db_repo.ModelBased", item)
+		}
+
+		err := m.repo.Update(ctx, model)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.logger.Info(ctx, "loaded %d postgres fixtures", len(fixtures))
+
+	return nil
+}
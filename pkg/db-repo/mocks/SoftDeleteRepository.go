@@ -0,0 +1,514 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	db_repo "github.com/justtrackio/gosoline/pkg/db-repo"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SoftDeleteRepository is an autogenerated mock type for the SoftDeleteRepository type
+type SoftDeleteRepository struct {
+	mock.Mock
+}
+
+type SoftDeleteRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SoftDeleteRepository) EXPECT() *SoftDeleteRepository_Expecter {
+	return &SoftDeleteRepository_Expecter{mock: &_m.Mock}
+}
+
+// Count provides a mock function with given fields: ctx, qb, model
+func (_m *SoftDeleteRepository) Count(ctx context.Context, qb *db_repo.QueryBuilder, model db_repo.ModelBased) (int, error) {
+	ret := _m.Called(ctx, qb, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *db_repo.QueryBuilder, db_repo.ModelBased) (int, error)); ok {
+		return rf(ctx, qb, model)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *db_repo.QueryBuilder, db_repo.ModelBased) int); ok {
+		r0 = rf(ctx, qb, model)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *db_repo.QueryBuilder, db_repo.ModelBased) error); ok {
+		r1 = rf(ctx, qb, model)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDeleteRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type SoftDeleteRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qb *db_repo.QueryBuilder
+//   - model db_repo.ModelBased
+func (_e *SoftDeleteRepository_Expecter) Count(ctx interface{}, qb interface{}, model interface{}) *SoftDeleteRepository_Count_Call {
+	return &SoftDeleteRepository_Count_Call{Call: _e.mock.On("Count", ctx, qb, model)}
+}
+
+func (_c *SoftDeleteRepository_Count_Call) Run(run func(ctx context.Context, qb *db_repo.QueryBuilder, model db_repo.ModelBased)) *SoftDeleteRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*db_repo.QueryBuilder), args[2].(db_repo.ModelBased))
+	})
+	return _c
+}
+
+func (_c *SoftDeleteRepository_Count_Call) Return(_a0 int, _a1 error) *SoftDeleteRepository_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SoftDeleteRepository_Count_Call) RunAndReturn(run func(context.Context, *db_repo.QueryBuilder, db_repo.ModelBased) (int, error)) *SoftDeleteRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, value
+func (_m *SoftDeleteRepository) Create(ctx context.Context, value db_repo.ModelBased) error {
+	ret := _m.Called(ctx, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db_repo.ModelBased) error); ok {
+		r0 = rf(ctx, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftDeleteRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SoftDeleteRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - value db_repo.ModelBased
+func (_e *SoftDeleteRepository_Expecter) Create(ctx interface{}, value interface{}) *SoftDeleteRepository_Create_Call {
+	return &SoftDeleteRepository_Create_Call{Call: _e.mock.On("Create", ctx, value)}
+}
+
+func (_c *SoftDeleteRepository_Create_Call) Run(run func(ctx context.Context, value db_repo.ModelBased)) *SoftDeleteRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db_repo.ModelBased))
+	})
+	return _c
+}
+
+func (_c *SoftDeleteRepository_Create_Call) Return(_a0 error) *SoftDeleteRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SoftDeleteRepository_Create_Call) RunAndReturn(run func(context.Context, db_repo.ModelBased) error) *SoftDeleteRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, value
+func (_m *SoftDeleteRepository) Delete(ctx context.Context, value db_repo.ModelBased) error {
+	ret := _m.Called(ctx, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db_repo.ModelBased) error); ok {
+		r0 = rf(ctx, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftDeleteRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type SoftDeleteRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - value db_repo.ModelBased
+func (_e *SoftDeleteRepository_Expecter) Delete(ctx interface{}, value interface{}) *SoftDeleteRepository_Delete_Call {
+	return &SoftDeleteRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, value)}
+}
+
+func (_c *SoftDeleteRepository_Delete_Call) Run(run func(ctx context.Context, value db_repo.ModelBased)) *SoftDeleteRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db_repo.ModelBased))
+	})
+	return _c
+}
+
+func (_c *SoftDeleteRepository_Delete_Call) Return(_a0 error) *SoftDeleteRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SoftDeleteRepository_Delete_Call) RunAndReturn(run func(context.Context, db_repo.ModelBased) error) *SoftDeleteRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetadata provides a mock function with no fields
+func (_m *SoftDeleteRepository) GetMetadata() db_repo.Metadata {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetadata")
+	}
+
+	var r0 db_repo.Metadata
+	if rf, ok := ret.Get(0).(func() db_repo.Metadata); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(db_repo.Metadata)
+	}
+
+	return r0
+}
+
+// SoftDeleteRepository_GetMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetadata'
+type SoftDeleteRepository_GetMetadata_Call struct {
+	*mock.Call
+}
+
+// GetMetadata is a helper method to define mock.On call
+func (_e *SoftDeleteRepository_Expecter) GetMetadata() *SoftDeleteRepository_GetMetadata_Call {
+	return &SoftDeleteRepository_GetMetadata_Call{Call: _e.mock.On("GetMetadata")}
+}
+
+func (_c *SoftDeleteRepository_GetMetadata_Call) Run(run func()) *SoftDeleteRepository_GetMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SoftDeleteRepository_GetMetadata_Call) Return(_a0 db_repo.Metadata) *SoftDeleteRepository_GetMetadata_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SoftDeleteRepository_GetMetadata_Call) RunAndReturn(run func() db_repo.Metadata) *SoftDeleteRepository_GetMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetModelId provides a mock function with no fields
+func (_m *SoftDeleteRepository) GetModelId() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetModelId")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// SoftDeleteRepository_GetModelId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetModelId'
+type SoftDeleteRepository_GetModelId_Call struct {
+	*mock.Call
+}
+
+// GetModelId is a helper method to define mock.On call
+func (_e *SoftDeleteRepository_Expecter) GetModelId() *SoftDeleteRepository_GetModelId_Call {
+	return &SoftDeleteRepository_GetModelId_Call{Call: _e.mock.On("GetModelId")}
+}
+
+func (_c *SoftDeleteRepository_GetModelId_Call) Run(run func()) *SoftDeleteRepository_GetModelId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SoftDeleteRepository_GetModelId_Call) Return(_a0 string) *SoftDeleteRepository_GetModelId_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SoftDeleteRepository_GetModelId_Call) RunAndReturn(run func() string) *SoftDeleteRepository_GetModelId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetModelName provides a mock function with no fields
+func (_m *SoftDeleteRepository) GetModelName() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetModelName")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// SoftDeleteRepository_GetModelName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetModelName'
+type SoftDeleteRepository_GetModelName_Call struct {
+	*mock.Call
+}
+
+// GetModelName is a helper method to define mock.On call
+func (_e *SoftDeleteRepository_Expecter) GetModelName() *SoftDeleteRepository_GetModelName_Call {
+	return &SoftDeleteRepository_GetModelName_Call{Call: _e.mock.On("GetModelName")}
+}
+
+func (_c *SoftDeleteRepository_GetModelName_Call) Run(run func()) *SoftDeleteRepository_GetModelName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SoftDeleteRepository_GetModelName_Call) Return(_a0 string) *SoftDeleteRepository_GetModelName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SoftDeleteRepository_GetModelName_Call) RunAndReturn(run func() string) *SoftDeleteRepository_GetModelName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Query provides a mock function with given fields: ctx, qb, result
+func (_m *SoftDeleteRepository) Query(ctx context.Context, qb *db_repo.QueryBuilder, result interface{}) error {
+	ret := _m.Called(ctx, qb, result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *db_repo.QueryBuilder, interface{}) error); ok {
+		r0 = rf(ctx, qb, result)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftDeleteRepository_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type SoftDeleteRepository_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qb *db_repo.QueryBuilder
+//   - result interface{}
+func (_e *SoftDeleteRepository_Expecter) Query(ctx interface{}, qb interface{}, result interface{}) *SoftDeleteRepository_Query_Call {
+	return &SoftDeleteRepository_Query_Call{Call: _e.mock.On("Query", ctx, qb, result)}
+}
+
+func (_c *SoftDeleteRepository_Query_Call) Run(run func(ctx context.Context, qb *db_repo.QueryBuilder, result interface{})) *SoftDeleteRepository_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*db_repo.QueryBuilder), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *SoftDeleteRepository_Query_Call) Return(_a0 error) *SoftDeleteRepository_Query_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SoftDeleteRepository_Query_Call) RunAndReturn(run func(context.Context, *db_repo.QueryBuilder, interface{}) error) *SoftDeleteRepository_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Read provides a mock function with given fields: ctx, id, out
+func (_m *SoftDeleteRepository) Read(ctx context.Context, id *uint, out db_repo.ModelBased) error {
+	ret := _m.Called(ctx, id, out)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Read")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uint, db_repo.ModelBased) error); ok {
+		r0 = rf(ctx, id, out)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftDeleteRepository_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type SoftDeleteRepository_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id *uint
+//   - out db_repo.ModelBased
+func (_e *SoftDeleteRepository_Expecter) Read(ctx interface{}, id interface{}, out interface{}) *SoftDeleteRepository_Read_Call {
+	return &SoftDeleteRepository_Read_Call{Call: _e.mock.On("Read", ctx, id, out)}
+}
+
+func (_c *SoftDeleteRepository_Read_Call) Run(run func(ctx context.Context, id *uint, out db_repo.ModelBased)) *SoftDeleteRepository_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*uint), args[2].(db_repo.ModelBased))
+	})
+	return _c
+}
+
+func (_c *SoftDeleteRepository_Read_Call) Return(_a0 error) *SoftDeleteRepository_Read_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SoftDeleteRepository_Read_Call) RunAndReturn(run func(context.Context, *uint, db_repo.ModelBased) error) *SoftDeleteRepository_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, value
+func (_m *SoftDeleteRepository) Restore(ctx context.Context, value db_repo.ModelBased) error {
+	ret := _m.Called(ctx, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db_repo.ModelBased) error); ok {
+		r0 = rf(ctx, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftDeleteRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type SoftDeleteRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - value db_repo.ModelBased
+func (_e *SoftDeleteRepository_Expecter) Restore(ctx interface{}, value interface{}) *SoftDeleteRepository_Restore_Call {
+	return &SoftDeleteRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, value)}
+}
+
+func (_c *SoftDeleteRepository_Restore_Call) Run(run func(ctx context.Context, value db_repo.ModelBased)) *SoftDeleteRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db_repo.ModelBased))
+	})
+	return _c
+}
+
+func (_c *SoftDeleteRepository_Restore_Call) Return(_a0 error) *SoftDeleteRepository_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SoftDeleteRepository_Restore_Call) RunAndReturn(run func(context.Context, db_repo.ModelBased) error) *SoftDeleteRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, value
+func (_m *SoftDeleteRepository) Update(ctx context.Context, value db_repo.ModelBased) error {
+	ret := _m.Called(ctx, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db_repo.ModelBased) error); ok {
+		r0 = rf(ctx, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SoftDeleteRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type SoftDeleteRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - value db_repo.ModelBased
+func (_e *SoftDeleteRepository_Expecter) Update(ctx interface{}, value interface{}) *SoftDeleteRepository_Update_Call {
+	return &SoftDeleteRepository_Update_Call{Call: _e.mock.On("Update", ctx, value)}
+}
+
+func (_c *SoftDeleteRepository_Update_Call) Run(run func(ctx context.Context, value db_repo.ModelBased)) *SoftDeleteRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db_repo.ModelBased))
+	})
+	return _c
+}
+
+func (_c *SoftDeleteRepository_Update_Call) Return(_a0 error) *SoftDeleteRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SoftDeleteRepository_Update_Call) RunAndReturn(run func(context.Context, db_repo.ModelBased) error) *SoftDeleteRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSoftDeleteRepository creates a new instance of SoftDeleteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSoftDeleteRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SoftDeleteRepository {
+	mock := &SoftDeleteRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -13,6 +13,13 @@ import (
 	"github.com/justtrackio/gosoline/pkg/log"
 )
 
+func init() {
+	// gorm knows the sqlite dialect only by the name of the cgo driver
+	if dialect, ok := gorm.GetDialect("sqlite3"); ok {
+		gorm.RegisterDialect(db.DriverSqlite, dialect)
+	}
+}
+
 type OrmMigrationSetting struct {
 	TablePrefixed bool `cfg:"table_prefixed" default:"true"`
 }
@@ -24,8 +31,6 @@ type OrmSettings struct {
 }
 
 func NewOrm(ctx context.Context, config cfg.Config, logger log.Logger, dbClientName string) (*gorm.DB, error) {
-	var err error
-
 	if dbClientName == "" {
 		dbClientName = "default"
 	}
@@ -35,6 +40,25 @@ func NewOrm(ctx context.Context, config cfg.Config, logger log.Logger, dbClientN
 		return nil, fmt.Errorf("can not create db connection : %w", err)
 	}
 
+	return newOrmWithClient(config, dbClientName, client)
+}
+
+// NewReplicaOrm creates an orm which executes its reads on the read replicas of the client. It is meant for read only
+// access, as it doesn't guarantee to read the own writes.
+func NewReplicaOrm(ctx context.Context, config cfg.Config, logger log.Logger, dbClientName string) (*gorm.DB, error) {
+	if dbClientName == "" {
+		dbClientName = "default"
+	}
+
+	client, err := NewOrmReplicaClient(ctx, config, logger, dbClientName)
+	if err != nil {
+		return nil, fmt.Errorf("can not create db connection : %w", err)
+	}
+
+	return newOrmWithClient(config, dbClientName, client)
+}
+
+func newOrmWithClient(config cfg.Config, dbClientName string, client *OrmClient) (*gorm.DB, error) {
 	settings := OrmSettings{}
 	key := fmt.Sprintf("db.%s", dbClientName)
 	if err := config.UnmarshalKey(key, &settings); err != nil {
@@ -76,6 +100,8 @@ func NewOrmWithInterfaces(dbClient gorm.SQLCommon, settings OrmSettings) (*gorm.
 		return clock.Provider.Now()
 	})
 
+	registerVersionCallbacks(orm)
+
 	if !settings.Migrations.TablePrefixed {
 		return orm, nil
 	}
@@ -10,7 +10,10 @@ import (
 	"github.com/justtrackio/gosoline/pkg/log"
 )
 
+// OrmClient adapts a db.Client to the interface gorm needs. As gorm doesn't pass a context, every query is executed
+// with the context of the client: the default client reads from the primary, a replica client from the read replicas.
 type OrmClient struct {
+	ctx    context.Context
 	client db.Client
 }
 
@@ -34,24 +37,59 @@ func NewOrmClientWithSettings(ctx context.Context, config cfg.Config, logger log
 	return clientGorm, nil
 }
 
+func NewOrmReplicaClient(ctx context.Context, config cfg.Config, logger log.Logger, name string) (*OrmClient, error) {
+	client, err := db.NewClient(ctx, config, logger, name)
+	if err != nil {
+		return nil, fmt.Errorf("can not create client: %w", err)
+	}
+
+	return NewOrmReplicaClientWithInterfaces(client), nil
+}
+
 func NewOrmClientWithInterfaces(client db.Client) *OrmClient {
 	return &OrmClient{
+		ctx:    db.WithForcedPrimary(context.Background()),
+		client: client,
+	}
+}
+
+func NewOrmReplicaClientWithInterfaces(client db.Client) *OrmClient {
+	return &OrmClient{
+		ctx:    context.Background(),
 		client: client,
 	}
 }
 
 func (c *OrmClient) Exec(query string, args ...any) (sql.Result, error) {
-	return c.client.Exec(context.Background(), query, args...)
+	return c.client.Exec(c.ctx, query, args...)
 }
 
 func (c *OrmClient) Prepare(query string) (*sql.Stmt, error) {
-	return c.client.Prepare(context.Background(), query)
+	return c.client.Prepare(c.ctx, query)
 }
 
 func (c *OrmClient) Query(query string, args ...any) (*sql.Rows, error) {
-	return c.client.Query(context.Background(), query, args...)
+	return c.client.Query(c.ctx, query, args...)
 }
 
 func (c *OrmClient) QueryRow(query string, args ...any) *sql.Row {
-	return c.client.QueryRow(context.Background(), query, args...)
+	return c.client.QueryRow(c.ctx, query, args...)
+}
+
+func (c *OrmClient) Begin() (*sql.Tx, error) {
+	return c.BeginTx(c.ctx, &sql.TxOptions{})
+}
+
+func (c *OrmClient) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	beginner, ok := c.client.(db.TxBeginner)
+	if !ok {
+		return nil, fmt.Errorf("the db client %T does not support transactions", c.client)
+	}
+
+	tx, err := beginner.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Tx, nil
 }
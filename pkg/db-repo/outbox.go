@@ -0,0 +1,241 @@
+package db_repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/justtrackio/gosoline/pkg/stream"
+)
+
+const (
+	// the attribute names match the ones of the mdlsub publisher, so subscribers can't tell a relayed notification
+	// apart from a directly published one
+	OutboxAttributeModelId = "modelId"
+	OutboxAttributeType    = "type"
+	OutboxAttributeVersion = "version"
+)
+
+type OutboxSettings struct {
+	ClientName  string                 `cfg:"client_name" default:"default"`
+	TableName   string                 `cfg:"table_name" default:"outbox"`
+	Encoding    stream.EncodingType    `cfg:"encoding" default:"application/json"`
+	Compression stream.CompressionType `cfg:"compression" default:"none"`
+	Relay       OutboxRelaySettings    `cfg:"relay"`
+}
+
+type OutboxRelaySettings struct {
+	Enabled   bool          `cfg:"enabled" default:"true"`
+	Output    string        `cfg:"output"`
+	Interval  time.Duration `cfg:"interval" default:"1s"`
+	BatchSize int           `cfg:"batch_size" default:"100"`
+	Retention time.Duration `cfg:"retention" default:"24h"`
+}
+
+// OutboxRecord is a notification which got persisted in the same transaction as the change of the model it belongs to.
+// The table has to be created by a migration, e.g. for mysql:
+//
+//	CREATE TABLE outbox (
+//	    id          BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+//	    model_id    VARCHAR(255) NOT NULL,
+//	    entity_id   BIGINT UNSIGNED NOT NULL,
+//	    type        VARCHAR(32) NOT NULL,
+//	    body        MEDIUMTEXT NOT NULL,
+//	    attributes  TEXT NOT NULL,
+//	    created_at  DATETIME NOT NULL,
+//	    relayed_at  DATETIME NULL,
+//	    INDEX idx_relayed_at (relayed_at)
+//	);
+type OutboxRecord struct {
+	Id         *uint      `gorm:"primary_key;AUTO_INCREMENT" db:"id"`
+	ModelId    string     `db:"model_id"`
+	EntityId   uint       `db:"entity_id"`
+	Type       string     `db:"type"`
+	Body       string     `db:"body"`
+	Attributes string     `db:"attributes"`
+	CreatedAt  *time.Time `gorm:"type:datetime" db:"created_at"`
+	RelayedAt  *time.Time `gorm:"type:datetime" db:"relayed_at"`
+}
+
+type outboxRepository struct {
+	Repository
+
+	base        TransactionalRepository
+	logger      log.Logger
+	clock       clock.Clock
+	encoder     stream.MessageEncoder
+	modelId     mdl.ModelId
+	version     int
+	transformer mdl.TransformerResolver
+	tableName   string
+}
+
+// NewOutboxRepository decorates the base repository to insert a notification into the outbox table with the given name
+// inside the transaction of every Create, Update and Delete. The OutboxRelay takes care of writing those notifications
+// to the configured stream output afterward.
+func NewOutboxRepository(
+	_ context.Context,
+	config cfg.Config,
+	logger log.Logger,
+	base TransactionalRepository,
+	name string,
+	version int,
+	transformer mdl.TransformerResolver,
+) (*outboxRepository, error) {
+	settings, err := ReadOutboxSettings(config, name)
+	if err != nil {
+		return nil, err
+	}
+
+	encoder := stream.NewMessageEncoder(&stream.MessageEncoderSettings{
+		Encoding:    settings.Encoding,
+		Compression: settings.Compression,
+	})
+
+	return NewOutboxRepositoryWithInterfaces(logger, base, clock.Provider, encoder, version, transformer, settings.TableName), nil
+}
+
+func NewOutboxRepositoryWithInterfaces(
+	logger log.Logger,
+	base TransactionalRepository,
+	clock clock.Clock,
+	encoder stream.MessageEncoder,
+	version int,
+	transformer mdl.TransformerResolver,
+	tableName string,
+) *outboxRepository {
+	return &outboxRepository{
+		Repository:  base,
+		base:        base,
+		logger:      logger,
+		clock:       clock,
+		encoder:     encoder,
+		modelId:     base.GetMetadata().ModelId,
+		version:     version,
+		transformer: transformer,
+		tableName:   tableName,
+	}
+}
+
+// Transaction runs do inside a transaction of the base repository. Create, Update and Delete of the repository handed
+// to do join that transaction, so their notifications are committed or rolled back together with everything else.
+func (r *outboxRepository) Transaction(ctx context.Context, do func(ctx context.Context, repo Repository, tx *gorm.DB) error) error {
+	return r.base.Transaction(ctx, func(ctx context.Context, _ Repository, tx *gorm.DB) error {
+		return do(ctx, r, tx)
+	})
+}
+
+func (r *outboxRepository) Create(ctx context.Context, value ModelBased) error {
+	return r.base.Transaction(ctx, func(ctx context.Context, repo Repository, tx *gorm.DB) error {
+		if err := repo.Create(ctx, value); err != nil {
+			return err
+		}
+
+		return r.writeRecord(ctx, tx, Create, value)
+	})
+}
+
+func (r *outboxRepository) Update(ctx context.Context, value ModelBased) error {
+	return r.base.Transaction(ctx, func(ctx context.Context, repo Repository, tx *gorm.DB) error {
+		if err := repo.Update(ctx, value); err != nil {
+			return err
+		}
+
+		return r.writeRecord(ctx, tx, Update, value)
+	})
+}
+
+func (r *outboxRepository) Delete(ctx context.Context, value ModelBased) error {
+	return r.base.Transaction(ctx, func(ctx context.Context, repo Repository, tx *gorm.DB) error {
+		if err := repo.Delete(ctx, value); err != nil {
+			return err
+		}
+
+		return r.writeRecord(ctx, tx, Delete, value)
+	})
+}
+
+func (r *outboxRepository) writeRecord(ctx context.Context, tx *gorm.DB, typ string, value ModelBased) error {
+	var err error
+	var msg *stream.Message
+	var attributes []byte
+
+	out := r.transformer("api", r.version, value)
+
+	if msg, err = r.encoder.Encode(ctx, out, CreateOutboxAttributes(r.modelId, typ, r.version)); err != nil {
+		return fmt.Errorf("can not encode %s notification for model %s with id %d: %w", typ, r.modelId, mdl.EmptyIfNil(value.GetId()), err)
+	}
+
+	if attributes, err = json.Marshal(msg.Attributes); err != nil {
+		return fmt.Errorf("can not marshal attributes of %s notification for model %s: %w", typ, r.modelId, err)
+	}
+
+	now := r.clock.Now()
+	record := &OutboxRecord{
+		ModelId:    r.modelId.String(),
+		EntityId:   mdl.EmptyIfNil(value.GetId()),
+		Type:       typ,
+		Body:       msg.Body,
+		Attributes: string(attributes),
+		CreatedAt:  &now,
+	}
+
+	if err = tx.Table(r.tableName).Create(record).Error; err != nil {
+		return fmt.Errorf("can not insert %s notification for model %s with id %d into outbox %s: %w", typ, r.modelId, record.EntityId, r.tableName, err)
+	}
+
+	r.logger.Debug(ctx, "inserted %s notification for model %s with id %d into outbox %s", typ, r.modelId, record.EntityId, r.tableName)
+
+	return nil
+}
+
+func CreateOutboxAttributes(modelId mdl.ModelId, typ string, version int) map[string]string {
+	return map[string]string{
+		OutboxAttributeType:    typ,
+		OutboxAttributeVersion: strconv.Itoa(version),
+		OutboxAttributeModelId: modelId.String(),
+	}
+}
+
+func OutboxSettingsKey(name string) string {
+	return fmt.Sprintf("db_repo.outbox.%s", name)
+}
+
+func ReadOutboxSettings(config cfg.Config, name string) (*OutboxSettings, error) {
+	key := OutboxSettingsKey(name)
+
+	settings := &OutboxSettings{}
+	if err := config.UnmarshalKey(key, settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal outbox settings for key %q: %w", key, err)
+	}
+
+	if settings.Relay.Output == "" {
+		settings.Relay.Output = name
+	}
+
+	return settings, nil
+}
+
+func readAllOutboxSettings(config cfg.Config) (map[string]*OutboxSettings, error) {
+	outboxes, err := config.GetStringMap("db_repo.outbox", map[string]any{})
+	if err != nil {
+		return nil, fmt.Errorf("can not read outbox settings: %w", err)
+	}
+
+	allSettings := make(map[string]*OutboxSettings, len(outboxes))
+
+	for name := range outboxes {
+		if allSettings[name], err = ReadOutboxSettings(config, name); err != nil {
+			return nil, err
+		}
+	}
+
+	return allSettings, nil
+}
@@ -0,0 +1,242 @@
+package db_repo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"github.com/jmoiron/sqlx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/kernel"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/stream"
+)
+
+// OutboxRelay reads pending notifications from an outbox table and writes them to a stream output. The rows of a
+// batch are locked until they got written and marked as relayed. Locked rows are skipped on mysql and postgres, so
+// several instances of an application relay different batches at the same time. Sqlite locks the whole database for
+// writes instead, so the instances relay one after another. As the output is written before the transaction commits,
+// a notification might be written more than once, but never gets lost.
+type OutboxRelay struct {
+	kernel.BackgroundModule
+	kernel.ServiceStage
+
+	logger   log.Logger
+	client   db.Client
+	output   stream.Output
+	clock    clock.Clock
+	name     string
+	table    string
+	bindType int
+	rowLock  string
+	settings *OutboxSettings
+}
+
+func OutboxRelayModuleFactory(_ context.Context, config cfg.Config, _ log.Logger) (map[string]kernel.ModuleFactory, error) {
+	modules := map[string]kernel.ModuleFactory{}
+
+	outboxSettings, err := readAllOutboxSettings(config)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, settings := range outboxSettings {
+		if !settings.Relay.Enabled {
+			continue
+		}
+
+		moduleName := fmt.Sprintf("outbox-relay-%s", name)
+		modules[moduleName] = NewOutboxRelayModule(name)
+	}
+
+	return modules, nil
+}
+
+func NewOutboxRelayModule(name string) kernel.ModuleFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (kernel.Module, error) {
+		var err error
+		var settings *OutboxSettings
+		var dbSettings *db.Settings
+		var client db.Client
+		var output stream.Output
+
+		logger = logger.WithChannel(fmt.Sprintf("outbox-relay-%s", name))
+
+		if settings, err = ReadOutboxSettings(config, name); err != nil {
+			return nil, err
+		}
+
+		if dbSettings, err = db.ReadSettings(config, settings.ClientName); err != nil {
+			return nil, err
+		}
+
+		if client, err = db.ProvideClient(ctx, config, logger, settings.ClientName); err != nil {
+			return nil, fmt.Errorf("can not create db client %s: %w", settings.ClientName, err)
+		}
+
+		if output, _, err = stream.NewConfigurableOutput(ctx, config, logger, settings.Relay.Output); err != nil {
+			return nil, fmt.Errorf("can not create output %s: %w", settings.Relay.Output, err)
+		}
+
+		return NewOutboxRelayWithInterfaces(logger, client, output, clock.Provider, name, dbSettings.Driver, settings), nil
+	}
+}
+
+// NewOutboxRelayWithInterfaces quotes the name of the outbox table, binds the query parameters and locks the rows of a
+// batch the way the given db driver expects it.
+func NewOutboxRelayWithInterfaces(
+	logger log.Logger,
+	client db.Client,
+	output stream.Output,
+	clock clock.Clock,
+	name string,
+	driver string,
+	settings *OutboxSettings,
+) *OutboxRelay {
+	return &OutboxRelay{
+		logger:   logger,
+		client:   client,
+		output:   output,
+		clock:    clock,
+		name:     name,
+		table:    quoteTableName(driver, settings.TableName),
+		bindType: sqlx.BindType(driver),
+		rowLock:  outboxRowLock(driver),
+		settings: settings,
+	}
+}
+
+func (r *OutboxRelay) Run(ctx context.Context) error {
+	ticker := r.clock.NewTicker(r.settings.Relay.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// relay everything which got inserted while the application was shutting down
+			return r.tick(context.WithoutCancel(ctx))
+
+		case <-ticker.Chan():
+			if err := r.tick(ctx); err != nil && !exec.IsRequestCanceled(err) {
+				r.logger.Error(ctx, "can not relay outbox %s: %w", r.name, err)
+			}
+		}
+	}
+}
+
+func (r *OutboxRelay) tick(ctx context.Context) error {
+	if err := r.Relay(ctx); err != nil {
+		return err
+	}
+
+	return r.Cleanup(ctx)
+}
+
+// Relay writes pending notifications in batches to the output until there are no more pending notifications.
+func (r *OutboxRelay) Relay(ctx context.Context) error {
+	for {
+		relayed, err := r.relayBatch(ctx)
+		if err != nil {
+			return err
+		}
+
+		if relayed < r.settings.Relay.BatchSize {
+			return nil
+		}
+	}
+}
+
+func (r *OutboxRelay) relayBatch(ctx context.Context) (relayed int, err error) {
+	err = r.client.WithTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx *sqlx.Tx) error {
+		records := make([]OutboxRecord, 0, r.settings.Relay.BatchSize)
+		selectQry := fmt.Sprintf("SELECT * FROM %s WHERE relayed_at IS NULL ORDER BY id ASC LIMIT ?%s", r.table, r.rowLock)
+		selectQry = sqlx.Rebind(r.bindType, selectQry)
+
+		if err := tx.SelectContext(ctx, &records, selectQry, r.settings.Relay.BatchSize); err != nil {
+			return fmt.Errorf("can not select pending records: %w", err)
+		}
+
+		if len(records) == 0 {
+			return nil
+		}
+
+		ids := make([]uint, len(records))
+		messages := make([]stream.WritableMessage, len(records))
+
+		for i, record := range records {
+			attributes := make(map[string]string)
+
+			if err := json.Unmarshal([]byte(record.Attributes), &attributes); err != nil {
+				return fmt.Errorf("can not unmarshal attributes of record %d: %w", *record.Id, err)
+			}
+
+			ids[i] = *record.Id
+			messages[i] = stream.NewMessage(record.Body, attributes)
+		}
+
+		if err := r.output.Write(ctx, messages); err != nil {
+			return fmt.Errorf("can not write %d records to output %s: %w", len(messages), r.settings.Relay.Output, err)
+		}
+
+		updateQry, args, err := sqlx.In(fmt.Sprintf("UPDATE %s SET relayed_at = ? WHERE id IN (?)", r.table), r.clock.Now(), ids)
+		if err != nil {
+			return fmt.Errorf("can not build update query: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, sqlx.Rebind(r.bindType, updateQry), args...); err != nil {
+			return fmt.Errorf("can not mark records as relayed: %w", err)
+		}
+
+		relayed = len(records)
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("can not relay batch: %w", err)
+	}
+
+	if relayed > 0 {
+		r.logger.Info(ctx, "relayed %d records from outbox %s", relayed, r.name)
+	}
+
+	return relayed, nil
+}
+
+// Cleanup removes the notifications which got relayed longer ago than the configured retention.
+func (r *OutboxRelay) Cleanup(ctx context.Context) error {
+	qry := fmt.Sprintf("DELETE FROM %s WHERE relayed_at IS NOT NULL AND relayed_at < ?", r.table)
+	qry = sqlx.Rebind(r.bindType, qry)
+	before := r.clock.Now().Add(-r.settings.Relay.Retention)
+
+	if _, err := r.client.Exec(ctx, qry, before); err != nil {
+		return fmt.Errorf("can not delete relayed records: %w", err)
+	}
+
+	return nil
+}
+
+// outboxRowLock returns the lock of the selected rows of a batch. Sqlite doesn't support row locks, a write
+// transaction locks the whole database instead.
+func outboxRowLock(driver string) string {
+	switch driver {
+	case db.DriverMysql, db.DriverPostgres:
+		return " FOR UPDATE SKIP LOCKED"
+	default:
+		return ""
+	}
+}
+
+// quoteTableName uses the quoting of the gorm dialect for the driver and falls back to the quoting of the sql standard.
+func quoteTableName(driver string, table string) string {
+	if dialect, ok := gorm.GetDialect(driver); ok {
+		return dialect.Quote(table)
+	}
+
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(table, `"`, `""`))
+}
@@ -0,0 +1,382 @@
+package db_repo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	goSqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	"github.com/jmoiron/sqlx"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/db-repo"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	streamMocks "github.com/justtrackio/gosoline/pkg/stream/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/env"
+	"github.com/justtrackio/gosoline/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type outboxTestModel struct {
+	db_repo.Model
+}
+
+func (m *outboxTestModel) TableName() string {
+	return "my_test_models"
+}
+
+type OutboxRepositoryTestSuite struct {
+	suite.Suite
+
+	now    time.Time
+	dbMock goSqlMock.Sqlmock
+	repo   db_repo.TransactionalRepository
+}
+
+func TestOutboxRepository(t *testing.T) {
+	suite.Run(t, new(OutboxRepositoryTestSuite))
+}
+
+func (s *OutboxRepositoryTestSuite) SetupTest() {
+	s.now = time.Unix(1549964818, 0)
+
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	tracer := tracing.NewLocalTracer()
+	testClock := clock.NewFakeClockAt(s.now)
+
+	sqlDb, dbMock, err := goSqlMock.New()
+	s.NoError(err)
+
+	orm, err := db_repo.NewOrmWithInterfaces(sqlDb, db_repo.OrmSettings{
+		Driver: "mysql",
+	})
+	s.NoError(err)
+
+	transformer := func(view string, version int, in any) any {
+		return map[string]any{
+			"id": *in.(db_repo.ModelBased).GetId(),
+		}
+	}
+
+	encoder := stream.NewMessageEncoder(&stream.MessageEncoderSettings{})
+	base := db_repo.NewWithInterfaces(logger, tracer, orm, testClock, MyTestModelMetadata)
+
+	s.dbMock = dbMock
+	s.repo = db_repo.NewOutboxRepositoryWithInterfaces(logger, base, testClock, encoder, 1, transformer, "outbox")
+}
+
+func (s *OutboxRepositoryTestSuite) TestCreate() {
+	s.dbMock.ExpectBegin()
+	s.dbMock.ExpectExec("INSERT INTO `my_test_models` \\(`id`,`updated_at`,`created_at`\\) VALUES \\(\\?,\\?,\\?\\)").
+		WithArgs(id1, &s.now, &s.now).
+		WillReturnResult(goSqlMock.NewResult(1, 1))
+
+	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &s.now, &s.now)
+	s.dbMock.ExpectQuery("SELECT \\* FROM `my_test_models`").WithArgs(id1).WillReturnRows(rows)
+
+	s.dbMock.ExpectExec("INSERT INTO `outbox` \\(`model_id`,`entity_id`,`type`,`body`,`attributes`,`created_at`,`relayed_at`\\) VALUES \\(\\?,\\?,\\?,\\?,\\?,\\?,\\?\\)").
+		WithArgs(
+			MyTestModelMetadata.ModelId.String(),
+			*id1,
+			db_repo.Create,
+			`{"id":1}`,
+			`{"encoding":"application/json","modelId":"`+MyTestModelMetadata.ModelId.String()+`","type":"create","version":"1"}`,
+			&s.now,
+			nil,
+		).
+		WillReturnResult(goSqlMock.NewResult(1, 1))
+	s.dbMock.ExpectCommit()
+
+	model := &outboxTestModel{
+		Model: db_repo.Model{
+			Id: id1,
+		},
+	}
+
+	err := s.repo.Create(s.T().Context(), model)
+	s.NoError(err)
+	s.NoError(s.dbMock.ExpectationsWereMet())
+}
+
+func (s *OutboxRepositoryTestSuite) TestCreateRollbackOnOutboxFailure() {
+	s.dbMock.ExpectBegin()
+	s.dbMock.ExpectExec("INSERT INTO `my_test_models`").
+		WithArgs(id1, &s.now, &s.now).
+		WillReturnResult(goSqlMock.NewResult(1, 1))
+
+	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id1, &s.now, &s.now)
+	s.dbMock.ExpectQuery("SELECT \\* FROM `my_test_models`").WithArgs(id1).WillReturnRows(rows)
+
+	s.dbMock.ExpectExec("INSERT INTO `outbox`").
+		WithArgs(goSqlMock.AnyArg(), goSqlMock.AnyArg(), goSqlMock.AnyArg(), goSqlMock.AnyArg(), goSqlMock.AnyArg(), goSqlMock.AnyArg(), goSqlMock.AnyArg()).
+		WillReturnError(assert.AnError)
+	s.dbMock.ExpectRollback()
+
+	model := &outboxTestModel{
+		Model: db_repo.Model{
+			Id: id1,
+		},
+	}
+
+	err := s.repo.Create(s.T().Context(), model)
+	s.ErrorIs(err, assert.AnError)
+	s.NoError(s.dbMock.ExpectationsWereMet())
+}
+
+func (s *OutboxRepositoryTestSuite) TestTransaction() {
+	s.dbMock.ExpectBegin()
+
+	for _, id := range []*uint{id1, id42} {
+		s.dbMock.ExpectExec("INSERT INTO `my_test_models`").
+			WithArgs(id, &s.now, &s.now).
+			WillReturnResult(goSqlMock.NewResult(int64(*id), 1))
+
+		rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at"}).AddRow(id, &s.now, &s.now)
+		s.dbMock.ExpectQuery("SELECT \\* FROM `my_test_models`").WithArgs(id).WillReturnRows(rows)
+
+		s.dbMock.ExpectExec("INSERT INTO `outbox`").
+			WithArgs(goSqlMock.AnyArg(), *id, db_repo.Create, goSqlMock.AnyArg(), goSqlMock.AnyArg(), goSqlMock.AnyArg(), goSqlMock.AnyArg()).
+			WillReturnResult(goSqlMock.NewResult(1, 1))
+	}
+
+	s.dbMock.ExpectCommit()
+
+	err := s.repo.Transaction(s.T().Context(), func(ctx context.Context, repo db_repo.Repository, _ *gorm.DB) error {
+		for _, id := range []*uint{id1, id42} {
+			if err := repo.Create(ctx, &outboxTestModel{Model: db_repo.Model{Id: id}}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	s.NoError(err)
+	s.NoError(s.dbMock.ExpectationsWereMet())
+}
+
+func TestOutboxRelay_Relay(t *testing.T) {
+	now := time.Unix(1549964818, 0)
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	sqlDb, dbMock, err := goSqlMock.New()
+	assert.NoError(t, err)
+
+	client := db.NewClientWithInterfaces(logger, sqlx.NewDb(sqlDb, "mysql"), exec.NewDefaultExecutor())
+	output := streamMocks.NewOutput(t)
+
+	settings := &db_repo.OutboxSettings{
+		TableName: "outbox",
+		Relay: db_repo.OutboxRelaySettings{
+			Output:    "notifications",
+			BatchSize: 2,
+			Retention: time.Hour,
+		},
+	}
+
+	relay := db_repo.NewOutboxRelayWithInterfaces(logger, client, output, clock.NewFakeClockAt(now), "notifications", "mysql", settings)
+
+	dbMock.ExpectBegin()
+	dbMock.ExpectQuery("SELECT \\* FROM `outbox` WHERE relayed_at IS NULL ORDER BY id ASC LIMIT \\? FOR UPDATE SKIP LOCKED").
+		WithArgs(2).
+		WillReturnRows(goSqlMock.NewRows([]string{"id", "model_id", "entity_id", "type", "body", "attributes", "created_at", "relayed_at"}).
+			AddRow(1, "application.myTestModel", 1, "create", `{"id":1}`, `{"type":"create"}`, now, nil).
+			AddRow(2, "application.myTestModel", 1, "update", `{"id":1}`, `{"type":"update"}`, now, nil),
+		)
+	dbMock.ExpectExec("UPDATE `outbox` SET relayed_at = \\? WHERE id IN \\(\\?, \\?\\)").
+		WithArgs(now, 1, 2).
+		WillReturnResult(goSqlMock.NewResult(0, 2))
+	dbMock.ExpectCommit()
+
+	dbMock.ExpectBegin()
+	dbMock.ExpectQuery("SELECT \\* FROM `outbox` WHERE relayed_at IS NULL").
+		WithArgs(2).
+		WillReturnRows(goSqlMock.NewRows([]string{"id"}))
+	dbMock.ExpectCommit()
+
+	output.EXPECT().Write(mock.Anything, []stream.WritableMessage{
+		stream.NewMessage(`{"id":1}`, map[string]string{"type": "create"}),
+		stream.NewMessage(`{"id":1}`, map[string]string{"type": "update"}),
+	}).Return(nil).Once()
+
+	err = relay.Relay(t.Context())
+	assert.NoError(t, err)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestOutboxRelay_RelayOutputFailure(t *testing.T) {
+	now := time.Unix(1549964818, 0)
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	sqlDb, dbMock, err := goSqlMock.New()
+	assert.NoError(t, err)
+
+	client := db.NewClientWithInterfaces(logger, sqlx.NewDb(sqlDb, "mysql"), exec.NewDefaultExecutor())
+	output := streamMocks.NewOutput(t)
+
+	settings := &db_repo.OutboxSettings{
+		TableName: "outbox",
+		Relay: db_repo.OutboxRelaySettings{
+			Output:    "notifications",
+			BatchSize: 2,
+		},
+	}
+
+	relay := db_repo.NewOutboxRelayWithInterfaces(logger, client, output, clock.NewFakeClockAt(now), "notifications", "mysql", settings)
+
+	dbMock.ExpectBegin()
+	dbMock.ExpectQuery("SELECT \\* FROM `outbox`").
+		WithArgs(2).
+		WillReturnRows(goSqlMock.NewRows([]string{"id", "body", "attributes"}).AddRow(1, `{"id":1}`, `{}`))
+	dbMock.ExpectRollback()
+
+	output.EXPECT().Write(mock.Anything, mock.Anything).Return(assert.AnError).Once()
+
+	err = relay.Relay(t.Context())
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestOutboxRelay_Cleanup(t *testing.T) {
+	now := time.Unix(1549964818, 0)
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	sqlDb, dbMock, err := goSqlMock.New()
+	assert.NoError(t, err)
+
+	client := db.NewClientWithInterfaces(logger, sqlx.NewDb(sqlDb, "mysql"), exec.NewDefaultExecutor())
+	settings := &db_repo.OutboxSettings{
+		TableName: "outbox",
+		Relay: db_repo.OutboxRelaySettings{
+			Retention: time.Hour,
+		},
+	}
+
+	relay := db_repo.NewOutboxRelayWithInterfaces(logger, client, streamMocks.NewOutput(t), clock.NewFakeClockAt(now), "notifications", "mysql", settings)
+
+	dbMock.ExpectExec("DELETE FROM `outbox` WHERE relayed_at IS NOT NULL AND relayed_at < \\?").
+		WithArgs(now.Add(-time.Hour)).
+		WillReturnResult(goSqlMock.NewResult(0, 3))
+
+	err = relay.Cleanup(t.Context())
+	assert.NoError(t, err)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestOutboxRelay_RelayPostgres(t *testing.T) {
+	now := time.Unix(1549964818, 0)
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	sqlDb, dbMock, err := goSqlMock.New()
+	assert.NoError(t, err)
+
+	client := db.NewClientWithInterfaces(logger, sqlx.NewDb(sqlDb, db.DriverPostgres), exec.NewDefaultExecutor())
+	settings := &db_repo.OutboxSettings{
+		TableName: "outbox",
+		Relay: db_repo.OutboxRelaySettings{
+			Output:    "notifications",
+			BatchSize: 2,
+			Retention: time.Hour,
+		},
+	}
+
+	relay := db_repo.NewOutboxRelayWithInterfaces(logger, client, streamMocks.NewOutput(t), clock.NewFakeClockAt(now), "notifications", db.DriverPostgres, settings)
+
+	dbMock.ExpectBegin()
+	dbMock.ExpectQuery(`SELECT \* FROM "outbox" WHERE relayed_at IS NULL ORDER BY id ASC LIMIT \$1 FOR UPDATE SKIP LOCKED`).
+		WithArgs(2).
+		WillReturnRows(goSqlMock.NewRows([]string{"id"}))
+	dbMock.ExpectCommit()
+
+	dbMock.ExpectExec(`DELETE FROM "outbox" WHERE relayed_at IS NOT NULL AND relayed_at < \$1`).
+		WithArgs(now.Add(-time.Hour)).
+		WillReturnResult(goSqlMock.NewResult(0, 0))
+
+	err = relay.Relay(t.Context())
+	assert.NoError(t, err)
+
+	err = relay.Cleanup(t.Context())
+	assert.NoError(t, err)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestOutboxRelay_Sqlite(t *testing.T) {
+	now := time.Unix(1549964818, 0).UTC()
+
+	environment, err := env.NewEnvironment(t, env.WithConfigMap(map[string]any{
+		"app": map[string]any{
+			"env":  "test",
+			"name": "outbox-relay-test",
+			"tags": map[string]any{
+				"project": "gosoline",
+				"family":  "test",
+				"group":   "db-repo",
+			},
+		},
+		"db": map[string]any{
+			"default": map[string]any{
+				"driver": db.DriverSqlite,
+			},
+		},
+	}))
+	require.NoError(t, err)
+
+	sqlite := environment.Sqlite("default")
+	sqlite.Exec(`CREATE TABLE outbox (
+		id          INTEGER PRIMARY KEY AUTOINCREMENT,
+		model_id    TEXT NOT NULL,
+		entity_id   INTEGER NOT NULL,
+		type        TEXT NOT NULL,
+		body        TEXT NOT NULL,
+		attributes  TEXT NOT NULL,
+		created_at  DATETIME NOT NULL,
+		relayed_at  DATETIME NULL
+	)`)
+
+	insert := "INSERT INTO outbox (model_id, entity_id, type, body, attributes, created_at, relayed_at) VALUES (?, ?, ?, ?, ?, ?, ?)"
+	sqlite.Exec(insert, "application.myTestModel", 1, "create", `{"id":1}`, `{"type":"create"}`, now, now.Add(-2*time.Hour))
+	sqlite.Exec(insert, "application.myTestModel", 1, "update", `{"id":1}`, `{"type":"update"}`, now, nil)
+	sqlite.Exec(insert, "application.myTestModel", 2, "create", `{"id":2}`, `{"type":"create"}`, now, nil)
+	sqlite.Exec(insert, "application.myTestModel", 3, "create", `{"id":3}`, `{"type":"create"}`, now, nil)
+
+	client, err := db.NewClient(environment.Context(), environment.Config(), environment.Logger(), "default")
+	require.NoError(t, err)
+
+	output := streamMocks.NewOutput(t)
+	output.EXPECT().Write(mock.Anything, []stream.WritableMessage{
+		stream.NewMessage(`{"id":1}`, map[string]string{"type": "update"}),
+		stream.NewMessage(`{"id":2}`, map[string]string{"type": "create"}),
+	}).Return(nil).Once()
+	output.EXPECT().Write(mock.Anything, []stream.WritableMessage{
+		stream.NewMessage(`{"id":3}`, map[string]string{"type": "create"}),
+	}).Return(nil).Once()
+
+	settings := &db_repo.OutboxSettings{
+		TableName: "outbox",
+		Relay: db_repo.OutboxRelaySettings{
+			Output:    "notifications",
+			BatchSize: 2,
+			Retention: time.Hour,
+		},
+	}
+
+	relay := db_repo.NewOutboxRelayWithInterfaces(environment.Logger(), client, output, clock.NewFakeClockAt(now), "notifications", db.DriverSqlite, settings)
+
+	err = relay.Relay(t.Context())
+	require.NoError(t, err)
+
+	var pending int
+	err = sqlite.Client().Get(&pending, "SELECT COUNT(*) FROM outbox WHERE relayed_at IS NULL")
+	require.NoError(t, err)
+	assert.Equal(t, 0, pending)
+
+	// only the record which got relayed before the retention is removed
+	err = relay.Cleanup(t.Context())
+	require.NoError(t, err)
+	sqlite.AssertRowCount("outbox", 3)
+}
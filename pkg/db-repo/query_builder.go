@@ -16,13 +16,14 @@ type order struct {
 }
 
 type QueryBuilder struct {
-	table   string
-	joins   []string
-	where   []any
-	args    [][]any
-	groupBy []string
-	orderBy []order
-	page    *page
+	table          string
+	joins          []string
+	where          []any
+	args           [][]any
+	groupBy        []string
+	orderBy        []order
+	page           *page
+	includeDeleted bool
 }
 
 func NewQueryBuilder() *QueryBuilder {
@@ -77,3 +78,10 @@ func (qb *QueryBuilder) Page(offset int, size int) db.QueryBuilder {
 
 	return qb
 }
+
+// IncludeDeleted makes Query and Count return SoftDeletable models which got deleted as well.
+func (qb *QueryBuilder) IncludeDeleted() *QueryBuilder {
+	qb.includeDeleted = true
+
+	return qb
+}
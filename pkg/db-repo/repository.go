@@ -2,15 +2,19 @@ package db_repo
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/jinzhu/gorm"
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/coffin"
 	"github.com/justtrackio/gosoline/pkg/db"
 	"github.com/justtrackio/gosoline/pkg/log"
 	"github.com/justtrackio/gosoline/pkg/mdl"
@@ -19,11 +23,12 @@ import (
 )
 
 const (
-	Create = "create"
-	Read   = "read"
-	Update = "update"
-	Delete = "delete"
-	Query  = "query"
+	Create  = "create"
+	Read    = "read"
+	Update  = "update"
+	Delete  = "delete"
+	Query   = "query"
+	Restore = "restore"
 )
 
 var (
@@ -60,10 +65,27 @@ type Repository interface {
 	Delete(ctx context.Context, value ModelBased) error
 }
 
+// TransactionalRepository is a Repository which is able to execute several operations inside a single sql transaction.
+// The repository handed to the do function as well as the orm operate on the transaction. Calling Transaction with the
+// context handed to the do function joins the running transaction instead of starting a new one.
+type TransactionalRepository interface {
+	Repository
+	Transaction(ctx context.Context, do func(ctx context.Context, repo Repository, tx *gorm.DB) error) error
+}
+
+// SoftDeleteRepository is a Repository which is able to restore SoftDeletable models after they got deleted.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name SoftDeleteRepository
+type SoftDeleteRepository interface {
+	Repository
+	Restore(ctx context.Context, value ModelBased) error
+}
+
 type repository struct {
 	logger          log.Logger
 	tracer          tracing.Tracer
 	orm             *gorm.DB
+	replicaOrm      *gorm.DB
 	clock           clock.Clock
 	metadata        Metadata
 	noDeleteRefresh bool
@@ -95,6 +117,33 @@ func New(ctx context.Context, config cfg.Config, logger log.Logger, settings Set
 	return NewWithInterfaces(logger, tracer, orm, clk, settings.Metadata), nil
 }
 
+// NewReadOnly creates a repository which reads from the read replicas of the configured client. Reads with a context
+// marked by db.WithForcedPrimary go to the primary. Without replicas configured it reads from the primary like the
+// repository returned by New.
+func NewReadOnly(ctx context.Context, config cfg.Config, logger log.Logger, settings Settings) (RepositoryReadOnly, error) {
+	var err error
+	var tracer tracing.Tracer
+	var orm, replicaOrm *gorm.DB
+
+	if tracer, err = tracing.ProvideTracer(ctx, config, logger); err != nil {
+		return nil, fmt.Errorf("can not create tracer: %w", err)
+	}
+
+	if orm, err = NewOrm(ctx, config, logger, settings.ClientName); err != nil {
+		return nil, fmt.Errorf("can not create orm: %w", err)
+	}
+
+	if replicaOrm, err = NewReplicaOrm(ctx, config, logger, settings.ClientName); err != nil {
+		return nil, fmt.Errorf("can not create replica orm: %w", err)
+	}
+
+	if err := settings.Metadata.ModelId.PadFromConfig(config); err != nil {
+		return nil, fmt.Errorf("can not pad model id from config: %w", err)
+	}
+
+	return NewReadOnlyWithInterfaces(logger, tracer, orm, replicaOrm, clock.Provider, settings.Metadata), nil
+}
+
 func NewWithDbSettings(ctx context.Context, config cfg.Config, logger log.Logger, dbSettings *db.Settings, repoSettings Settings) (*repository, error) {
 	tracer, err := tracing.ProvideTracer(ctx, config, logger)
 	if err != nil {
@@ -130,10 +179,75 @@ func NewWithInterfaces(logger log.Logger, tracer tracing.Tracer, orm *gorm.DB, c
 	}
 }
 
+func NewReadOnlyWithInterfaces(logger log.Logger, tracer tracing.Tracer, orm *gorm.DB, replicaOrm *gorm.DB, clock clock.Clock, metadata Metadata) *repository {
+	repo := NewWithInterfaces(logger, tracer, orm, clock, metadata)
+	repo.replicaOrm = replicaOrm
+
+	return repo
+}
+
 func (r *repository) GetOrm() *gorm.DB {
 	return r.orm
 }
 
+func (r *repository) Transaction(ctx context.Context, do func(ctx context.Context, repo Repository, tx *gorm.DB) error) (err error) {
+	ctx, span := r.startSubSpan(ctx, "Transaction")
+	defer span.Finish()
+
+	if tx, ok := transactionFromContext(ctx); ok {
+		txRepo := *r
+		txRepo.orm = tx
+		txRepo.replicaOrm = nil
+
+		return do(ctx, &txRepo, tx)
+	}
+
+	tx := r.orm.BeginTx(ctx, &sql.TxOptions{})
+	if tx.Error != nil {
+		return fmt.Errorf("can not begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if rec := coffin.ResolveRecovery(recover()); rec != nil {
+			err = multierror.Append(err, fmt.Errorf("panic: %w", rec))
+		}
+
+		if err == nil {
+			return
+		}
+
+		if errRollback := tx.Rollback().Error; errRollback != nil {
+			err = multierror.Append(err, fmt.Errorf("can not rollback transaction: %w", errRollback))
+		}
+	}()
+
+	txRepo := *r
+	txRepo.orm = tx
+	txRepo.replicaOrm = nil
+
+	if err = do(withTransaction(ctx, tx), &txRepo, tx); err != nil {
+		return err
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return fmt.Errorf("can not commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+type transactionCtxKey struct{}
+
+func withTransaction(ctx context.Context, tx *gorm.DB) context.Context {
+	return context.WithValue(ctx, transactionCtxKey{}, tx)
+}
+
+func transactionFromContext(ctx context.Context) (*gorm.DB, bool) {
+	tx, ok := ctx.Value(transactionCtxKey{}).(*gorm.DB)
+
+	return tx, ok
+}
+
 func (r *repository) Create(ctx context.Context, value ModelBased) error {
 	if !r.isQueryableModel(value) {
 		return fmt.Errorf("table %q: %w", r.orm.NewScope(value).TableName(), ErrCrossCreate)
@@ -185,7 +299,7 @@ func (r *repository) Read(ctx context.Context, id *uint, out ModelBased) error {
 	_, span := r.startSubSpan(ctx, "Get")
 	defer span.Finish()
 
-	err := r.orm.First(out, *id).Error
+	err := r.reader(ctx).First(out, *id).Error
 
 	if gorm.IsRecordNotFoundError(err) {
 		return NewRecordNotFoundError(*id, modelId, err)
@@ -209,6 +323,12 @@ func (r *repository) Update(ctx context.Context, value ModelBased) error {
 
 	err := r.orm.Save(value).Error
 
+	if errors.Is(err, errVersionConflict) {
+		r.logger.Warn(ctx, "could not update model of type %s with id %d due to a concurrent update", modelId, mdl.EmptyIfNil(value.GetId()))
+
+		return r.newVersionConflictError(value)
+	}
+
 	if db.IsDuplicateEntryError(err) {
 		r.logger.Warn(ctx, "could not update model of type %s with id %d due to duplicate entry error: %s", modelId, mdl.EmptyIfNil(value.GetId()), err.Error())
 
@@ -245,23 +365,78 @@ func (r *repository) Delete(ctx context.Context, value ModelBased) error {
 	_, span := r.startSubSpan(ctx, "Delete")
 	defer span.Finish()
 
-	err := r.refreshAssociations(value, Delete)
-	if err != nil {
-		r.logger.Error(ctx, "could not delete associations of model type %s with id %d: %w", modelId, *value.GetId(), err)
+	softDeletable, isSoftDeletable := getSoftDeletable(value)
 
-		return err
+	// the associations are kept for soft deleted models, so they are still there after a restore
+	if !isSoftDeletable {
+		if err := r.refreshAssociations(value, Delete); err != nil {
+			r.logger.Error(ctx, "could not delete associations of model type %s with id %d: %w", modelId, *value.GetId(), err)
+
+			return err
+		}
+	}
+
+	err := r.orm.Delete(value).Error
+	if errors.Is(err, errVersionConflict) {
+		r.logger.Warn(ctx, "could not delete model of type %s with id %d due to a concurrent update", modelId, *value.GetId())
+
+		return r.newVersionConflictError(value)
 	}
 
-	err = r.orm.Delete(value).Error
 	if err != nil {
 		r.logger.Error(ctx, "could not delete model of type %s with id %d: %w", modelId, *value.GetId(), err)
 	}
 
+	if err == nil && isSoftDeletable {
+		now := r.clock.Now()
+		softDeletable.SetDeletedAt(&now)
+	}
+
 	r.logger.Info(ctx, "deleted model of type %s with id %d", modelId, *value.GetId())
 
 	return err
 }
 
+func (r *repository) Restore(ctx context.Context, value ModelBased) error {
+	if !r.isQueryableModel(value) {
+		return fmt.Errorf("table %q: %w", r.orm.NewScope(value).TableName(), ErrCrossUpdate)
+	}
+
+	modelId := r.GetModelId()
+
+	if _, ok := getSoftDeletable(value); !ok {
+		return fmt.Errorf("can not restore model of type %s as it is not soft deletable", modelId)
+	}
+
+	ctx, span := r.startSubSpan(ctx, "Restore")
+	defer span.Finish()
+
+	result := r.orm.Unscoped().Model(value).UpdateColumn(ColumnDeletedAt, nil)
+
+	if result.Error != nil {
+		r.logger.Error(ctx, "could not restore model of type %s with id %d: %w", modelId, mdl.EmptyIfNil(value.GetId()), result.Error)
+
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return NewRecordNotFoundError(mdl.EmptyIfNil(value.GetId()), modelId, gorm.ErrRecordNotFound)
+	}
+
+	r.logger.Info(ctx, "restored model of type %s with id %d", modelId, *value.GetId())
+
+	return r.Read(ctx, value.GetId(), value)
+}
+
+func (r *repository) newVersionConflictError(value ModelBased) error {
+	version := 0
+	if m, ok := getVersioned(value); ok {
+		version = m.GetVersion()
+	}
+
+	return NewVersionConflictError(mdl.EmptyIfNil(value.GetId()), version, r.GetModelId())
+}
+
 func (r *repository) isQueryableModel(model any) bool {
 	tableName := r.orm.NewScope(model).TableName()
 
@@ -293,7 +468,7 @@ func (r *repository) Query(ctx context.Context, qb *QueryBuilder, result any) er
 	_, span := r.startSubSpan(ctx, "Query")
 	defer span.Finish()
 
-	db := r.orm.New()
+	db := r.reader(ctx).New()
 
 	for _, j := range qb.joins {
 		db = db.Joins(j)
@@ -323,6 +498,10 @@ func (r *repository) Query(ctx context.Context, qb *QueryBuilder, result any) er
 		db = db.Limit(qb.page.limit)
 	}
 
+	if qb.includeDeleted {
+		db = db.Unscoped()
+	}
+
 	db = db.Table(r.GetMetadata().TableName)
 
 	err = db.Find(result).Error
@@ -342,7 +521,7 @@ func (r *repository) Count(ctx context.Context, qb *QueryBuilder, model ModelBas
 		Count int
 	}{}
 
-	db := r.orm.New()
+	db := r.reader(ctx).New()
 
 	for _, j := range qb.joins {
 		db = db.Joins(j)
@@ -355,6 +534,11 @@ func (r *repository) Count(ctx context.Context, qb *QueryBuilder, model ModelBas
 	scope := r.orm.NewScope(model)
 	tableName := scope.TableName()
 	key := scope.PrimaryKey()
+
+	// the count doesn't select the model, so the orm can't exclude the deleted ones on its own
+	if _, ok := getSoftDeletable(model); ok && !qb.includeDeleted {
+		db = db.Where(fmt.Sprintf("%s.%s IS NULL", tableName, ColumnDeletedAt))
+	}
 	sel := fmt.Sprintf("COUNT(DISTINCT %s.%s) AS count", tableName, key)
 
 	err := db.Table(tableName).Select(sel).Scan(&result).Error
@@ -468,6 +652,16 @@ func (r *repository) refreshAssociationsDelete(model any, field reflect.StructFi
 	return
 }
 
+// reader returns the orm executing the reads. These go to the replicas of a read only repository, unless the context
+// forces the primary.
+func (r *repository) reader(ctx context.Context) *gorm.DB {
+	if r.replicaOrm == nil || db.IsForcedPrimary(ctx) {
+		return r.orm
+	}
+
+	return r.replicaOrm
+}
+
 func (r *repository) GetModelId() string {
 	return r.metadata.ModelId.String()
 }
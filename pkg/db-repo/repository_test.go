@@ -7,11 +7,13 @@ import (
 
 	goSqlMock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/db"
 	"github.com/justtrackio/gosoline/pkg/db-repo"
 	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
 	"github.com/justtrackio/gosoline/pkg/mdl"
 	"github.com/justtrackio/gosoline/pkg/tracing"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type MyTestModel struct {
@@ -23,6 +25,8 @@ const (
 	manyToMany  = "manyToMany"
 	oneOfMany   = "oneOfMany"
 	hasMany     = "hasMany"
+	versioned   = "versionedModel"
+	softDeleted = "softDeleteModel"
 )
 
 var MyTestModelMetadata = db_repo.Metadata{
@@ -96,11 +100,47 @@ type Ones struct {
 	HasManyId *uint
 }
 
+type VersionedModel struct {
+	db_repo.Model
+	db_repo.Versioning
+}
+
+var VersionedModelMetadata = db_repo.Metadata{
+	ModelId: mdl.ModelId{
+		Application: "application",
+		Name:        "versionedModel",
+	},
+	TableName:  "versioned_models",
+	PrimaryKey: "versioned_models.id",
+	Mappings: db_repo.FieldMappings{
+		"versionedModel.id": db_repo.NewFieldMapping("versioned_models.id"),
+	},
+}
+
+type SoftDeleteModel struct {
+	db_repo.Model
+	db_repo.SoftDeletes
+}
+
+var SoftDeleteModelMetadata = db_repo.Metadata{
+	ModelId: mdl.ModelId{
+		Application: "application",
+		Name:        "softDeleteModel",
+	},
+	TableName:  "soft_delete_models",
+	PrimaryKey: "soft_delete_models.id",
+	Mappings: db_repo.FieldMappings{
+		"softDeleteModel.id": db_repo.NewFieldMapping("soft_delete_models.id"),
+	},
+}
+
 var metadatas = map[string]db_repo.Metadata{
-	"myTestModel": MyTestModelMetadata,
-	"manyToMany":  ManyToManyMetadata,
-	"oneOfMany":   OneOfManyMetadata,
-	"hasMany":     HasManyMetadata,
+	"myTestModel":     MyTestModelMetadata,
+	"versionedModel":  VersionedModelMetadata,
+	"manyToMany":      ManyToManyMetadata,
+	"oneOfMany":       OneOfManyMetadata,
+	"hasMany":         HasManyMetadata,
+	"softDeleteModel": SoftDeleteModelMetadata,
 }
 
 type idMatcher struct{}
@@ -390,6 +430,93 @@ func TestRepository_Update(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestRepository_UpdateVersioned(t *testing.T) {
+	dbc, repo := getMocks(t, versioned)
+	now := time.Unix(1549964818, 0)
+
+	result := goSqlMock.NewResult(0, 1)
+
+	dbc.ExpectBegin()
+	dbc.ExpectExec("UPDATE `versioned_models` SET `updated_at` = \\?, `version` = \\? WHERE `versioned_models`\\.`id` = \\? AND \\(\\(`versioned_models`\\.`version` = \\?\\)\\)").WithArgs(goSqlMock.AnyArg(), 4, id1, 3).WillReturnResult(result)
+	dbc.ExpectCommit()
+
+	rows := goSqlMock.NewRows([]string{"id", "updated_at", "created_at", "version"}).AddRow(id1, &now, &now, 4)
+	dbc.ExpectQuery("SELECT \\* FROM `versioned_models` WHERE `versioned_models`\\.`id` = \\? AND \\(\\(`versioned_models`\\.`id` = 1\\)\\) ORDER BY `versioned_models`\\.`id` ASC LIMIT 1").WithArgs(id1).WillReturnRows(rows)
+
+	model := VersionedModel{
+		Model: db_repo.Model{
+			Id: id1,
+		},
+		Versioning: db_repo.Versioning{
+			Version: 3,
+		},
+	}
+
+	err := repo.Update(t.Context(), &model)
+
+	if err := dbc.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, model.Version)
+}
+
+func TestRepository_UpdateVersionConflict(t *testing.T) {
+	dbc, repo := getMocks(t, versioned)
+
+	result := goSqlMock.NewResult(0, 0)
+
+	dbc.ExpectBegin()
+	dbc.ExpectExec("UPDATE `versioned_models` SET `updated_at` = \\?, `version` = \\? WHERE `versioned_models`\\.`id` = \\? AND \\(\\(`versioned_models`\\.`version` = \\?\\)\\)").WithArgs(goSqlMock.AnyArg(), 4, id1, 3).WillReturnResult(result)
+	dbc.ExpectRollback()
+
+	model := VersionedModel{
+		Model: db_repo.Model{
+			Id: id1,
+		},
+		Versioning: db_repo.Versioning{
+			Version: 3,
+		},
+	}
+
+	err := repo.Update(t.Context(), &model)
+
+	if err := dbc.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+
+	assert.True(t, db_repo.IsVersionConflictError(err))
+	assert.ErrorContains(t, err, "with id 1 is not at version 3 anymore")
+	assert.Equal(t, 3, model.Version)
+}
+
+func TestRepository_UpdateVersionedFailure(t *testing.T) {
+	dbc, repo := getMocks(t, versioned)
+
+	dbc.ExpectBegin()
+	dbc.ExpectExec("UPDATE `versioned_models` SET `updated_at` = \\?, `version` = \\? WHERE `versioned_models`\\.`id` = \\? AND \\(\\(`versioned_models`\\.`version` = \\?\\)\\)").WithArgs(goSqlMock.AnyArg(), 4, id1, 3).WillReturnError(assert.AnError)
+	dbc.ExpectRollback()
+
+	model := VersionedModel{
+		Model: db_repo.Model{
+			Id: id1,
+		},
+		Versioning: db_repo.Versioning{
+			Version: 3,
+		},
+	}
+
+	err := repo.Update(t.Context(), &model)
+
+	if err := dbc.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Equal(t, 3, model.Version)
+}
+
 func TestRepository_UpdateManyToManyNoRelation(t *testing.T) {
 	dbc, repo := getMocks(t, manyToMany)
 	now := time.Unix(1549964818, 0)
@@ -618,6 +745,171 @@ func TestRepository_Delete(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestRepository_DeleteSoft(t *testing.T) {
+	now := time.Unix(1549964818, 0)
+	dbc, repo := getTimedMocks(t, now, softDeleted)
+
+	result := goSqlMock.NewResult(0, 1)
+	dbc.ExpectBegin()
+	dbc.ExpectExec("UPDATE `soft_delete_models` SET `deleted_at`=\\? WHERE `soft_delete_models`\\.`deleted_at` IS NULL AND `soft_delete_models`\\.`id` = \\?").WithArgs(goSqlMock.AnyArg(), id1).WillReturnResult(result)
+	dbc.ExpectCommit()
+
+	model := SoftDeleteModel{
+		Model: db_repo.Model{
+			Id: id1,
+		},
+	}
+
+	err := repo.Delete(t.Context(), &model)
+
+	if err := dbc.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, &now, model.DeletedAt)
+}
+
+func TestRepository_ReadSoftDeleted(t *testing.T) {
+	dbc, repo := getMocks(t, softDeleted)
+
+	dbc.ExpectQuery("SELECT \\* FROM `soft_delete_models` WHERE `soft_delete_models`\\.`deleted_at` IS NULL AND \\(\\(`soft_delete_models`\\.`id` = 1\\)\\) ORDER BY `soft_delete_models`\\.`id` ASC LIMIT 1").WillReturnRows(goSqlMock.NewRows([]string{"id"}))
+
+	model := SoftDeleteModel{}
+	err := repo.Read(t.Context(), id1, &model)
+
+	if err := dbc.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+
+	assert.True(t, db_repo.IsRecordNotFoundError(err))
+}
+
+func TestRepository_QuerySoftDeleted(t *testing.T) {
+	dbc, repo := getMocks(t, softDeleted)
+
+	rows := goSqlMock.NewRows([]string{"id"}).AddRow(id1)
+	dbc.ExpectQuery("SELECT \\* FROM `soft_delete_models` WHERE `soft_delete_models`\\.`deleted_at` IS NULL AND \\(\\(id > \\?\\)\\)").WithArgs(0).WillReturnRows(rows)
+
+	qb := db_repo.NewQueryBuilder()
+	qb.Where("id > ?", 0)
+
+	result := make([]*SoftDeleteModel, 0)
+	err := repo.Query(t.Context(), qb, &result)
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+
+	rows = goSqlMock.NewRows([]string{"id"}).AddRow(id1).AddRow(id42)
+	dbc.ExpectQuery("SELECT \\* FROM `soft_delete_models` WHERE \\(id > \\?\\)").WithArgs(0).WillReturnRows(rows)
+
+	result = make([]*SoftDeleteModel, 0)
+	err = repo.Query(t.Context(), qb.IncludeDeleted(), &result)
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+
+	if err := dbc.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestRepository_CountSoftDeleted(t *testing.T) {
+	dbc, repo := getMocks(t, softDeleted)
+
+	dbc.ExpectQuery("SELECT COUNT\\(DISTINCT soft_delete_models\\.id\\) AS count FROM `soft_delete_models` WHERE \\(soft_delete_models\\.deleted_at IS NULL\\)").WillReturnRows(goSqlMock.NewRows([]string{"count"}).AddRow(1))
+
+	count, err := repo.Count(t.Context(), db_repo.NewQueryBuilder(), &SoftDeleteModel{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	dbc.ExpectQuery("SELECT COUNT\\(DISTINCT soft_delete_models\\.id\\) AS count FROM `soft_delete_models`$").WillReturnRows(goSqlMock.NewRows([]string{"count"}).AddRow(2))
+
+	count, err = repo.Count(t.Context(), db_repo.NewQueryBuilder().IncludeDeleted(), &SoftDeleteModel{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	if err := dbc.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestRepository_Restore(t *testing.T) {
+	dbc, repo := getMocks(t, softDeleted)
+	deletedAt := time.Unix(1549964818, 0)
+
+	dbc.ExpectBegin()
+	dbc.ExpectExec("UPDATE `soft_delete_models` SET `deleted_at` = \\? WHERE `soft_delete_models`\\.`id` = \\?").WithArgs(nil, id1).WillReturnResult(goSqlMock.NewResult(0, 1))
+	dbc.ExpectCommit()
+
+	rows := goSqlMock.NewRows([]string{"id", "deleted_at"}).AddRow(id1, nil)
+	dbc.ExpectQuery("SELECT \\* FROM `soft_delete_models`  WHERE `soft_delete_models`\\.`deleted_at` IS NULL AND `soft_delete_models`\\.`id` = \\?").WithArgs(id1).WillReturnRows(rows)
+
+	model := SoftDeleteModel{
+		Model: db_repo.Model{
+			Id: id1,
+		},
+		SoftDeletes: db_repo.SoftDeletes{
+			DeletedAt: &deletedAt,
+		},
+	}
+
+	err := repo.(db_repo.SoftDeleteRepository).Restore(t.Context(), &model)
+
+	if err := dbc.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+
+	assert.NoError(t, err)
+	assert.Nil(t, model.DeletedAt)
+}
+
+func TestRepository_RestoreNotFound(t *testing.T) {
+	dbc, repo := getMocks(t, softDeleted)
+
+	dbc.ExpectBegin()
+	dbc.ExpectExec("UPDATE `soft_delete_models` SET `deleted_at` = \\?").WithArgs(nil, id1).WillReturnResult(goSqlMock.NewResult(0, 0))
+	dbc.ExpectCommit()
+
+	model := SoftDeleteModel{
+		Model: db_repo.Model{
+			Id: id1,
+		},
+	}
+
+	err := repo.(db_repo.SoftDeleteRepository).Restore(t.Context(), &model)
+
+	if err := dbc.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+
+	assert.True(t, db_repo.IsRecordNotFoundError(err))
+}
+
+func TestRepository_DeleteVersionConflict(t *testing.T) {
+	dbc, repo := getMocks(t, versioned)
+
+	result := goSqlMock.NewResult(0, 0)
+	dbc.ExpectBegin()
+	dbc.ExpectExec("DELETE FROM `versioned_models`  WHERE `versioned_models`\\.`id` = \\? AND \\(\\(`versioned_models`\\.`version` = \\?\\)\\)").WithArgs(id1, 3).WillReturnResult(result)
+	dbc.ExpectRollback()
+
+	model := VersionedModel{
+		Model: db_repo.Model{
+			Id: id1,
+		},
+		Versioning: db_repo.Versioning{
+			Version: 3,
+		},
+	}
+
+	err := repo.Delete(t.Context(), &model)
+
+	if err := dbc.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+
+	assert.True(t, db_repo.IsVersionConflictError(err))
+}
+
 func TestRepository_DeleteManyToManyNoRelation(t *testing.T) {
 	dbc, repo := getMocks(t, manyToMany)
 
@@ -794,6 +1086,37 @@ func TestRepository_DeleteHasManyNoRelation(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestRepository_ReadOnlyReadsFromReplica(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	primaryDb, primary, err := goSqlMock.New()
+	require.NoError(t, err)
+
+	replicaDb, replica, err := goSqlMock.New()
+	require.NoError(t, err)
+
+	orm, err := db_repo.NewOrmWithInterfaces(primaryDb, db_repo.OrmSettings{Driver: "mysql"})
+	require.NoError(t, err)
+
+	replicaOrm, err := db_repo.NewOrmWithInterfaces(replicaDb, db_repo.OrmSettings{Driver: "mysql"})
+	require.NoError(t, err)
+
+	repo := db_repo.NewReadOnlyWithInterfaces(logger, tracing.NewLocalTracer(), orm, replicaOrm, clock.NewFakeClock(), MyTestModelMetadata)
+
+	qry := "SELECT \\* FROM `my_test_models` WHERE \\(`my_test_models`\\.`id` = 1\\) ORDER BY `my_test_models`\\.`id` ASC LIMIT 1"
+	replica.ExpectQuery(qry).WillReturnRows(goSqlMock.NewRows([]string{"id"}).AddRow(1))
+	primary.ExpectQuery(qry).WillReturnRows(goSqlMock.NewRows([]string{"id"}).AddRow(1))
+
+	err = repo.Read(t.Context(), id1, &MyTestModel{})
+	assert.NoError(t, err)
+
+	err = repo.Read(db.WithForcedPrimary(t.Context()), id1, &MyTestModel{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, replica.ExpectationsWereMet())
+	assert.NoError(t, primary.ExpectationsWereMet())
+}
+
 func getMocks(t *testing.T, whichMetadata string) (goSqlMock.Sqlmock, db_repo.Repository) {
 	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
 	tracer := tracing.NewLocalTracer()
@@ -0,0 +1,45 @@
+package db_repo
+
+import (
+	"reflect"
+	"time"
+)
+
+const ColumnDeletedAt = "deleted_at"
+
+// SoftDeletable models are not removed by a delete. Their deletion time is set instead and they are excluded from
+// Read, Query and Count until they are restored. Use QueryBuilder.IncludeDeleted to query them anyway.
+type SoftDeletable interface {
+	GetDeletedAt() *time.Time
+	SetDeletedAt(deletedAt *time.Time)
+}
+
+// SoftDeletes can be embedded into a model to make it SoftDeletable. The orm recognizes the DeletedAt field and turns
+// deletes into updates of the deleted_at column, which has to be added to the table by a migration.
+type SoftDeletes struct {
+	DeletedAt *time.Time
+}
+
+func (s *SoftDeletes) GetDeletedAt() *time.Time {
+	return s.DeletedAt
+}
+
+func (s *SoftDeletes) SetDeletedAt(deletedAt *time.Time) {
+	s.DeletedAt = deletedAt
+}
+
+func getSoftDeletable(value any) (SoftDeletable, bool) {
+	if value == nil {
+		return nil, false
+	}
+
+	if m, ok := value.(SoftDeletable); ok {
+		return m, true
+	}
+
+	if val := reflect.ValueOf(value); val.Kind() == reflect.Ptr {
+		return getSoftDeletable(val.Elem().Interface())
+	}
+
+	return nil, false
+}
@@ -0,0 +1,143 @@
+package db_repo
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/jinzhu/gorm"
+)
+
+const ColumnVersion = "version"
+
+// Versioned models are written with optimistic concurrency control: an update or delete only succeeds if the row is
+// still at the version of the model, otherwise it fails with a VersionConflictError. Every update increments the
+// version.
+type Versioned interface {
+	GetVersion() int
+	SetVersion(version int)
+}
+
+// Versioning can be embedded into a model to make it Versioned.
+type Versioning struct {
+	Version int `gorm:"not null"`
+}
+
+func (v *Versioning) GetVersion() int {
+	return v.Version
+}
+
+func (v *Versioning) SetVersion(version int) {
+	v.Version = version
+}
+
+type VersionConflictError struct {
+	modelId string
+	id      uint
+	version int
+}
+
+func NewVersionConflictError(id uint, version int, modelId string) VersionConflictError {
+	return VersionConflictError{
+		modelId: modelId,
+		id:      id,
+		version: version,
+	}
+}
+
+func (e VersionConflictError) Error() string {
+	return fmt.Sprintf("model of type %s with id %d is not at version %d anymore", e.modelId, e.id, e.version)
+}
+
+func IsVersionConflictError(err error) bool {
+	return errors.As(err, &VersionConflictError{})
+}
+
+// errVersionConflict is returned by the orm if a Versioned model was not written because its row is at another
+// version. The repository turns it into a VersionConflictError.
+var errVersionConflict = errors.New("the row is not at the version of the model")
+
+// registerVersionCallbacks adds the version condition to updates and deletes of Versioned models and fails them if
+// no row was affected. If an update fails, the model gets back the version it had before.
+func registerVersionCallbacks(orm *gorm.DB) {
+	orm.Callback().
+		Update().
+		Before("gorm:update").
+		Register("gosoline:version_condition", updateVersionCondition)
+	orm.Callback().
+		Update().
+		After("gorm:update").
+		Register("gosoline:version_check", versionCheck)
+	orm.Callback().
+		Update().
+		After("gorm:commit_or_rollback_transaction").
+		Register("gosoline:version_rollback", versionRollback)
+	orm.Callback().
+		Delete().
+		Before("gorm:delete").
+		Register("gosoline:version_condition", deleteVersionCondition)
+	orm.Callback().
+		Delete().
+		After("gorm:delete").
+		Register("gosoline:version_check", versionCheck)
+}
+
+func updateVersionCondition(scope *gorm.Scope) {
+	// updates of single columns don't write the version, so there is nothing to check
+	if _, ok := scope.InstanceGet("gorm:update_interface"); ok {
+		return
+	}
+
+	if m, ok := getVersioned(scope.Value); ok {
+		addVersionCondition(scope, m.GetVersion())
+		m.SetVersion(m.GetVersion() + 1)
+	}
+}
+
+func deleteVersionCondition(scope *gorm.Scope) {
+	if m, ok := getVersioned(scope.Value); ok {
+		addVersionCondition(scope, m.GetVersion())
+	}
+}
+
+func addVersionCondition(scope *gorm.Scope, version int) {
+	scope.Search.Where(fmt.Sprintf("%s.%s = ?", scope.QuotedTableName(), scope.Quote(ColumnVersion)), version)
+	scope.InstanceSet("gosoline:expected_version", version)
+}
+
+func versionCheck(scope *gorm.Scope) {
+	if _, ok := scope.InstanceGet("gosoline:expected_version"); !ok || scope.HasError() || scope.DB().RowsAffected > 0 {
+		return
+	}
+
+	scope.Err(errVersionConflict)
+}
+
+// versionRollback resets the version incremented by updateVersionCondition if the update failed for any reason, so
+// the model keeps the version of its row.
+func versionRollback(scope *gorm.Scope) {
+	version, ok := scope.InstanceGet("gosoline:expected_version")
+	if !ok || !scope.HasError() {
+		return
+	}
+
+	if m, ok := getVersioned(scope.Value); ok {
+		m.SetVersion(version.(int))
+	}
+}
+
+func getVersioned(value any) (Versioned, bool) {
+	if value == nil {
+		return nil, false
+	}
+
+	if m, ok := value.(Versioned); ok {
+		return m, true
+	}
+
+	if val := reflect.ValueOf(value); val.Kind() == reflect.Ptr {
+		return getVersioned(val.Elem().Interface())
+	}
+
+	return nil, false
+}
@@ -59,6 +59,9 @@ type (
 
 //go:generate go run github.com/vektra/mockery/v2 --name Client
 type (
+	// Client executes the queries on the primary. If there are read replicas configured, Query, Queryx, Select,
+	// NamedSelect and Get are executed on one of them instead, unless the context is marked by WithForcedPrimary.
+	// The ? placeholders of the queries are rebound to the placeholders of the driver, e.g. $1 for postgres.
 	Client interface {
 		GetSingleScalarValue(ctx context.Context, query string, args ...any) (int, error)
 		GetResult(ctx context.Context, query string, args ...any) (*Result, error)
@@ -80,9 +83,15 @@ type (
 		Close() error
 	}
 
+	// TxBeginner is implemented by clients which hand out transactions to be committed or rolled back by the caller.
+	TxBeginner interface {
+		BeginTx(ctx context.Context, ops *sql.TxOptions) (*sqlx.Tx, error)
+	}
+
 	ClientSqlx struct {
 		logger   log.Logger
 		db       *sqlx.DB
+		replicas *ReplicaPool
 		executor exec.Executor
 	}
 
@@ -126,7 +135,7 @@ func NewClientWithSettings(ctx context.Context, config cfg.Config, logger log.Lo
 		executor   = exec.NewDefaultExecutor()
 	)
 
-	if connection, err = ProvideConnectionFromSettings(ctx, logger, name, settings); err != nil {
+	if connection, err = ProvideConnectionFromSettings(ctx, config, logger, name, settings); err != nil {
 		return nil, fmt.Errorf("can not connect to sql database: %w", err)
 	}
 
@@ -138,6 +147,12 @@ func NewClientWithSettings(ctx context.Context, config cfg.Config, logger log.Lo
 
 	client := NewClientWithInterfaces(logger, connection, executor)
 
+	if len(settings.Replicas.Hosts) > 0 {
+		if client.replicas, err = ProvideReplicaPool(ctx, config, logger, name, settings); err != nil {
+			return nil, fmt.Errorf("can not create replica pool for sql client %s: %w", name, err)
+		}
+	}
+
 	for _, option := range options {
 		option(client)
 	}
@@ -237,6 +252,7 @@ func (c *ClientSqlx) GetResult(ctx context.Context, query string, args ...any) (
 }
 
 func (c *ClientSqlx) Exec(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	query = c.db.Rebind(query)
 	c.logger.Debug(ctx, "> %s %q", query, args)
 
 	res, err := c.executor.Execute(ctx, func(ctx context.Context) (any, error) {
@@ -269,13 +285,15 @@ func (c *ClientSqlx) ExecMultiInTx(ctx context.Context, sqlers ...Sqler) (result
 			return nil, fmt.Errorf("can not build sql #%d: %w", i, err)
 		}
 
-		queries = append(queries, qry)
+		queries = append(queries, c.db.Rebind(qry))
 		argss = append(argss, args)
 	}
 
 	err = c.WithTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx *sqlx.Tx) error {
 		for i, qry := range queries {
-			if res, err = c.Exec(ctx, qry, argss[i]...); err != nil {
+			c.logger.Debug(ctx, "> %s %q", qry, argss[i])
+
+			if res, err = tx.ExecContext(ctx, qry, argss[i]...); err != nil {
 				return fmt.Errorf("can not exec qry %s: %w", qry, err)
 			}
 
@@ -296,6 +314,7 @@ func (c *ClientSqlx) BindNamed(query string, arg any) (qry string, args []any, e
 }
 
 func (c *ClientSqlx) Prepare(ctx context.Context, query string) (*sql.Stmt, error) {
+	query = c.db.Rebind(query)
 	res, err := c.executor.Execute(ctx, func(ctx context.Context) (any, error) {
 		return c.db.PrepareContext(ctx, query)
 	})
@@ -307,6 +326,7 @@ func (c *ClientSqlx) Prepare(ctx context.Context, query string) (*sql.Stmt, erro
 }
 
 func (c *ClientSqlx) Preparex(ctx context.Context, query string) (*sqlx.Stmt, error) {
+	query = c.db.Rebind(query)
 	res, err := c.executor.Execute(ctx, func(ctx context.Context) (any, error) {
 		return c.db.PreparexContext(ctx, query)
 	})
@@ -329,10 +349,11 @@ func (c *ClientSqlx) PrepareNamed(ctx context.Context, query string) (*sqlx.Name
 }
 
 func (c *ClientSqlx) Query(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	query = c.db.Rebind(query)
 	c.logger.Debug(ctx, "> %s %q", query, args)
 
 	res, err := c.executor.Execute(ctx, func(ctx context.Context) (any, error) {
-		return c.db.QueryContext(ctx, query, args...)
+		return c.reader(ctx).QueryContext(ctx, query, args...)
 	})
 	if err != nil {
 		return nil, err
@@ -342,6 +363,7 @@ func (c *ClientSqlx) Query(ctx context.Context, query string, args ...any) (*sql
 }
 
 func (c *ClientSqlx) QueryRow(ctx context.Context, query string, args ...any) *sql.Row {
+	query = c.db.Rebind(query)
 	c.logger.Debug(ctx, "> %s %q", query, args)
 
 	res, err := c.executor.Execute(ctx, func(ctx context.Context) (any, error) {
@@ -368,10 +390,11 @@ func (c *ClientSqlx) NamedQuery(ctx context.Context, query string, arg any) (*sq
 }
 
 func (c *ClientSqlx) Queryx(ctx context.Context, query string, args ...any) (*sqlx.Rows, error) {
+	query = c.db.Rebind(query)
 	c.logger.Debug(ctx, "> %s %q", query, args)
 
 	res, err := c.executor.Execute(ctx, func(ctx context.Context) (any, error) {
-		return c.db.QueryxContext(ctx, query, args...)
+		return c.reader(ctx).QueryxContext(ctx, query, args...)
 	})
 	if err != nil {
 		return nil, err
@@ -381,10 +404,11 @@ func (c *ClientSqlx) Queryx(ctx context.Context, query string, args ...any) (*sq
 }
 
 func (c *ClientSqlx) Select(ctx context.Context, dest any, query string, args ...any) error {
+	query = c.db.Rebind(query)
 	c.logger.Debug(ctx, "> %s %q", query, args)
 
 	_, err := c.executor.Execute(ctx, func(ctx context.Context) (any, error) {
-		return nil, c.db.SelectContext(ctx, dest, query, args...)
+		return nil, c.reader(ctx).SelectContext(ctx, dest, query, args...)
 	})
 
 	return err
@@ -394,7 +418,7 @@ func (c *ClientSqlx) NamedSelect(ctx context.Context, dest any, query string, ar
 	c.logger.Debug(ctx, "> %s %q", query, arg)
 
 	_, err := c.executor.Execute(ctx, func(ctx context.Context) (any, error) {
-		stmt, err := c.db.PrepareNamedContext(ctx, query)
+		stmt, err := c.reader(ctx).PrepareNamedContext(ctx, query)
 		if err != nil {
 			return nil, err
 		}
@@ -412,10 +436,11 @@ func (c *ClientSqlx) NamedSelect(ctx context.Context, dest any, query string, ar
 }
 
 func (c *ClientSqlx) Get(ctx context.Context, dest any, query string, args ...any) error {
+	query = c.db.Rebind(query)
 	c.logger.Debug(ctx, "> %s %q", query, args)
 
 	_, err := c.executor.Execute(ctx, func(ctx context.Context) (any, error) {
-		return nil, c.db.GetContext(ctx, dest, query, args...)
+		return nil, c.reader(ctx).GetContext(ctx, dest, query, args...)
 	})
 
 	return err
@@ -479,8 +504,28 @@ func (c *ClientSqlx) Close() error {
 	return c.db.Close()
 }
 
+// reader returns the connection to use for reading queries. These go to the replicas if there are any, unless the
+// context forces the primary or all replicas are unhealthy.
+func (c *ClientSqlx) reader(ctx context.Context) *sqlx.DB {
+	if c.replicas == nil || IsForcedPrimary(ctx) {
+		return c.db
+	}
+
+	if replica, ok := c.replicas.Get(); ok {
+		return replica
+	}
+
+	return c.db
+}
+
 func ClientWithExecutor(executor exec.Executor) ClientOption {
 	return func(c *ClientSqlx) {
 		c.executor = executor
 	}
 }
+
+func ClientWithReplicas(replicas *ReplicaPool) ClientOption {
+	return func(c *ClientSqlx) {
+		c.replicas = replicas
+	}
+}
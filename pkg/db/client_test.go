@@ -126,6 +126,41 @@ func TestClient_Exec(t *testing.T) {
 	sqlMock.ExpectClose()
 }
 
+func TestClient_RebindPostgres(t *testing.T) {
+	ctx := t.Context()
+
+	dbMock, sqlMock, err := goSqlMock.New()
+	assert.NoError(t, err)
+
+	loggerMock := logMocks.NewLoggerMock(logMocks.WithMockAll)
+	client := db.NewClientWithInterfaces(loggerMock, sqlx.NewDb(dbMock, db.DriverPostgres), exec.NewDefaultExecutor())
+
+	sqlMock.ExpectExec(`UPDATE campaigns SET name = \$1 WHERE id = \$2`).WithArgs("new_name", 2).WillReturnResult(goSqlMock.NewResult(0, 1))
+	sqlMock.ExpectQuery(`SELECT name FROM campaigns WHERE id = \$1`).WithArgs(2).WillReturnRows(goSqlMock.NewRows([]string{"name"}).AddRow("new_name"))
+	sqlMock.ExpectQuery(`SELECT name FROM campaigns WHERE id > \$1`).WithArgs(1).WillReturnRows(goSqlMock.NewRows([]string{"name"}).AddRow("new_name"))
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(`DELETE FROM campaigns WHERE id = \$1`).WithArgs(2).WillReturnResult(goSqlMock.NewResult(0, 1))
+	sqlMock.ExpectCommit()
+
+	_, err = client.Exec(ctx, "UPDATE campaigns SET name = ? WHERE id = ?", "new_name", 2)
+	assert.NoError(t, err)
+
+	var name string
+	err = client.Get(ctx, &name, "SELECT name FROM campaigns WHERE id = ?", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "new_name", name)
+
+	var names []string
+	err = client.Select(ctx, &names, "SELECT name FROM campaigns WHERE id > ?", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"new_name"}, names)
+
+	_, err = client.ExecMultiInTx(ctx, db.SqlFmt("DELETE FROM campaigns WHERE id = ?", nil, 2))
+	assert.NoError(t, err)
+
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
 func getMocks(t *testing.T) (db.Client, goSqlMock.Sqlmock) {
 	dbMock, sqlMock, err := goSqlMock.New()
 	if err != nil {
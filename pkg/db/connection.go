@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 
 	"github.com/jmoiron/sqlx"
@@ -23,7 +24,7 @@ func ProvideConnection(ctx context.Context, config cfg.Config, logger log.Logger
 		return nil, err
 	}
 
-	return ProvideConnectionFromSettings(ctx, logger, name, settings)
+	return ProvideConnectionFromSettings(ctx, config, logger, name, settings)
 }
 
 func NewConnection(ctx context.Context, config cfg.Config, logger log.Logger, name string) (*sqlx.DB, error) {
@@ -35,24 +36,29 @@ func NewConnection(ctx context.Context, config cfg.Config, logger log.Logger, na
 		return nil, err
 	}
 
-	if con, err = NewConnectionFromSettings(ctx, logger, name, settings); err != nil {
+	if con, err = NewConnectionFromSettings(ctx, config, logger, name, settings); err != nil {
 		return nil, err
 	}
 
 	return con, nil
 }
 
-func ProvideConnectionFromSettings(ctx context.Context, logger log.Logger, name string, settings *Settings) (*sqlx.DB, error) {
+func ProvideConnectionFromSettings(ctx context.Context, config cfg.Config, logger log.Logger, name string, settings *Settings) (*sqlx.DB, error) {
 	return appctx.Provide(ctx, connectionCtxKey(fmt.Sprint(settings)), func() (*sqlx.DB, error) {
-		return NewConnectionFromSettings(ctx, logger, name, settings)
+		return NewConnectionFromSettings(ctx, config, logger, name, settings)
 	})
 }
 
-func NewConnectionFromSettings(ctx context.Context, logger log.Logger, name string, settings *Settings) (*sqlx.DB, error) {
+func NewConnectionFromSettings(ctx context.Context, config cfg.Config, logger log.Logger, name string, settings *Settings) (*sqlx.DB, error) {
 	var err error
+	var credentials CredentialsProvider
 	var connection *sqlx.DB
 
-	if connection, err = NewConnectionWithInterfaces(logger, settings); err != nil {
+	if credentials, err = ProvideCredentialsProvider(ctx, config, logger, settings); err != nil {
+		return nil, fmt.Errorf("can not create credentials provider: %w", err)
+	}
+
+	if connection, err = NewConnectionWithCredentials(logger, settings, credentials); err != nil {
 		return nil, fmt.Errorf("can not create connection: %w", err)
 	}
 
@@ -69,23 +75,43 @@ func NewConnectionFromSettings(ctx context.Context, logger log.Logger, name stri
 	return connection, nil
 }
 
+// NewConnectionWithInterfaces connects with the user and password of the settings.
 func NewConnectionWithInterfaces(logger log.Logger, settings *Settings) (*sqlx.DB, error) {
+	return NewConnectionWithCredentials(logger, settings, NewStaticCredentialsProvider(settings.Uri))
+}
+
+// NewConnectionWithCredentials connects with the credentials of the provider. They are requested for every new
+// connection of the pool, so credentials changing over time are picked up without recreating the pool.
+func NewConnectionWithCredentials(logger log.Logger, settings *Settings, credentials CredentialsProvider) (*sqlx.DB, error) {
 	drv, err := GetDriver(logger, settings.Driver)
 	if err != nil {
 		return nil, fmt.Errorf("could not get dsn provider for driver %s", settings.Driver)
 	}
 
-	dsn := drv.GetDSN(settings)
+	if settings.Auth.Type == AuthTypeIam {
+		if settings, err = withIamParameters(settings); err != nil {
+			return nil, err
+		}
+	}
 
-	genDriver, err := getGenericDriver(settings.Driver, dsn)
+	genDriver, err := getGenericDriver(settings.Driver, drv.GetDSN(settings))
 	if err != nil {
 		return nil, fmt.Errorf("could not get driver from %s connection factory: %w", settings.Driver, err)
 	}
 
-	metricDriverId := newMetricDriver(genDriver)
+	connector := &credentialsConnector{
+		driver:      newMetricDriver(genDriver),
+		dsnProvider: drv,
+		settings:    settings,
+		credentials: credentials,
+	}
+
+	// the driver name tells sqlx which placeholders to use
+	db := sqlx.NewDb(sql.OpenDB(connector), settings.Driver)
+
+	if err = db.Ping(); err != nil {
+		_ = db.Close()
 
-	db, err := sqlx.Connect(metricDriverId, dsn)
-	if err != nil {
 		return nil, fmt.Errorf("can not connect: %w", err)
 	}
 
@@ -112,3 +138,91 @@ func getGenericDriver(driverName, dsn string) (driver.Driver, error) {
 
 	return genDriver, nil
 }
+
+// withIamParameters enables the verified transport security RDS requires for IAM auth tokens, unless the parameters
+// are configured explicitly. The servers are verified with the ca bundle of the iam settings or the system roots.
+func withIamParameters(settings *Settings) (*Settings, error) {
+	var defaults map[string]string
+
+	caBundle := settings.Auth.Iam.CaBundle
+
+	switch settings.Driver {
+	case DriverMysql:
+		tlsConfig := "true"
+
+		if _, ok := settings.Parameters["tls"]; !ok && caBundle != "" {
+			var err error
+			if tlsConfig, err = registerMysqlTlsConfig(caBundle); err != nil {
+				return nil, fmt.Errorf("can not set up tls: %w", err)
+			}
+		}
+
+		// the token is sent as cleartext password, which is only done over the verified tls connection
+		defaults = map[string]string{
+			"allowCleartextPasswords": "true",
+			"tls":                     tlsConfig,
+		}
+	case DriverPostgres:
+		defaults = map[string]string{
+			"sslmode": "verify-full",
+		}
+
+		if caBundle != "" {
+			defaults["sslrootcert"] = caBundle
+		}
+	default:
+		return settings, nil
+	}
+
+	iamSettings := *settings
+	iamSettings.Parameters = make(map[string]string, len(settings.Parameters)+len(defaults))
+
+	for key, value := range defaults {
+		iamSettings.Parameters[key] = value
+	}
+
+	for key, value := range settings.Parameters {
+		iamSettings.Parameters[key] = value
+	}
+
+	return &iamSettings, nil
+}
+
+// credentialsConnector opens every connection with the current credentials of the provider. If the database rejects
+// them, the provider gets the chance to refresh them before the connection is tried a second time.
+type credentialsConnector struct {
+	driver      driver.Driver
+	dsnProvider Driver
+	settings    *Settings
+	credentials CredentialsProvider
+}
+
+func (c *credentialsConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.connect(ctx)
+	if err == nil || !IsAuthenticationError(err) {
+		return conn, err
+	}
+
+	if refreshErr := c.credentials.Refresh(ctx); refreshErr != nil {
+		return nil, errors.Join(err, fmt.Errorf("can not refresh credentials: %w", refreshErr))
+	}
+
+	return c.connect(ctx)
+}
+
+func (c *credentialsConnector) Driver() driver.Driver {
+	return c.driver
+}
+
+func (c *credentialsConnector) connect(ctx context.Context) (driver.Conn, error) {
+	user, password, err := c.credentials.GetCredentials(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("can not get credentials: %w", err)
+	}
+
+	settings := *c.settings
+	settings.Uri.User = user
+	settings.Uri.Password = password
+
+	return c.driver.Open(c.dsnProvider.GetDSN(&settings))
+}
@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const (
+	AuthTypeStatic         = "static"
+	AuthTypeIam            = "iam"
+	AuthTypeSecretsManager = "secrets_manager"
+)
+
+type credentialsCtxKey string
+
+// CredentialsProvider provides the user and password every new connection to the database is opened with.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name CredentialsProvider
+type CredentialsProvider interface {
+	GetCredentials(ctx context.Context) (user string, password string, err error)
+	// Refresh is called after the database rejected the credentials. Providers which are able to fetch new credentials
+	// should do so, as the connection is retried once afterward.
+	Refresh(ctx context.Context) error
+}
+
+func ProvideCredentialsProvider(ctx context.Context, config cfg.Config, logger log.Logger, settings *Settings) (CredentialsProvider, error) {
+	key := credentialsCtxKey(fmt.Sprint(settings.Uri, settings.Auth))
+
+	return appctx.Provide(ctx, key, func() (CredentialsProvider, error) {
+		return NewCredentialsProvider(ctx, config, logger, settings)
+	})
+}
+
+func NewCredentialsProvider(ctx context.Context, config cfg.Config, logger log.Logger, settings *Settings) (CredentialsProvider, error) {
+	switch settings.Auth.Type {
+	case AuthTypeStatic, "":
+		return NewStaticCredentialsProvider(settings.Uri), nil
+	case AuthTypeIam:
+		return NewIamCredentialsProvider(ctx, config, logger, settings)
+	case AuthTypeSecretsManager:
+		return NewSecretsManagerCredentialsProvider(ctx, config, logger, &settings.Auth.SecretsManager)
+	default:
+		return nil, fmt.Errorf("unknown auth type %q", settings.Auth.Type)
+	}
+}
+
+type staticCredentialsProvider struct {
+	user     string
+	password string
+}
+
+// NewStaticCredentialsProvider returns the user and password configured for the connection.
+func NewStaticCredentialsProvider(uri SettingsUri) CredentialsProvider {
+	return &staticCredentialsProvider{
+		user:     uri.User,
+		password: uri.Password,
+	}
+}
+
+func (p *staticCredentialsProvider) GetCredentials(_ context.Context) (string, string, error) {
+	return p.user, p.password, nil
+}
+
+func (p *staticCredentialsProvider) Refresh(_ context.Context) error {
+	return nil
+}
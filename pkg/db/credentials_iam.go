@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/rds/auth"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	gosoAws "github.com/justtrackio/gosoline/pkg/cloud/aws"
+	gosoRds "github.com/justtrackio/gosoline/pkg/cloud/aws/rds"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type iamCredentialsProvider struct {
+	clock       clock.Clock
+	credentials aws.CredentialsProvider
+	region      string
+	endpoint    string
+	user        string
+	refresh     time.Duration
+
+	lck       sync.Mutex
+	token     string
+	createdAt time.Time
+}
+
+// NewIamCredentialsProvider generates RDS IAM auth tokens for the configured user. The region and the aws credentials
+// are taken from the settings of the rds client named in the iam auth settings.
+func NewIamCredentialsProvider(ctx context.Context, config cfg.Config, logger log.Logger, settings *Settings) (CredentialsProvider, error) {
+	if settings.Uri.User == "" {
+		return nil, fmt.Errorf("iam auth requires the db user to be configured")
+	}
+
+	clientCfg := &gosoRds.ClientConfig{}
+	if err := gosoAws.UnmarshalClientSettings(config, &clientCfg.Settings, "rds", settings.Auth.Iam.ClientName); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal RDS client settings: %w", err)
+	}
+
+	awsConfig, err := gosoAws.DefaultClientConfig(ctx, config, logger, clientCfg)
+	if err != nil {
+		return nil, fmt.Errorf("can not initialize aws config: %w", err)
+	}
+
+	return NewIamCredentialsProviderWithInterfaces(clock.Provider, awsConfig.Credentials, awsConfig.Region, settings), nil
+}
+
+func NewIamCredentialsProviderWithInterfaces(clock clock.Clock, credentials aws.CredentialsProvider, region string, settings *Settings) CredentialsProvider {
+	return &iamCredentialsProvider{
+		clock:       clock,
+		credentials: credentials,
+		region:      region,
+		endpoint:    net.JoinHostPort(settings.Uri.Host, strconv.Itoa(settings.Uri.Port)),
+		user:        settings.Uri.User,
+		refresh:     settings.Auth.Iam.TokenRefresh,
+	}
+}
+
+func (p *iamCredentialsProvider) GetCredentials(ctx context.Context) (string, string, error) {
+	p.lck.Lock()
+	defer p.lck.Unlock()
+
+	// a token is only checked when opening a connection, so it is replaced well before it expires
+	if p.token != "" && p.clock.Since(p.createdAt) < p.refresh {
+		return p.user, p.token, nil
+	}
+
+	createdAt := p.clock.Now()
+
+	token, err := auth.BuildAuthToken(ctx, p.endpoint, p.region, p.user, p.credentials)
+	if err != nil {
+		return "", "", fmt.Errorf("can not build iam auth token for user %s at %s: %w", p.user, p.endpoint, err)
+	}
+
+	p.token = token
+	p.createdAt = createdAt
+
+	return p.user, p.token, nil
+}
+
+func (p *iamCredentialsProvider) Refresh(_ context.Context) error {
+	p.lck.Lock()
+	defer p.lck.Unlock()
+
+	p.token = ""
+
+	return nil
+}
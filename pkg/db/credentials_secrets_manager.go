@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	gosoSecretsManager "github.com/justtrackio/gosoline/pkg/cloud/aws/secretsmanager"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+// secretsManagerCredentials is the format of the secrets RDS manages and rotates for a database.
+type secretsManagerCredentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type secretsManagerCredentialsProvider struct {
+	logger   log.Logger
+	clock    clock.Clock
+	client   gosoSecretsManager.Client
+	settings *SettingsAuthSecretsManager
+
+	lck       sync.Mutex
+	loaded    bool
+	loadedAt  time.Time
+	user      string
+	password  string
+	versionId string
+}
+
+// NewSecretsManagerCredentialsProvider reads the credentials from a secret. The secret is read again if the database
+// rejects the credentials, which happens after the secret got rotated.
+func NewSecretsManagerCredentialsProvider(ctx context.Context, config cfg.Config, logger log.Logger, settings *SettingsAuthSecretsManager) (CredentialsProvider, error) {
+	if settings.SecretId == "" {
+		return nil, fmt.Errorf("secrets manager auth requires the secret id to be configured")
+	}
+
+	client, err := gosoSecretsManager.ProvideClient(ctx, config, logger, settings.ClientName)
+	if err != nil {
+		return nil, fmt.Errorf("can not create secrets manager client: %w", err)
+	}
+
+	return NewSecretsManagerCredentialsProviderWithInterfaces(logger, clock.Provider, client, settings), nil
+}
+
+func NewSecretsManagerCredentialsProviderWithInterfaces(
+	logger log.Logger,
+	clock clock.Clock,
+	client gosoSecretsManager.Client,
+	settings *SettingsAuthSecretsManager,
+) CredentialsProvider {
+	return &secretsManagerCredentialsProvider{
+		logger:   logger.WithChannel("db-credentials"),
+		clock:    clock,
+		client:   client,
+		settings: settings,
+	}
+}
+
+func (p *secretsManagerCredentialsProvider) GetCredentials(ctx context.Context) (string, string, error) {
+	p.lck.Lock()
+	defer p.lck.Unlock()
+
+	if !p.loaded {
+		if err := p.read(ctx); err != nil {
+			return "", "", err
+		}
+	}
+
+	return p.user, p.password, nil
+}
+
+func (p *secretsManagerCredentialsProvider) Refresh(ctx context.Context) error {
+	p.lck.Lock()
+	defer p.lck.Unlock()
+
+	// every failing connection attempt asks for a refresh, so wrong credentials must not flood secrets manager
+	if p.loaded && p.clock.Since(p.loadedAt) < p.settings.MinRefreshInterval {
+		return nil
+	}
+
+	versionId := p.versionId
+
+	if err := p.read(ctx); err != nil {
+		return err
+	}
+
+	if p.versionId != versionId {
+		p.logger.Info(ctx, "read new version %s of the db credentials from secret %s", p.versionId, p.settings.SecretId)
+	}
+
+	return nil
+}
+
+func (p *secretsManagerCredentialsProvider) read(ctx context.Context) error {
+	out, err := p.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(p.settings.SecretId),
+	})
+	if err != nil {
+		return fmt.Errorf("can not read secret %s: %w", p.settings.SecretId, err)
+	}
+
+	credentials := &secretsManagerCredentials{}
+	if err := json.Unmarshal([]byte(aws.ToString(out.SecretString)), credentials); err != nil {
+		return fmt.Errorf("can not unmarshal credentials of secret %s: %w", p.settings.SecretId, err)
+	}
+
+	p.loaded = true
+	p.loadedAt = p.clock.Now()
+	p.user = credentials.Username
+	p.password = credentials.Password
+	p.versionId = aws.ToString(out.VersionId)
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package db_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/go-sql-driver/mysql"
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	secretsManagerMocks "github.com/justtrackio/gosoline/pkg/cloud/aws/secretsmanager/mocks"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/log"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const authTestDriver = "auth-test"
+
+func init() {
+	sql.Register(authTestDriver, &authTestSqlDriver{})
+	db.AddDriverFactory(authTestDriver, func(_ log.Logger) (db.Driver, error) {
+		return &authTestDriverFactory{}, nil
+	})
+}
+
+func TestIamCredentialsProvider(t *testing.T) {
+	ctx := t.Context()
+	clk := clock.NewFakeClockAt(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC))
+	awsCredentials := &countingCredentialsProvider{
+		CredentialsProvider: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+	}
+	settings := &db.Settings{
+		Uri: db.SettingsUri{
+			Host: "db.example.com",
+			Port: 3306,
+			User: "app",
+		},
+		Auth: db.SettingsAuth{
+			Type: db.AuthTypeIam,
+			Iam: db.SettingsAuthIam{
+				TokenRefresh: 10 * time.Minute,
+			},
+		},
+	}
+
+	provider := db.NewIamCredentialsProviderWithInterfaces(clk, awsCredentials, "eu-central-1", settings)
+
+	user, token, err := provider.GetCredentials(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "app", user)
+	assert.True(t, strings.HasPrefix(token, "db.example.com:3306?Action=connect&DBUser=app&"), token)
+	assert.Contains(t, token, "%2Feu-central-1%2Frds-db%2Faws4_request")
+	assert.Contains(t, token, "X-Amz-Expires=900")
+	assert.Contains(t, token, "X-Amz-Signature=")
+	assert.Equal(t, 1, awsCredentials.retrieved)
+
+	clk.Advance(9 * time.Minute)
+
+	_, cachedToken, err := provider.GetCredentials(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, token, cachedToken, "the token should be reused until it is due for a refresh")
+	assert.Equal(t, 1, awsCredentials.retrieved)
+
+	clk.Advance(time.Minute)
+
+	_, _, err = provider.GetCredentials(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, awsCredentials.retrieved, "the token should be rebuilt when it is due for a refresh")
+
+	require.NoError(t, provider.Refresh(ctx))
+
+	_, _, err = provider.GetCredentials(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, awsCredentials.retrieved, "the token should be rebuilt after it got rejected")
+}
+
+func TestSecretsManagerCredentialsProvider(t *testing.T) {
+	ctx := t.Context()
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	clk := clock.NewFakeClock()
+	client := secretsManagerMocks.NewClient(t)
+	settings := &db.SettingsAuthSecretsManager{
+		SecretId:           "db-credentials",
+		MinRefreshInterval: 10 * time.Second,
+	}
+
+	expectSecret(client, "v1", "app", "old")
+	provider := db.NewSecretsManagerCredentialsProviderWithInterfaces(logger, clk, client, settings)
+
+	user, password, err := provider.GetCredentials(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "app", user)
+	assert.Equal(t, "old", password)
+
+	// the secret was just read, it is not read again that quickly
+	require.NoError(t, provider.Refresh(ctx))
+
+	clk.Advance(10 * time.Second)
+	expectSecret(client, "v2", "app", "new")
+	require.NoError(t, provider.Refresh(ctx))
+
+	user, password, err = provider.GetCredentials(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "app", user)
+	assert.Equal(t, "new", password)
+}
+
+func TestConnection_RefreshesRejectedCredentials(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	client := secretsManagerMocks.NewClient(t)
+	settings := &db.Settings{
+		Driver: authTestDriver,
+		Auth: db.SettingsAuth{
+			Type: db.AuthTypeSecretsManager,
+		},
+	}
+
+	expectSecret(client, "v1", "app", "old")
+	provider := db.NewSecretsManagerCredentialsProviderWithInterfaces(logger, clock.NewFakeClock(), client, &db.SettingsAuthSecretsManager{
+		SecretId: "db-credentials",
+	})
+
+	// the secret got rotated: the database only accepts the new password
+	expectSecret(client, "v2", "app", "new")
+
+	connection, err := db.NewConnectionWithCredentials(logger, settings, provider)
+	require.NoError(t, err)
+	assert.NoError(t, connection.Close())
+}
+
+func TestConnection_FailsWithRejectedCredentials(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	settings := &db.Settings{
+		Driver: authTestDriver,
+		Uri: db.SettingsUri{
+			User:     "app",
+			Password: "old",
+		},
+	}
+
+	_, err := db.NewConnectionWithInterfaces(logger, settings)
+	assert.True(t, db.IsAuthenticationError(err), "expected an authentication error, got %v", err)
+}
+
+func TestConnection_IamFailsWithoutTls(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	settings := &db.Settings{
+		Driver: db.DriverMysql,
+		Uri: db.SettingsUri{
+			User: "app",
+		},
+		Auth: db.SettingsAuth{
+			Type: db.AuthTypeIam,
+			Iam: db.SettingsAuthIam{
+				CaBundle: t.TempDir() + "/missing.pem",
+			},
+		},
+	}
+
+	_, err := db.NewConnectionWithCredentials(logger, settings, db.NewStaticCredentialsProvider(settings.Uri))
+	assert.ErrorContains(t, err, "can not set up tls: can not read ca bundle")
+}
+
+func expectSecret(client *secretsManagerMocks.Client, versionId string, user string, password string) {
+	client.EXPECT().GetSecretValue(mock.Anything, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String("db-credentials"),
+	}).Return(&secretsmanager.GetSecretValueOutput{
+		SecretString: aws.String(fmt.Sprintf(`{"username":%q,"password":%q}`, user, password)),
+		VersionId:    aws.String(versionId),
+	}, nil).Once()
+}
+
+// countingCredentialsProvider counts how often a token got signed.
+type countingCredentialsProvider struct {
+	aws.CredentialsProvider
+	retrieved int
+}
+
+func (p *countingCredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	p.retrieved++
+
+	return p.CredentialsProvider.Retrieve(ctx)
+}
+
+// authTestDriverFactory passes the credentials as dsn to the authTestSqlDriver.
+type authTestDriverFactory struct{}
+
+func (d *authTestDriverFactory) GetDSN(settings *db.Settings) string {
+	return fmt.Sprintf("%s:%s", settings.Uri.User, settings.Uri.Password)
+}
+
+func (d *authTestDriverFactory) GetMigrationDriver(_ *sql.DB, _ string, _ string) (database.Driver, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+// authTestSqlDriver only accepts the user app with the password new.
+type authTestSqlDriver struct{}
+
+func (d *authTestSqlDriver) Open(dsn string) (driver.Conn, error) {
+	if dsn != "app:new" {
+		return nil, &mysql.MySQLError{Number: 1045, Message: "Access denied"}
+	}
+
+	return &authTestConn{}, nil
+}
+
+type authTestConn struct{}
+
+func (c *authTestConn) Prepare(_ string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (c *authTestConn) Close() error {
+	return nil
+}
+
+func (c *authTestConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (c *authTestConn) Ping(_ context.Context) error {
+	return nil
+}
@@ -2,9 +2,13 @@ package db
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"database/sql"
 	"fmt"
+	"hash/crc32"
 	"net"
+	"os"
 	"strconv"
 
 	"github.com/go-sql-driver/mysql"
@@ -67,6 +71,28 @@ func (m *mysqlDriver) GetMigrationDriver(db *sql.DB, database string, migrations
 	})
 }
 
+// registerMysqlTlsConfig registers a tls config verifying the servers with the certificates of the ca bundle and
+// returns its name to be used as tls parameter.
+func registerMysqlTlsConfig(caBundle string) (string, error) {
+	certificates, err := os.ReadFile(caBundle)
+	if err != nil {
+		return "", fmt.Errorf("can not read ca bundle: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(certificates) {
+		return "", fmt.Errorf("the ca bundle %s contains no certificates", caBundle)
+	}
+
+	name := fmt.Sprintf("ca-%08x", crc32.ChecksumIEEE([]byte(caBundle)))
+
+	if err = mysql.RegisterTLSConfig(name, &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}); err != nil {
+		return "", fmt.Errorf("can not register tls config: %w", err)
+	}
+
+	return name, nil
+}
+
 type mysqlLogger struct {
 	logger log.Logger
 }
@@ -0,0 +1,58 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/justtrackio/gosoline/pkg/log"
+	_ "github.com/lib/pq" // registers the postgres sql driver
+)
+
+const DriverPostgres = "postgres"
+
+func init() {
+	AddDriverFactory(DriverPostgres, NewPostgresDriver)
+}
+
+func NewPostgresDriver(logger log.Logger) (Driver, error) {
+	return &postgresDriver{}, nil
+}
+
+type postgresDriver struct{}
+
+func (p *postgresDriver) GetDSN(settings *Settings) string {
+	dsn := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(settings.Uri.User, settings.Uri.Password),
+		Host:   fmt.Sprintf("%s:%d", settings.Uri.Host, settings.Uri.Port),
+		Path:   settings.Uri.Database,
+	}
+
+	// the sslmode is left to the defaults of the driver unless it is configured as a parameter
+	qry := dsn.Query()
+
+	if settings.Timeouts.Timeout > 0 {
+		// postgres only accepts the connect timeout in whole seconds
+		qry.Set("connect_timeout", strconv.Itoa(max(int(settings.Timeouts.Timeout.Seconds()), 1)))
+	}
+
+	// every other parameter is passed to the server as a run-time parameter
+	for k, v := range settings.Parameters {
+		qry.Set(k, v)
+	}
+
+	dsn.RawQuery = qry.Encode()
+
+	return dsn.String()
+}
+
+func (p *postgresDriver) GetMigrationDriver(db *sql.DB, database string, migrationsTable string) (database.Driver, error) {
+	return postgres.WithInstance(db, &postgres.Config{
+		MigrationsTable: migrationsTable,
+		DatabaseName:    database,
+	})
+}
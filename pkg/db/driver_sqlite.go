@@ -0,0 +1,56 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/database/sqlite"
+	"github.com/justtrackio/gosoline/pkg/log"
+	_ "modernc.org/sqlite" // registers the pure go sqlite sql driver
+)
+
+const (
+	DriverSqlite             = "sqlite"
+	sqliteDefaultBusyTimeout = 5 * time.Second
+)
+
+func init() {
+	AddDriverFactory(DriverSqlite, NewSqliteDriver)
+}
+
+func NewSqliteDriver(logger log.Logger) (Driver, error) {
+	return &sqliteDriver{}, nil
+}
+
+type sqliteDriver struct{}
+
+// GetDSN uses the database setting as the path of the database file. To get an in-memory database which is shared
+// by all connections of the process, use a path starting with a slash together with the parameter vfs=memdb.
+func (s *sqliteDriver) GetDSN(settings *Settings) string {
+	// sqlite locks the whole database for writes, so concurrent writers have to wait instead of failing right away
+	busyTimeout := sqliteDefaultBusyTimeout
+	if settings.Timeouts.Timeout > 0 {
+		busyTimeout = settings.Timeouts.Timeout
+	}
+
+	qry := url.Values{}
+	qry.Add("_pragma", "foreign_keys(1)")
+	qry.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", busyTimeout.Milliseconds()))
+
+	// pragmas are applied in order, so a parameter can overwrite the defaults from above
+	for k, v := range settings.Parameters {
+		qry.Add(k, v)
+	}
+
+	return fmt.Sprintf("file:%s?%s", settings.Uri.Database, qry.Encode())
+}
+
+func (s *sqliteDriver) GetMigrationDriver(db *sql.DB, database string, migrationsTable string) (database.Driver, error) {
+	return sqlite.WithInstance(db, &sqlite.Config{
+		MigrationsTable: migrationsTable,
+		DatabaseName:    database,
+	})
+}
@@ -0,0 +1,128 @@
+package db_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqliteDriver_GetDSN(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	driver, err := db.NewSqliteDriver(logger)
+	assert.NoError(t, err)
+
+	settings := &db.Settings{
+		Uri: db.SettingsUri{
+			Database: "/tmp/test.db",
+		},
+	}
+
+	dsn := driver.GetDSN(settings)
+	assert.Equal(t, "file:/tmp/test.db?_pragma=foreign_keys%281%29&_pragma=busy_timeout%285000%29", dsn)
+
+	settings.Timeouts.Timeout = time.Second
+	settings.Parameters = map[string]string{
+		"vfs":     "memdb",
+		"_pragma": "foreign_keys(0)",
+	}
+
+	dsn = driver.GetDSN(settings)
+	assert.Equal(t, "file:/tmp/test.db?_pragma=foreign_keys%281%29&_pragma=busy_timeout%281000%29&_pragma=foreign_keys%280%29&vfs=memdb", dsn)
+}
+
+func TestSqliteDriver_InMemory(t *testing.T) {
+	ctx := t.Context()
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	settings := &db.Settings{
+		Driver: db.DriverSqlite,
+		Uri: db.SettingsUri{
+			Database: fmt.Sprintf("/%s", uuid.New().NewV4()),
+		},
+		Parameters: map[string]string{
+			"vfs": "memdb",
+		},
+		// the in-memory database is gone as soon as the last connection is closed
+		MaxIdleConnections: 1,
+	}
+
+	connection, err := db.NewConnectionWithInterfaces(logger, settings)
+	require.NoError(t, err)
+
+	client := db.NewClientWithInterfaces(logger, connection, exec.NewDefaultExecutor())
+
+	_, err = client.Exec(ctx, `
+		CREATE TABLE users (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL);
+		CREATE TABLE posts (id INTEGER PRIMARY KEY AUTOINCREMENT, user_id INTEGER NOT NULL REFERENCES users (id), title TEXT NOT NULL);
+	`)
+	require.NoError(t, err)
+
+	// the fixtures are written with a connection without foreign keys, so the posts can be written before their users
+	fixtureSettings := *settings
+	fixtureSettings.Parameters = map[string]string{
+		"vfs":     "memdb",
+		"_pragma": "foreign_keys(0)",
+	}
+
+	fixtureConnection, err := db.NewConnectionWithInterfaces(logger, &fixtureSettings)
+	require.NoError(t, err)
+
+	fixtureClient := db.NewClientWithInterfaces(logger, fixtureConnection, exec.NewDefaultExecutor())
+
+	posts := db.NewSqlitePlainFixtureWriterWithInterfaces(logger, fixtureClient, &db.SqlitePlainMetaData{
+		TableName: "posts",
+		Columns:   []string{"id", "user_id", "title"},
+	})
+	err = posts.Write(ctx, []any{db.SqlitePlainFixtureValues{1, 2, "hello"}})
+	require.NoError(t, err)
+
+	users := db.NewSqlitePlainFixtureWriterWithInterfaces(logger, fixtureClient, &db.SqlitePlainMetaData{
+		TableName: "users",
+		Columns:   []string{"id", "name"},
+	})
+	err = users.Write(ctx, []any{
+		db.SqlitePlainFixtureValues{1, "Bob"},
+		db.SqlitePlainFixtureValues{2, "Alice"},
+	})
+	require.NoError(t, err)
+
+	// existing rows are replaced
+	err = users.Write(ctx, []any{db.SqlitePlainFixtureValues{2, "Eve"}})
+	require.NoError(t, err)
+
+	// the foreign keys are enforced by the default connection
+	_, err = client.Exec(ctx, "INSERT INTO posts (user_id, title) VALUES (?, ?)", 3, "nobody")
+	assert.EqualError(t, err, "constraint failed: FOREIGN KEY constraint failed (787)")
+
+	var names []string
+	err = client.Select(ctx, &names, "SELECT name FROM users ORDER BY id")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bob", "Eve"}, names)
+
+	// the purger uses its own connection, which sees the same in-memory database
+	purger, err := db.NewLifeCyclePurgerWithSettings(logger, settings)
+	require.NoError(t, err)
+
+	err = purger.Purge(ctx)
+	require.NoError(t, err)
+
+	var count int
+	err = client.Get(ctx, &count, "SELECT COUNT(*) FROM users")
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	// the autoincrement counters are reset as well
+	_, err = client.Exec(ctx, "INSERT INTO users (name) VALUES (?)", "Mallory")
+	require.NoError(t, err)
+
+	var id int
+	err = client.Get(ctx, &id, "SELECT id FROM users WHERE name = ?", "Mallory")
+	require.NoError(t, err)
+	assert.Equal(t, 1, id)
+}
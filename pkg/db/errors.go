@@ -6,6 +6,15 @@ import (
 
 	"github.com/VividCortex/mysqlerr"
 	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+)
+
+const (
+	pqErrUniqueViolation      pq.ErrorCode = "23505"
+	pqErrSerializationFailure pq.ErrorCode = "40001"
+	pqErrDeadlockDetected     pq.ErrorCode = "40P01"
+	pqErrInvalidPassword      pq.ErrorCode = "28P01"
+	pqErrInvalidAuthorization pq.ErrorCode = "28000"
 )
 
 type DuplicateEntryError struct {
@@ -43,5 +52,28 @@ func IsDuplicateEntryError(err error) bool {
 		return mysqlErr.Number == mysqlerr.ER_DUP_ENTRY
 	}
 
+	pqErr := &pq.Error{}
+
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == pqErrUniqueViolation
+	}
+
 	return errors.Is(err, &DuplicateEntryError{})
 }
+
+// IsAuthenticationError reports whether the database rejected the credentials of a new connection.
+func IsAuthenticationError(err error) bool {
+	mysqlErr := &mysql.MySQLError{}
+
+	if errors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlerr.ER_ACCESS_DENIED_ERROR
+	}
+
+	pqErr := &pq.Error{}
+
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == pqErrInvalidPassword || pqErr.Code == pqErrInvalidAuthorization
+	}
+
+	return false
+}
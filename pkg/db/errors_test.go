@@ -6,6 +6,8 @@ import (
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,6 +24,12 @@ func TestIsDuplicateEntryError(t *testing.T) {
 			Number: 1062,
 		}),
 		fmt.Errorf("error: %w", &db.DuplicateEntryError{}),
+		&pq.Error{
+			Code: "23505",
+		},
+		fmt.Errorf("error: %w", &pq.Error{
+			Code: "23505",
+		}),
 	}
 
 	invalid := []error{
@@ -30,6 +38,9 @@ func TestIsDuplicateEntryError(t *testing.T) {
 		&mysql.MySQLError{
 			Number: 42,
 		},
+		&pq.Error{
+			Code: "23503",
+		},
 	}
 
 	for _, validErr := range valid {
@@ -40,3 +51,21 @@ func TestIsDuplicateEntryError(t *testing.T) {
 		assert.False(t, db.IsDuplicateEntryError(invalidErr))
 	}
 }
+
+func TestCheckDeadlock(t *testing.T) {
+	assert.Equal(t, exec.ErrorTypeRetryable, db.CheckDeadlock(nil, &mysql.MySQLError{Number: 1213}))
+	assert.Equal(t, exec.ErrorTypeRetryable, db.CheckDeadlock(nil, fmt.Errorf("error: %w", &pq.Error{Code: "40P01"})))
+	assert.Equal(t, exec.ErrorTypeRetryable, db.CheckDeadlock(nil, &pq.Error{Code: "40001"}))
+	assert.Equal(t, exec.ErrorTypeUnknown, db.CheckDeadlock(nil, &pq.Error{Code: "23505"}))
+	assert.Equal(t, exec.ErrorTypeUnknown, db.CheckDeadlock(nil, fmt.Errorf("foo")))
+}
+
+func TestIsAuthenticationError(t *testing.T) {
+	assert.True(t, db.IsAuthenticationError(&mysql.MySQLError{Number: 1045}))
+	assert.True(t, db.IsAuthenticationError(fmt.Errorf("error: %w", &pq.Error{Code: "28P01"})))
+	assert.True(t, db.IsAuthenticationError(&pq.Error{Code: "28000"}))
+	assert.False(t, db.IsAuthenticationError(&mysql.MySQLError{Number: 1062}))
+	assert.False(t, db.IsAuthenticationError(&pq.Error{Code: "23505"}))
+	assert.False(t, db.IsAuthenticationError(fmt.Errorf("foo")))
+	assert.False(t, db.IsAuthenticationError(nil))
+}
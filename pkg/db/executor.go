@@ -9,6 +9,7 @@ import (
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/exec"
 	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/lib/pq"
 )
 
 func NewExecutor(config cfg.Config, logger log.Logger, name string, backoffType string, notifier ...exec.Notify) (exec.Executor, error) {
@@ -52,6 +53,11 @@ func CheckDeadlock(result any, err error) exec.ErrorType {
 		return exec.ErrorTypeRetryable
 	}
 
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && (pqErr.Code == pqErrDeadlockDetected || pqErr.Code == pqErrSerializationFailure) {
+		return exec.ErrorTypeRetryable
+	}
+
 	return exec.ErrorTypeUnknown
 }
 
@@ -0,0 +1,176 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/fixtures"
+	"github.com/justtrackio/gosoline/pkg/funk"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const maxPostgresPreparedStatementArgs = 65535
+
+type PostgresPlainFixtureValues []any
+
+// PostgresPlainMetaData describes the table the fixtures are written to. If PrimaryKey is set, existing rows with the
+// same key are updated, otherwise the fixtures are plainly inserted.
+type PostgresPlainMetaData struct {
+	TableName  string
+	Columns    []string
+	PrimaryKey []string
+}
+
+type postgresPlainFixtureWriter struct {
+	logger   log.Logger
+	client   Client
+	metadata *PostgresPlainMetaData
+}
+
+func PostgresPlainFixtureSetFactory[T any](metadata *PostgresPlainMetaData, data fixtures.NamedFixtures[T], options ...fixtures.FixtureSetOption) fixtures.FixtureSetFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (fixtures.FixtureSet, error) {
+		var err error
+		var writer fixtures.FixtureWriter
+
+		if writer, err = NewPostgresPlainFixtureWriter(ctx, config, logger, metadata); err != nil {
+			return nil, fmt.Errorf("failed to create postgres plain fixture writer for %s: %w", metadata.TableName, err)
+		}
+
+		return fixtures.NewSimpleFixtureSet(data, writer, options...), nil
+	}
+}
+
+func NewPostgresPlainFixtureWriter(ctx context.Context, config cfg.Config, logger log.Logger, metadata *PostgresPlainMetaData) (fixtures.FixtureWriter, error) {
+	dbClient, err := ProvideClient(ctx, config, logger, "default")
+	if err != nil {
+		return nil, fmt.Errorf("can not create dbClient: %w", err)
+	}
+
+	return NewPostgresPlainFixtureWriterWithInterfaces(logger, dbClient, metadata), nil
+}
+
+func NewPostgresPlainFixtureWriterWithInterfaces(logger log.Logger, client Client, metadata *PostgresPlainMetaData) fixtures.FixtureWriter {
+	return &postgresPlainFixtureWriter{
+		logger:   logger,
+		client:   client,
+		metadata: metadata,
+	}
+}
+
+func (m *postgresPlainFixtureWriter) newInsertBuilder() squirrel.InsertBuilder {
+	cols := funk.Map(m.metadata.Columns, quoteIdentifier)
+
+	insertBuilder := squirrel.
+		Insert(m.metadata.TableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Columns(cols...)
+
+	if conflict := m.buildConflictClause(); conflict != "" {
+		insertBuilder = insertBuilder.Suffix(conflict)
+	}
+
+	return insertBuilder
+}
+
+func (m *postgresPlainFixtureWriter) buildConflictClause() string {
+	if len(m.metadata.PrimaryKey) == 0 {
+		return ""
+	}
+
+	keys := funk.Map(m.metadata.PrimaryKey, quoteIdentifier)
+	updates := make([]string, 0, len(m.metadata.Columns))
+
+	for _, col := range m.metadata.Columns {
+		if funk.Contains(m.metadata.PrimaryKey, col) {
+			continue
+		}
+
+		updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", quoteIdentifier(col), quoteIdentifier(col)))
+	}
+
+	if len(updates) == 0 {
+		return fmt.Sprintf("ON CONFLICT (%s) DO NOTHING", strings.Join(keys, ", "))
+	}
+
+	return fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s", strings.Join(keys, ", "), strings.Join(updates, ", "))
+}
+
+func (m *postgresPlainFixtureWriter) buildSql(fixtures []any) (stmts []string, argss [][]any, err error) {
+	insertBuilder := m.newInsertBuilder()
+	cols := len(m.metadata.Columns)
+	offset := 0
+	var stmt string
+	var args []any
+
+	for i, values := range fixtures {
+		// if we exceed the max amount of parameters for a prepared statement, make it a new statement instead
+		if ((i+1)*cols)-offset > maxPostgresPreparedStatementArgs {
+			offset = i * cols
+
+			stmt, args, err = insertBuilder.ToSql()
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to build sql statement: %w", err)
+			}
+
+			stmts = append(stmts, stmt)
+			argss = append(argss, args)
+			insertBuilder = m.newInsertBuilder()
+		}
+
+		pVals, ok := values.(PostgresPlainFixtureValues)
+		if !ok {
+			return nil, nil, fmt.Errorf("postgresPlainFixtureWriter values for table %s are type %T, but should be db.PostgresPlainFixtureValues", m.metadata.TableName, values)
+		}
+
+		insertBuilder = insertBuilder.Values(pVals...)
+	}
+
+	stmt, args, err = insertBuilder.ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build sql statement: %w", err)
+	}
+
+	stmts = append(stmts, stmt)
+	argss = append(argss, args)
+
+	return stmts, argss, nil
+}
+
+func (m *postgresPlainFixtureWriter) Write(ctx context.Context, fixtures []any) error {
+	if len(fixtures) == 0 {
+		return nil
+	}
+
+	stmts, argss, err := m.buildSql(fixtures)
+	if err != nil {
+		return fmt.Errorf("failed to build sqlers for fixture loading: %w", err)
+	}
+
+	var ress []sql.Result
+	var sqls []Sqler
+
+	// disables the foreign key triggers until the end of the transaction, requires a superuser
+	sqls = append(sqls, SqlFmt("SET LOCAL session_replication_role = replica", nil))
+	for i, stmt := range stmts {
+		sqls = append(sqls, SqlFmt(stmt, nil, argss[i]...))
+	}
+
+	ress, err = m.client.ExecMultiInTx(ctx, sqls...)
+	if err != nil {
+		m.logger.Error(ctx, "error writing fixtures: %w", err)
+
+		return fmt.Errorf("failed to execute fixture loading queries in transaction: %w", err)
+	}
+
+	if len(ress) < len(sqls) {
+		return fmt.Errorf("expected %d results, got %d", len(sqls), len(ress))
+	}
+
+	m.logger.Info(ctx, "loaded %d plain postgres fixtures", len(fixtures))
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package db_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestPostgresPlainFixtureWriterTestSuite(t *testing.T) {
+	suite.Run(t, new(PostgresPlainFixtureWriterTestSuite))
+}
+
+type PostgresPlainFixtureWriterTestSuite struct {
+	suite.Suite
+
+	mock   sqlmock.Sqlmock
+	client db.Client
+}
+
+func (s *PostgresPlainFixtureWriterTestSuite) SetupTest() {
+	var err error
+	var sdb *sql.DB
+
+	sdb, s.mock, err = sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	s.NoError(err)
+
+	xdb := sqlx.NewDb(sdb, "postgres")
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+
+	s.client = db.NewClientWithInterfaces(logger, xdb, exec.NewDefaultExecutor())
+}
+
+func (s *PostgresPlainFixtureWriterTestSuite) TestWrite() {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	writer := db.NewPostgresPlainFixtureWriterWithInterfaces(logger, s.client, &db.PostgresPlainMetaData{
+		TableName: "users",
+		Columns:   []string{"id", "name", "user"},
+	})
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(`SET LOCAL session_replication_role = replica`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectExec(`INSERT INTO users ("id","name","user") VALUES ($1,$2,$3),($4,$5,$6)`).
+		WithArgs(1, "Bob", true, 2, "Alice", false).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	s.mock.ExpectCommit()
+
+	err := writer.Write(s.T().Context(), []any{
+		db.PostgresPlainFixtureValues{1, "Bob", true},
+		db.PostgresPlainFixtureValues{2, "Alice", false},
+	})
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *PostgresPlainFixtureWriterTestSuite) TestWrite_Upsert() {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	writer := db.NewPostgresPlainFixtureWriterWithInterfaces(logger, s.client, &db.PostgresPlainMetaData{
+		TableName:  "users",
+		Columns:    []string{"id", "name"},
+		PrimaryKey: []string{"id"},
+	})
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec(`SET LOCAL session_replication_role = replica`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectExec(`INSERT INTO users ("id","name") VALUES ($1,$2) ON CONFLICT ("id") DO UPDATE SET "name" = EXCLUDED."name"`).
+		WithArgs(1, "Bob").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+
+	err := writer.Write(s.T().Context(), []any{
+		db.PostgresPlainFixtureValues{1, "Bob"},
+	})
+	s.NoError(err)
+	s.NoError(s.mock.ExpectationsWereMet())
+}
+
+func (s *PostgresPlainFixtureWriterTestSuite) TestWrite_WrongValues() {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	writer := db.NewPostgresPlainFixtureWriterWithInterfaces(logger, s.client, &db.PostgresPlainMetaData{
+		TableName: "users",
+		Columns:   []string{"id"},
+	})
+
+	err := writer.Write(s.T().Context(), []any{
+		db.MysqlPlainFixtureValues{1},
+	})
+	s.EqualError(err, "failed to build sqlers for fixture loading: postgresPlainFixtureWriter values for table users are type db.MysqlPlainFixtureValues, but should be db.PostgresPlainFixtureValues")
+}
@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/fixtures"
+	"github.com/justtrackio/gosoline/pkg/funk"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/refl"
+)
+
+type PostgresSqlxMetaData struct {
+	TableName string
+}
+
+type postgresSqlxFixtureWriter struct {
+	logger   log.Logger
+	client   Client
+	metadata *PostgresSqlxMetaData
+}
+
+func PostgresSqlxFixtureSetFactory[T any](metadata *PostgresSqlxMetaData, data fixtures.NamedFixtures[T], options ...fixtures.FixtureSetOption) fixtures.FixtureSetFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (fixtures.FixtureSet, error) {
+		var err error
+		var writer fixtures.FixtureWriter
+
+		if writer, err = NewPostgresSqlxFixtureWriter(ctx, config, logger, metadata); err != nil {
+			return nil, fmt.Errorf("failed to create postgres sqlx fixture writer for %s: %w", metadata.TableName, err)
+		}
+
+		return fixtures.NewSimpleFixtureSet(data, writer, options...), nil
+	}
+}
+
+func NewPostgresSqlxFixtureWriter(ctx context.Context, config cfg.Config, logger log.Logger, metadata *PostgresSqlxMetaData) (fixtures.FixtureWriter, error) {
+	dbClient, err := ProvideClient(ctx, config, logger, "default")
+	if err != nil {
+		return nil, fmt.Errorf("can not create dbClient: %w", err)
+	}
+
+	return NewPostgresSqlxFixtureWriterWithInterfaces(logger, dbClient, metadata), nil
+}
+
+func NewPostgresSqlxFixtureWriterWithInterfaces(logger log.Logger, client Client, metadata *PostgresSqlxMetaData) fixtures.FixtureWriter {
+	return &postgresSqlxFixtureWriter{
+		logger:   logger,
+		client:   client,
+		metadata: metadata,
+	}
+}
+
+func (m *postgresSqlxFixtureWriter) Write(ctx context.Context, fixtures []any) error {
+	for _, item := range fixtures {
+		columns := refl.GetTags(item, "db")
+		quoted := funk.Map(columns, quoteIdentifier)
+		placeholders := funk.Map(columns, func(column string) string {
+			return ":" + column
+		})
+
+		sql := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", m.metadata.TableName, strings.Join(quoted, ","), strings.Join(placeholders, ","))
+
+		if _, err := m.client.NamedExec(ctx, sql, item); err != nil {
+			return fmt.Errorf("can not insert item %T into %s: %w", item, m.metadata.TableName, err)
+		}
+	}
+
+	m.logger.Info(ctx, "loaded %d sqlx postgres fixtures", len(fixtures))
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/fixtures"
+	"github.com/justtrackio/gosoline/pkg/funk"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const maxSqlitePreparedStatementArgs = 32766
+
+type SqlitePlainFixtureValues []any
+
+type SqlitePlainMetaData struct {
+	TableName string
+	Columns   []string
+}
+
+type sqlitePlainFixtureWriter struct {
+	logger   log.Logger
+	client   Client
+	metadata *SqlitePlainMetaData
+}
+
+func SqlitePlainFixtureSetFactory[T any](metadata *SqlitePlainMetaData, data fixtures.NamedFixtures[T], options ...fixtures.FixtureSetOption) fixtures.FixtureSetFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (fixtures.FixtureSet, error) {
+		var err error
+		var writer fixtures.FixtureWriter
+
+		if writer, err = NewSqlitePlainFixtureWriter(ctx, config, logger, metadata); err != nil {
+			return nil, fmt.Errorf("failed to create sqlite plain fixture writer for %s: %w", metadata.TableName, err)
+		}
+
+		return fixtures.NewSimpleFixtureSet(data, writer, options...), nil
+	}
+}
+
+func NewSqlitePlainFixtureWriter(ctx context.Context, config cfg.Config, logger log.Logger, metadata *SqlitePlainMetaData) (fixtures.FixtureWriter, error) {
+	settings, err := ReadSettings(config, "default")
+	if err != nil {
+		return nil, fmt.Errorf("can not read db settings: %w", err)
+	}
+
+	if settings.Parameters == nil {
+		settings.Parameters = make(map[string]string)
+	}
+
+	// sqlite can't disable the foreign keys within a transaction, so the fixtures get a connection without them
+	settings.Parameters["_pragma"] = "foreign_keys(0)"
+
+	dbClient, err := NewClientWithSettings(ctx, config, logger, "default", settings)
+	if err != nil {
+		return nil, fmt.Errorf("can not create dbClient: %w", err)
+	}
+
+	return NewSqlitePlainFixtureWriterWithInterfaces(logger, dbClient, metadata), nil
+}
+
+func NewSqlitePlainFixtureWriterWithInterfaces(logger log.Logger, client Client, metadata *SqlitePlainMetaData) fixtures.FixtureWriter {
+	return &sqlitePlainFixtureWriter{
+		logger:   logger,
+		client:   client,
+		metadata: metadata,
+	}
+}
+
+func (m *sqlitePlainFixtureWriter) newInsertBuilder() squirrel.InsertBuilder {
+	return squirrel.
+		Insert(m.metadata.TableName).
+		Options("OR REPLACE").
+		PlaceholderFormat(squirrel.Question).
+		Columns(funk.Map(m.metadata.Columns, quoteIdentifier)...)
+}
+
+func (m *sqlitePlainFixtureWriter) buildSql(fixtures []any) (stmts []string, argss [][]any, err error) {
+	insertBuilder := m.newInsertBuilder()
+	cols := len(m.metadata.Columns)
+	offset := 0
+	var stmt string
+	var args []any
+
+	for i, values := range fixtures {
+		// if we exceed the max amount of parameters for a prepared statement, make it a new statement instead
+		if ((i+1)*cols)-offset > maxSqlitePreparedStatementArgs {
+			offset = i * cols
+
+			stmt, args, err = insertBuilder.ToSql()
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to build sql statement: %w", err)
+			}
+
+			stmts = append(stmts, stmt)
+			argss = append(argss, args)
+			insertBuilder = m.newInsertBuilder()
+		}
+
+		pVals, ok := values.(SqlitePlainFixtureValues)
+		if !ok {
+			return nil, nil, fmt.Errorf("sqlitePlainFixtureWriter values for table %s are type %T, but should be db.SqlitePlainFixtureValues", m.metadata.TableName, values)
+		}
+
+		insertBuilder = insertBuilder.Values(pVals...)
+	}
+
+	stmt, args, err = insertBuilder.ToSql()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build sql statement: %w", err)
+	}
+
+	stmts = append(stmts, stmt)
+	argss = append(argss, args)
+
+	return stmts, argss, nil
+}
+
+func (m *sqlitePlainFixtureWriter) Write(ctx context.Context, fixtures []any) error {
+	if len(fixtures) == 0 {
+		return nil
+	}
+
+	stmts, argss, err := m.buildSql(fixtures)
+	if err != nil {
+		return fmt.Errorf("failed to build sqlers for fixture loading: %w", err)
+	}
+
+	var ress []sql.Result
+	var sqls []Sqler
+
+	for i, stmt := range stmts {
+		sqls = append(sqls, SqlFmt(stmt, nil, argss[i]...))
+	}
+
+	ress, err = m.client.ExecMultiInTx(ctx, sqls...)
+	if err != nil {
+		m.logger.Error(ctx, "error writing fixtures: %w", err)
+
+		return fmt.Errorf("failed to execute fixture loading queries in transaction: %w", err)
+	}
+
+	if len(ress) < len(sqls) {
+		return fmt.Errorf("expected %d results, got %d", len(sqls), len(ress))
+	}
+
+	m.logger.Info(ctx, "loaded %d plain sqlite fixtures", len(fixtures))
+
+	return nil
+}
@@ -36,9 +36,14 @@ var _ LifecycleManager = (*lifecycleManager)(nil)
 func NewLifecycleManager(name string, settings *Settings) reslife.LifeCycleerFactory {
 	return func(ctx context.Context, config cfg.Config, logger log.Logger) (reslife.LifeCycleer, error) {
 		var err error
+		var credentials CredentialsProvider
 		var purger reslife.Purger
 
-		if purger, err = NewLifeCyclePurgerWithSettings(logger, settings); err != nil {
+		if credentials, err = ProvideCredentialsProvider(ctx, config, logger, settings); err != nil {
+			return nil, fmt.Errorf("can not create credentials provider: %w", err)
+		}
+
+		if purger, err = NewLifeCyclePurgerWithCredentials(logger, settings, credentials); err != nil {
 			return nil, err
 		}
 
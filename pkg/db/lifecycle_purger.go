@@ -34,10 +34,14 @@ func NewLifeCyclePurger(config cfg.Config, logger log.Logger, connectionName str
 }
 
 func NewLifeCyclePurgerWithSettings(logger log.Logger, settings *Settings) (*LifeCyclePurger, error) {
+	return NewLifeCyclePurgerWithCredentials(logger, settings, NewStaticCredentialsProvider(settings.Uri))
+}
+
+func NewLifeCyclePurgerWithCredentials(logger log.Logger, settings *Settings, credentials CredentialsProvider) (*LifeCyclePurger, error) {
 	var err error
 	var db *sqlx.DB
 
-	if db, err = NewConnectionWithInterfaces(logger, settings); err != nil {
+	if db, err = NewConnectionWithCredentials(logger, settings, credentials); err != nil {
 		return nil, fmt.Errorf("could not connect to database: %w", err)
 	}
 
@@ -76,7 +80,18 @@ func (p LifeCyclePurger) Purge(ctx context.Context) (err error) {
 func (p LifeCyclePurger) getTables(ctx context.Context) ([]string, error) {
 	var tables []string
 
-	err := p.db.SelectContext(ctx, &tables, "SELECT TABLE_NAME FROM information_schema.TABLES WHERE TABLE_SCHEMA = ? AND TABLE_TYPE = 'BASE TABLE'", p.settings.Uri.Database)
+	var err error
+
+	switch p.settings.Driver {
+	case DriverPostgres:
+		err = p.db.SelectContext(ctx, &tables, "SELECT table_name FROM information_schema.tables WHERE table_schema = current_schema() AND table_type = 'BASE TABLE'")
+	case DriverSqlite:
+		// sqlite_sequence holds the current values of the autoincrement columns and is purged to reset them
+		err = p.db.SelectContext(ctx, &tables, "SELECT name FROM sqlite_master WHERE type = 'table' AND (name = 'sqlite_sequence' OR name NOT LIKE 'sqlite\\_%' ESCAPE '\\')")
+	default:
+		err = p.db.SelectContext(ctx, &tables, "SELECT TABLE_NAME FROM information_schema.TABLES WHERE TABLE_SCHEMA = ? AND TABLE_TYPE = 'BASE TABLE'", p.settings.Uri.Database)
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("failed to query tables of database: %w", err)
 	}
@@ -113,17 +128,7 @@ func (p LifeCyclePurger) deleteTables(ctx context.Context, tables []string) erro
 		}
 	}()
 
-	// Build a single multi-statement query with all DELETEs
-	// This reduces round trips and improves performance significantly
-	var statements []string
-	statements = append(statements, "SET FOREIGN_KEY_CHECKS = 0")
-	for _, table := range tables {
-		statements = append(statements, fmt.Sprintf("TRUNCATE TABLE `%s`", table))
-	}
-	statements = append(statements, "SET FOREIGN_KEY_CHECKS = 1")
-
-	query := strings.Join(statements, "; ")
-	if _, err = tx.ExecContext(ctx, query); err != nil {
+	if _, err = tx.ExecContext(ctx, p.buildPurgeQuery(tables)); err != nil {
 		return fmt.Errorf("failed to purge tables: %w", err)
 	}
 
@@ -135,3 +140,33 @@ func (p LifeCyclePurger) deleteTables(ctx context.Context, tables []string) erro
 
 	return nil
 }
+
+func (p LifeCyclePurger) buildPurgeQuery(tables []string) string {
+	// postgres truncates all tables in a single statement, which takes care of the foreign keys between them
+	if p.settings.Driver == DriverPostgres {
+		quoted := funk.Map(tables, quoteIdentifier)
+
+		return fmt.Sprintf("TRUNCATE TABLE %s RESTART IDENTITY CASCADE", strings.Join(quoted, ", "))
+	}
+
+	// sqlite has no truncate and only checks the foreign keys at the end of the transaction with deferred foreign keys
+	if p.settings.Driver == DriverSqlite {
+		statements := []string{"PRAGMA defer_foreign_keys = ON"}
+		for _, table := range tables {
+			statements = append(statements, fmt.Sprintf("DELETE FROM %s", quoteIdentifier(table)))
+		}
+
+		return strings.Join(statements, "; ")
+	}
+
+	// Build a single multi-statement query with all DELETEs
+	// This reduces round trips and improves performance significantly
+	var statements []string
+	statements = append(statements, "SET FOREIGN_KEY_CHECKS = 0")
+	for _, table := range tables {
+		statements = append(statements, fmt.Sprintf("TRUNCATE TABLE `%s`", table))
+	}
+	statements = append(statements, "SET FOREIGN_KEY_CHECKS = 1")
+
+	return strings.Join(statements, "; ")
+}
@@ -2,13 +2,11 @@ package db
 
 import (
 	"context"
-	"database/sql"
 	"database/sql/driver"
 	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/justtrackio/gosoline/pkg/metric"
-	"github.com/justtrackio/gosoline/pkg/uuid"
 )
 
 const (
@@ -21,18 +19,11 @@ type metricDriver struct {
 	metricWriter metric.Writer
 }
 
-func newMetricDriver(driver driver.Driver) string {
-	mw := metric.NewWriter()
-
-	id := uuid.New().NewV4()
-	md := &metricDriver{
+func newMetricDriver(driver driver.Driver) *metricDriver {
+	return &metricDriver{
 		Driver:       driver,
-		metricWriter: mw,
+		metricWriter: metric.NewWriter(),
 	}
-
-	sql.Register(id, md)
-
-	return id
 }
 
 func (m *metricDriver) Open(dsn string) (driver.Conn, error) {
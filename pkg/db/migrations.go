@@ -3,7 +3,9 @@ package db
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/justtrackio/gosoline/pkg/log"
@@ -74,6 +76,13 @@ func resetMigrations(ctx context.Context, logger log.Logger, settings *Settings,
 
 	logger.Info(ctx, "resetting database %s to rerun migrations", settings.Uri.Database)
 
+	switch settings.Driver {
+	case DriverPostgres:
+		return resetPostgresMigrations(settings, db)
+	case DriverSqlite:
+		return resetSqliteMigrations(ctx, settings, db)
+	}
+
 	sql := fmt.Sprintf("DROP DATABASE IF EXISTS %s", settings.Uri.Database)
 	if _, err := db.Exec(sql); err != nil {
 		return fmt.Errorf("can not drop database %s: %w", settings.Uri.Database, err)
@@ -91,3 +100,61 @@ func resetMigrations(ctx context.Context, logger log.Logger, settings *Settings,
 
 	return nil
 }
+
+// resetPostgresMigrations recreates the public schema instead of the database, as postgres doesn't allow to drop
+// the database the connection is using.
+func resetPostgresMigrations(settings *Settings, db *sql.DB) error {
+	if _, err := db.Exec("DROP SCHEMA IF EXISTS public CASCADE"); err != nil {
+		return fmt.Errorf("can not drop schema of database %s: %w", settings.Uri.Database, err)
+	}
+
+	if _, err := db.Exec("CREATE SCHEMA public"); err != nil {
+		return fmt.Errorf("can not create schema of database %s: %w", settings.Uri.Database, err)
+	}
+
+	return nil
+}
+
+// resetSqliteMigrations drops all tables and views, as a sqlite database is a file (or memory) without a server to
+// drop it from. The foreign keys are only checked at the end of the transaction, so the order doesn't matter.
+func resetSqliteMigrations(ctx context.Context, settings *Settings, db *sql.DB) error {
+	var err error
+	var tx *sql.Tx
+	var rows *sql.Rows
+	var drops []string
+
+	if rows, err = db.QueryContext(ctx, "SELECT type, name FROM sqlite_master WHERE type IN ('table', 'view') AND name NOT LIKE 'sqlite\\_%' ESCAPE '\\'"); err != nil {
+		return fmt.Errorf("can not query tables of database %s: %w", settings.Uri.Database, err)
+	}
+
+	for rows.Next() {
+		var typ, name string
+		if err = rows.Scan(&typ, &name); err != nil {
+			return errors.Join(fmt.Errorf("can not scan table of database %s: %w", settings.Uri.Database, err), rows.Close())
+		}
+
+		drops = append(drops, fmt.Sprintf("DROP %s IF EXISTS %s", strings.ToUpper(typ), quoteIdentifier(name)))
+	}
+
+	if err = errors.Join(rows.Err(), rows.Close()); err != nil {
+		return fmt.Errorf("can not query tables of database %s: %w", settings.Uri.Database, err)
+	}
+
+	if tx, err = db.BeginTx(ctx, nil); err != nil {
+		return fmt.Errorf("can not begin transaction: %w", err)
+	}
+
+	drops = append([]string{"PRAGMA defer_foreign_keys = ON"}, drops...)
+
+	for _, drop := range drops {
+		if _, err = tx.ExecContext(ctx, drop); err != nil {
+			return errors.Join(fmt.Errorf("can not reset database %s: %w", settings.Uri.Database, err), tx.Rollback())
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("can not commit reset of database %s: %w", settings.Uri.Database, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CredentialsProvider is an autogenerated mock type for the CredentialsProvider type
+type CredentialsProvider struct {
+	mock.Mock
+}
+
+type CredentialsProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CredentialsProvider) EXPECT() *CredentialsProvider_Expecter {
+	return &CredentialsProvider_Expecter{mock: &_m.Mock}
+}
+
+// GetCredentials provides a mock function with given fields: ctx
+func (_m *CredentialsProvider) GetCredentials(ctx context.Context) (string, string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCredentials")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context) (string, string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) string); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CredentialsProvider_GetCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredentials'
+type CredentialsProvider_GetCredentials_Call struct {
+	*mock.Call
+}
+
+// GetCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CredentialsProvider_Expecter) GetCredentials(ctx interface{}) *CredentialsProvider_GetCredentials_Call {
+	return &CredentialsProvider_GetCredentials_Call{Call: _e.mock.On("GetCredentials", ctx)}
+}
+
+func (_c *CredentialsProvider_GetCredentials_Call) Run(run func(ctx context.Context)) *CredentialsProvider_GetCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CredentialsProvider_GetCredentials_Call) Return(user string, password string, err error) *CredentialsProvider_GetCredentials_Call {
+	_c.Call.Return(user, password, err)
+	return _c
+}
+
+func (_c *CredentialsProvider_GetCredentials_Call) RunAndReturn(run func(context.Context) (string, string, error)) *CredentialsProvider_GetCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with given fields: ctx
+func (_m *CredentialsProvider) Refresh(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CredentialsProvider_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type CredentialsProvider_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CredentialsProvider_Expecter) Refresh(ctx interface{}) *CredentialsProvider_Refresh_Call {
+	return &CredentialsProvider_Refresh_Call{Call: _e.mock.On("Refresh", ctx)}
+}
+
+func (_c *CredentialsProvider_Refresh_Call) Run(run func(ctx context.Context)) *CredentialsProvider_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CredentialsProvider_Refresh_Call) Return(_a0 error) *CredentialsProvider_Refresh_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CredentialsProvider_Refresh_Call) RunAndReturn(run func(context.Context) error) *CredentialsProvider_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCredentialsProvider creates a new instance of CredentialsProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCredentialsProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CredentialsProvider {
+	mock := &CredentialsProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
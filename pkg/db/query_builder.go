@@ -2,6 +2,7 @@ package db
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/justtrackio/gosoline/pkg/funk"
@@ -65,3 +66,9 @@ func (b *RawQueryBuilder) Page(offset int, size int) QueryBuilder {
 
 	return b
 }
+
+// quoteIdentifier quotes table and column names the way the sql standard does (and postgres and sqlite follow), as
+// they might contain keywords.
+func quoteIdentifier(identifier string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+}
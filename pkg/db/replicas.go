@@ -0,0 +1,239 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/kernel"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type (
+	forcedPrimaryCtxKey struct{}
+	replicaPoolCtxKey   string
+)
+
+// WithForcedPrimary returns a context which makes the client read from the primary instead of the replicas. Use it to
+// read your own writes, as the replicas might not have caught up with the primary yet.
+func WithForcedPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forcedPrimaryCtxKey{}, true)
+}
+
+func IsForcedPrimary(ctx context.Context) bool {
+	forced, ok := ctx.Value(forcedPrimaryCtxKey{}).(bool)
+
+	return ok && forced
+}
+
+type replica struct {
+	host    string
+	db      *sqlx.DB
+	healthy atomic.Bool
+}
+
+// ReplicaPool hands out the connections to the read replicas in a round-robin fashion. As a module of the kernel, the
+// pool pings the replicas in the background and skips them as long as they are unhealthy. The connections are closed
+// when the module stops. The application runs the modules of all pools with ReplicaPoolModuleFactory.
+type ReplicaPool struct {
+	kernel.BackgroundModule
+	kernel.EssentialStage
+
+	logger   log.Logger
+	clock    clock.Clock
+	settings *SettingsReplicas
+	replicas []*replica
+	next     atomic.Uint64
+}
+
+// ReplicaPoolModuleFactory adds a module running the health checks of the replica pool and closing its connections on
+// shutdown for every db client with replicas configured.
+func ReplicaPoolModuleFactory(ctx context.Context, config cfg.Config, logger log.Logger) (map[string]kernel.ModuleFactory, error) {
+	modules := map[string]kernel.ModuleFactory{}
+
+	clients, err := config.GetStringMap("db", map[string]any{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get db settings: %w", err)
+	}
+
+	for name := range clients {
+		settings, err := ReadSettings(config, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(settings.Replicas.Hosts) == 0 {
+			continue
+		}
+
+		pool, err := ProvideReplicaPool(ctx, config, logger, name, settings)
+		if err != nil {
+			return nil, fmt.Errorf("can not create replica pool for db client %s: %w", name, err)
+		}
+
+		moduleName := fmt.Sprintf("db-replicas-%s", name)
+		modules[moduleName] = func(ctx context.Context, config cfg.Config, logger log.Logger) (kernel.Module, error) {
+			return pool, nil
+		}
+	}
+
+	return modules, nil
+}
+
+func ProvideReplicaPool(ctx context.Context, config cfg.Config, logger log.Logger, name string, settings *Settings) (*ReplicaPool, error) {
+	return appctx.Provide(ctx, replicaPoolCtxKey(name), func() (*ReplicaPool, error) {
+		return NewReplicaPool(ctx, config, logger, name, settings)
+	})
+}
+
+func NewReplicaPool(ctx context.Context, config cfg.Config, logger log.Logger, name string, settings *Settings) (*ReplicaPool, error) {
+	var err error
+	var credentials CredentialsProvider
+	var connection *sqlx.DB
+
+	connections := make(map[string]*sqlx.DB, len(settings.Replicas.Hosts))
+
+	for _, host := range settings.Replicas.Hosts {
+		replicaSettings := *settings
+		replicaSettings.Migrations.Enabled = false
+		replicaSettings.Replicas = SettingsReplicas{}
+
+		if replicaSettings.Uri.Host, replicaSettings.Uri.Port, err = splitReplicaHost(host, settings.Uri.Port); err != nil {
+			return nil, fmt.Errorf("invalid replica host %q for db client %s: %w", host, name, err)
+		}
+
+		// iam auth tokens are bound to the host, so every replica needs its own provider
+		if credentials, err = ProvideCredentialsProvider(ctx, config, logger, &replicaSettings); err != nil {
+			return nil, fmt.Errorf("can not create credentials provider for replica %s of db client %s: %w", host, name, err)
+		}
+
+		if connection, err = NewConnectionWithCredentials(logger, &replicaSettings, credentials); err != nil {
+			return nil, fmt.Errorf("can not connect to replica %s of db client %s: %w", host, name, err)
+		}
+
+		publishConnectionMetrics(connection)
+		connections[host] = connection
+	}
+
+	return NewReplicaPoolWithInterfaces(logger, clock.Provider, &settings.Replicas, connections), nil
+}
+
+func NewReplicaPoolWithInterfaces(logger log.Logger, clock clock.Clock, settings *SettingsReplicas, connections map[string]*sqlx.DB) *ReplicaPool {
+	pool := &ReplicaPool{
+		logger:   logger.WithChannel("db-replicas"),
+		clock:    clock,
+		settings: settings,
+	}
+
+	for _, host := range settings.Hosts {
+		r := &replica{
+			host: host,
+			db:   connections[host],
+		}
+		r.healthy.Store(true)
+
+		pool.replicas = append(pool.replicas, r)
+	}
+
+	return pool
+}
+
+// Get returns the next healthy replica. If there is none, false is returned and the primary should be used instead.
+func (p *ReplicaPool) Get() (*sqlx.DB, bool) {
+	count := uint64(len(p.replicas))
+	start := p.next.Add(1) - 1
+
+	for i := range count {
+		r := p.replicas[(start+i)%count]
+
+		if r.healthy.Load() {
+			return r.db, true
+		}
+	}
+
+	return nil, false
+}
+
+// Run checks the health of the replicas until the context is canceled and closes the connections to the replicas
+// afterward.
+func (p *ReplicaPool) Run(ctx context.Context) error {
+	defer p.close(ctx)
+
+	if p.settings.HealthCheckInterval <= 0 {
+		<-ctx.Done()
+
+		return nil
+	}
+
+	ticker := p.clock.NewTicker(p.settings.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.Chan():
+			for _, r := range p.replicas {
+				p.checkHealth(ctx, r)
+			}
+		}
+	}
+}
+
+func (p *ReplicaPool) checkHealth(ctx context.Context, r *replica) {
+	ctx, cancel := context.WithTimeout(ctx, p.settings.HealthCheckTimeout)
+	defer cancel()
+
+	err := r.db.PingContext(ctx)
+	healthy := err == nil
+
+	// a ping canceled by the shutdown says nothing about the replica
+	if !healthy && errors.Is(ctx.Err(), context.Canceled) {
+		return
+	}
+
+	if wasHealthy := r.healthy.Swap(healthy); wasHealthy == healthy {
+		return
+	}
+
+	if healthy {
+		p.logger.Info(ctx, "replica %s is healthy again", r.host)
+	} else {
+		p.logger.Warn(ctx, "replica %s is unhealthy and won't be used until it recovers: %s", r.host, err)
+	}
+}
+
+func (p *ReplicaPool) close(ctx context.Context) {
+	for _, r := range p.replicas {
+		if err := r.db.Close(); err != nil {
+			p.logger.Warn(ctx, "can not close the connection to replica %s: %s", r.host, err)
+		}
+	}
+}
+
+func splitReplicaHost(host string, defaultPort int) (string, int, error) {
+	// without a port, the replica listens on the same one as the primary
+	if !strings.Contains(host, ":") {
+		return host, defaultPort, nil
+	}
+
+	hostname, port, err := net.SplitHostPort(host)
+	if err != nil {
+		return "", 0, err
+	}
+
+	portNumber, err := strconv.Atoi(port)
+	if err != nil {
+		return "", 0, fmt.Errorf("can not parse port %q: %w", port, err)
+	}
+
+	return hostname, portNumber, nil
+}
@@ -0,0 +1,188 @@
+package db_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	goSqlMock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReplicaPoolTestSuite struct {
+	suite.Suite
+
+	clock       clock.FakeClock
+	primary     goSqlMock.Sqlmock
+	replicaMock map[string]goSqlMock.Sqlmock
+	pool        *db.ReplicaPool
+	client      db.Client
+	cancel      context.CancelFunc
+	done        chan error
+}
+
+func TestReplicaPoolTestSuite(t *testing.T) {
+	suite.Run(t, new(ReplicaPoolTestSuite))
+}
+
+func (s *ReplicaPoolTestSuite) SetupTest() {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll)
+	settings := &db.SettingsReplicas{
+		Hosts:               []string{"replica-a", "replica-b"},
+		HealthCheckInterval: time.Second,
+		HealthCheckTimeout:  time.Second,
+	}
+
+	s.clock = clock.NewFakeClock()
+	s.replicaMock = map[string]goSqlMock.Sqlmock{}
+	connections := map[string]*sqlx.DB{}
+
+	for _, host := range settings.Hosts {
+		dbMock, sqlMock, err := goSqlMock.New(goSqlMock.MonitorPingsOption(true))
+		s.Require().NoError(err)
+
+		s.replicaMock[host] = sqlMock
+		connections[host] = sqlx.NewDb(dbMock, "sqlmock")
+	}
+
+	dbMock, sqlMock, err := goSqlMock.New()
+	s.Require().NoError(err)
+
+	s.primary = sqlMock
+	s.pool = db.NewReplicaPoolWithInterfaces(logger, s.clock, settings, connections)
+
+	client := db.NewClientWithInterfaces(logger, sqlx.NewDb(dbMock, "sqlmock"), exec.NewDefaultExecutor())
+	db.ClientWithReplicas(s.pool)(client)
+	s.client = client
+
+	var ctx context.Context
+	ctx, s.cancel = context.WithCancel(s.T().Context())
+	s.done = make(chan error, 1)
+
+	go func() {
+		s.done <- s.pool.Run(ctx)
+	}()
+
+	s.clock.BlockUntilTickers(1)
+}
+
+func (s *ReplicaPoolTestSuite) TearDownTest() {
+	for _, mock := range s.replicaMock {
+		mock.ExpectClose()
+	}
+
+	s.cancel()
+
+	select {
+	case err := <-s.done:
+		s.NoError(err)
+	case <-time.After(time.Second):
+		s.Fail("the health checks of the replica pool didn't stop")
+	}
+
+	// the connections to the replicas are closed on shutdown
+	s.assertExpectations()
+}
+
+func (s *ReplicaPoolTestSuite) TestReadsAreDistributed() {
+	ctx := s.T().Context()
+
+	s.expectCount(s.replicaMock["replica-a"], 1)
+	s.expectCount(s.replicaMock["replica-b"], 2)
+	s.expectCount(s.replicaMock["replica-a"], 3)
+
+	for _, expected := range []int{1, 2, 3} {
+		count, err := s.client.GetSingleScalarValue(ctx, "SELECT COUNT(*) FROM foo")
+		s.NoError(err)
+		s.Equal(expected, count)
+	}
+
+	s.assertExpectations()
+}
+
+func (s *ReplicaPoolTestSuite) TestWritesAndForcedReadsUsePrimary() {
+	ctx := s.T().Context()
+
+	s.primary.ExpectExec("DELETE FROM foo").WillReturnResult(goSqlMock.NewResult(0, 1))
+	s.expectCount(s.primary, 5)
+
+	_, err := s.client.Exec(ctx, "DELETE FROM foo")
+	s.NoError(err)
+
+	count, err := s.client.GetSingleScalarValue(db.WithForcedPrimary(ctx), "SELECT COUNT(*) FROM foo")
+	s.NoError(err)
+	s.Equal(5, count)
+
+	s.assertExpectations()
+}
+
+func (s *ReplicaPoolTestSuite) TestUnhealthyReplicasAreSkipped() {
+	ctx := s.T().Context()
+
+	s.replicaMock["replica-a"].ExpectPing().WillReturnError(fmt.Errorf("connection refused"))
+	s.replicaMock["replica-b"].ExpectPing()
+	s.clock.Advance(time.Second)
+
+	s.Eventually(func() bool {
+		return s.replicaMock["replica-b"].ExpectationsWereMet() == nil
+	}, time.Second, time.Millisecond)
+
+	s.expectCount(s.replicaMock["replica-b"], 1)
+	s.expectCount(s.replicaMock["replica-b"], 2)
+
+	for _, expected := range []int{1, 2} {
+		count, err := s.client.GetSingleScalarValue(ctx, "SELECT COUNT(*) FROM foo")
+		s.NoError(err)
+		s.Equal(expected, count)
+	}
+
+	s.assertExpectations()
+}
+
+func (s *ReplicaPoolTestSuite) TestAllReplicasUnhealthyFallsBackToPrimary() {
+	ctx := s.T().Context()
+
+	s.replicaMock["replica-a"].ExpectPing().WillReturnError(fmt.Errorf("connection refused"))
+	s.replicaMock["replica-b"].ExpectPing().WillReturnError(fmt.Errorf("connection refused"))
+	s.clock.Advance(time.Second)
+
+	s.Eventually(func() bool {
+		_, ok := s.pool.Get()
+
+		return !ok
+	}, time.Second, time.Millisecond)
+
+	s.expectCount(s.primary, 7)
+
+	count, err := s.client.GetSingleScalarValue(ctx, "SELECT COUNT(*) FROM foo")
+	s.NoError(err)
+	s.Equal(7, count)
+
+	s.assertExpectations()
+}
+
+func (s *ReplicaPoolTestSuite) expectCount(mock goSqlMock.Sqlmock, count int) {
+	mock.ExpectQuery("SELECT COUNT").WillReturnRows(goSqlMock.NewRows([]string{"count"}).AddRow(count))
+}
+
+func (s *ReplicaPoolTestSuite) assertExpectations() {
+	s.NoError(s.primary.ExpectationsWereMet())
+
+	for host, mock := range s.replicaMock {
+		s.NoError(mock.ExpectationsWereMet(), "expectations of %s", host)
+	}
+}
+
+func TestWithForcedPrimary(t *testing.T) {
+	ctx := context.Background()
+
+	assert.False(t, db.IsForcedPrimary(ctx))
+	assert.True(t, db.IsForcedPrimary(db.WithForcedPrimary(ctx)))
+}
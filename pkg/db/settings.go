@@ -8,6 +8,7 @@ import (
 )
 
 type Settings struct {
+	Auth                  SettingsAuth      `cfg:"auth"`
 	Charset               string            `cfg:"charset"                 default:"utf8mb4"`
 	Collation             string            `cfg:"collation"               default:"utf8mb4_general_ci"`
 	ConnectionMaxIdleTime time.Duration     `cfg:"connection_max_idletime" default:"120s"`
@@ -19,11 +20,14 @@ type Settings struct {
 	MultiStatements       bool              `cfg:"multi_statements"        default:"true"`
 	Parameters            map[string]string `cfg:"parameters"`
 	ParseTime             bool              `cfg:"parse_time"              default:"true"`
+	Replicas              SettingsReplicas  `cfg:"replicas"`
 	Retry                 SettingsRetry     `cfg:"retry"`
 	Timeouts              SettingsTimeout   `cfg:"timeouts"`
 	Uri                   SettingsUri       `cfg:"uri"`
 }
 
+// SettingsUri addresses the database. The user and the password are required for the static auth type, the iam auth
+// type only requires the user and the secrets_manager auth type reads both from the secret.
 type SettingsUri struct {
 	Host     string `cfg:"host"     default:"localhost" validation:"required"`
 	Port     int    `cfg:"port"     default:"3306"      validation:"required"`
@@ -32,6 +36,38 @@ type SettingsUri struct {
 	Database string `cfg:"database"                     validation:"required"`
 }
 
+// SettingsAuth selects where the credentials of new connections come from. With the static type, the user and the
+// password of the uri are used. The iam type generates RDS IAM auth tokens for the user of the uri, the
+// secrets_manager type reads user and password from a secret in the format RDS uses for its managed secrets.
+type SettingsAuth struct {
+	Type           string                     `cfg:"type"            default:"static"`
+	Iam            SettingsAuthIam            `cfg:"iam"`
+	SecretsManager SettingsAuthSecretsManager `cfg:"secrets_manager"`
+}
+
+type SettingsAuthIam struct {
+	// CaBundle is the path of the pem file with the certificates the server is verified with, e.g. the global bundle
+	// of RDS. Without it, the system roots are used.
+	CaBundle     string        `cfg:"ca_bundle"`
+	ClientName   string        `cfg:"client_name"   default:"default"`
+	TokenRefresh time.Duration `cfg:"token_refresh" default:"10m"` // tokens are valid for 15 minutes
+}
+
+type SettingsAuthSecretsManager struct {
+	ClientName         string        `cfg:"client_name"          default:"default"`
+	SecretId           string        `cfg:"secret_id"`
+	MinRefreshInterval time.Duration `cfg:"min_refresh_interval" default:"10s"`
+}
+
+// SettingsReplicas configure the read replicas of the database. The hosts are given as "host" or "host:port" and
+// share the credentials and the database name with the primary. The replicas are health checked by the modules of
+// ReplicaPoolModuleFactory, which every application runs. A HealthCheckInterval of 0 disables the health checks.
+type SettingsReplicas struct {
+	Hosts               []string      `cfg:"hosts"`
+	HealthCheckInterval time.Duration `cfg:"health_check_interval" default:"10s"`
+	HealthCheckTimeout  time.Duration `cfg:"health_check_timeout"  default:"1s"`
+}
+
 type SettingsRetry struct {
 	Enabled bool `cfg:"enabled" default:"false"`
 }
@@ -38,6 +38,8 @@ type QueryBuilder interface {
 	WithPageSize(size int) QueryBuilder
 	WithDescendingOrder() QueryBuilder
 	WithConsistentRead(consistentRead bool) QueryBuilder
+	// WithExclusiveStartKey continues a query after the item with the given key, see QueryResult.LastEvaluatedKey.
+	WithExclusiveStartKey(key map[string]types.AttributeValue) QueryBuilder
 	Build(result any) (*QueryOperation, error)
 }
 
@@ -55,6 +57,7 @@ type queryBuilder struct {
 	pageSize         *int32
 	scanIndexForward *bool
 	consistentRead   *bool
+	startKey         map[string]types.AttributeValue
 }
 
 func NewQueryBuilder(metadata *Metadata, clock clock.Clock) QueryBuilder {
@@ -186,6 +189,12 @@ func (b *queryBuilder) WithConsistentRead(consistentRead bool) QueryBuilder {
 	return b
 }
 
+func (b *queryBuilder) WithExclusiveStartKey(key map[string]types.AttributeValue) QueryBuilder {
+	b.startKey = key
+
+	return b
+}
+
 func (b *queryBuilder) Build(result any) (*QueryOperation, error) {
 	var err error
 	var keyCondition expression.KeyConditionBuilder
@@ -227,6 +236,7 @@ func (b *queryBuilder) Build(result any) (*QueryOperation, error) {
 		TableName:                 aws.String(b.metadata.TableName),
 		IndexName:                 b.indexName,
 		ConsistentRead:            b.consistentRead,
+		ExclusiveStartKey:         b.startKey,
 		ExpressionAttributeNames:  expr.Names(),
 		ExpressionAttributeValues: expr.Values(),
 		KeyConditionExpression:    expr.KeyCondition(),
@@ -6,6 +6,8 @@ import (
 	expression "github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
 	ddb "github.com/justtrackio/gosoline/pkg/ddb"
 	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 )
 
 // QueryBuilder is an autogenerated mock type for the QueryBuilder type
@@ -221,6 +223,54 @@ func (_c *QueryBuilder_WithDescendingOrder_Call) RunAndReturn(run func() ddb.Que
 	return _c
 }
 
+// WithExclusiveStartKey provides a mock function with given fields: key
+func (_m *QueryBuilder) WithExclusiveStartKey(key map[string]types.AttributeValue) ddb.QueryBuilder {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithExclusiveStartKey")
+	}
+
+	var r0 ddb.QueryBuilder
+	if rf, ok := ret.Get(0).(func(map[string]types.AttributeValue) ddb.QueryBuilder); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ddb.QueryBuilder)
+		}
+	}
+
+	return r0
+}
+
+// QueryBuilder_WithExclusiveStartKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithExclusiveStartKey'
+type QueryBuilder_WithExclusiveStartKey_Call struct {
+	*mock.Call
+}
+
+// WithExclusiveStartKey is a helper method to define mock.On call
+//   - key map[string]types.AttributeValue
+func (_e *QueryBuilder_Expecter) WithExclusiveStartKey(key interface{}) *QueryBuilder_WithExclusiveStartKey_Call {
+	return &QueryBuilder_WithExclusiveStartKey_Call{Call: _e.mock.On("WithExclusiveStartKey", key)}
+}
+
+func (_c *QueryBuilder_WithExclusiveStartKey_Call) Run(run func(key map[string]types.AttributeValue)) *QueryBuilder_WithExclusiveStartKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]types.AttributeValue))
+	})
+	return _c
+}
+
+func (_c *QueryBuilder_WithExclusiveStartKey_Call) Return(_a0 ddb.QueryBuilder) *QueryBuilder_WithExclusiveStartKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryBuilder_WithExclusiveStartKey_Call) RunAndReturn(run func(map[string]types.AttributeValue) ddb.QueryBuilder) *QueryBuilder_WithExclusiveStartKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WithFilter provides a mock function with given fields: filter
 func (_m *QueryBuilder) WithFilter(filter expression.ConditionBuilder) ddb.QueryBuilder {
 	ret := _m.Called(filter)
@@ -560,6 +560,7 @@ func (r *repository) doQuery(ctx context.Context, op *QueryOperation) (*readResu
 	op.result.ItemCount += out.Count
 	op.result.ScannedCount += out.ScannedCount
 	op.result.ConsumedCapacity.add(out.ConsumedCapacity)
+	op.result.LastEvaluatedKey = out.LastEvaluatedKey
 
 	nextPageSize := op.iterator.advance(&out.Count)
 
@@ -254,6 +254,55 @@ func (s *RepositoryTestSuite) TestQuery() {
 	s.EqualValues(expected, result)
 }
 
+func (s *RepositoryTestSuite) TestQuery_WithExclusiveStartKey() {
+	startKey := map[string]types.AttributeValue{
+		"id":  &types.AttributeValueMemberN{Value: "1"},
+		"rev": &types.AttributeValueMemberS{Value: "0"},
+	}
+	lastKey := map[string]types.AttributeValue{
+		"id":  &types.AttributeValueMemberN{Value: "1"},
+		"rev": &types.AttributeValueMemberS{Value: "1"},
+	}
+
+	input := &dynamodb.QueryInput{
+		ExclusiveStartKey: startKey,
+		ExpressionAttributeNames: map[string]string{
+			"#0": "id",
+		},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":0": &types.AttributeValueMemberN{Value: "1"},
+		},
+		KeyConditionExpression: aws.String("#0 = :0"),
+		Limit:                  aws.Int32(1),
+		TableName:              aws.String("applike-test-gosoline-ddb-myModel"),
+		ReturnConsumedCapacity: types.ReturnConsumedCapacityIndexes,
+	}
+	output := &dynamodb.QueryOutput{
+		ConsumedCapacity: &types.ConsumedCapacity{},
+		Count:            1,
+		ScannedCount:     1,
+		Items: []map[string]types.AttributeValue{
+			{
+				"id":  &types.AttributeValueMemberN{Value: "1"},
+				"rev": &types.AttributeValueMemberS{Value: "1"},
+				"foo": &types.AttributeValueMemberS{Value: "baz"},
+			},
+		},
+		LastEvaluatedKey: lastKey,
+	}
+
+	s.client.EXPECT().Query(matcher.Context, input).Return(output, nil)
+
+	result := make([]model, 0)
+
+	qb := s.repo.QueryBuilder().WithHash(1).WithLimit(1).WithExclusiveStartKey(startKey)
+	res, err := s.repo.Query(s.ctx, qb, &result)
+
+	s.NoError(err)
+	s.Equal([]model{{Id: 1, Rev: "1", Foo: "baz"}}, result)
+	s.Equal(lastKey, res.LastEvaluatedKey)
+}
+
 func (s *RepositoryTestSuite) TestQuery_Canceled() {
 	awsErr := &smithy.CanceledError{}
 
@@ -70,6 +70,9 @@ type QueryResult struct {
 	ItemCount        int32
 	ScannedCount     int32
 	ConsumedCapacity *ConsumedCapacity
+	// LastEvaluatedKey is the key of the item the query stopped at because of its limit. It is empty if all items
+	// were read.
+	LastEvaluatedKey map[string]types.AttributeValue
 }
 
 func (q QueryResult) GetRequestCount() int32 {
@@ -0,0 +1,31 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/justtrackio/gosoline/pkg/httpserver/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientCertificateSubjectInterceptor adds the subject of the client certificate verified during the tls handshake to
+// the context of the call. It is available with auth.GetSubject. Calls without a verified certificate are passed on
+// without a subject.
+func ClientCertificateSubjectInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		p, ok := peer.FromContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+		if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+			return handler(ctx, req)
+		}
+
+		subject := auth.SubjectFromCertificate(tlsInfo.State.VerifiedChains[0][0])
+
+		return handler(auth.ContextWithSubject(ctx, subject), req)
+	}
+}
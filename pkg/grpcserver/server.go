@@ -12,8 +12,10 @@ import (
 	"github.com/justtrackio/gosoline/pkg/coffin"
 	"github.com/justtrackio/gosoline/pkg/kernel"
 	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/tlsx"
 	"github.com/justtrackio/gosoline/pkg/tracing"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	_ "google.golang.org/grpc/encoding/gzip"
 	protobuf "google.golang.org/grpc/health/grpc_health_v1"
 )
@@ -91,16 +93,27 @@ func NewWithInterfaces(
 		serverCtx  = ctx
 	)
 
+	options := make([]grpc.ServerOption, 0)
+
+	if s.Tls.Enabled {
+		tlsConfig, err := tlsx.NewConfig(logger, s.Tls)
+		if err != nil {
+			return nil, fmt.Errorf("can not create tls config: %w", err)
+		}
+
+		options = append(options, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		// the subject has to be known before any other interceptor runs
+		interceptors = append([]grpc.UnaryServerInterceptor{ClientCertificateSubjectInterceptor()}, interceptors...)
+	}
+
 	grpcUnaryServerInterceptor := tracingInstrumentor.GrpcUnaryServerInterceptor()
 	if grpcUnaryServerInterceptor != nil {
 		interceptors = append(interceptors, grpcUnaryServerInterceptor)
 	}
 
-	options := []grpc.ServerOption{
-		grpc.UnaryInterceptor(
-			grpc_middleware.ChainUnaryServer(interceptors...),
-		),
-	}
+	options = append(options, grpc.UnaryInterceptor(
+		grpc_middleware.ChainUnaryServer(interceptors...),
+	))
 
 	grpcTracingServerHandler := tracingInstrumentor.GrpcServerHandler()
 	if grpcTracingServerHandler != nil {
@@ -1,5 +1,7 @@
 package grpcserver
 
+import "github.com/justtrackio/gosoline/pkg/tlsx"
+
 // Settings of the Server.
 type Settings struct {
 	// Port where the grpc.Server will be listening to.
@@ -8,6 +10,8 @@ type Settings struct {
 	Health Health `cfg:"health"`
 	// Statistics related settings.
 	Stats Stats `cfg:"stats"`
+	// Tls settings control the tls termination and the verification of client certificates.
+	Tls tlsx.Settings `cfg:"tls"`
 }
 
 // Health settings of the Server.
@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/funk"
+	"github.com/justtrackio/gosoline/pkg/subject"
 )
 
 const Anonymous = "anon"
@@ -16,30 +17,27 @@ type Authenticator interface {
 	IsValid(ginCtx *gin.Context) (bool, error)
 }
 
-type subjectKeyType int
-
-var subjectKey = new(subjectKeyType)
-
-type Subject struct {
-	Name            string
-	Anonymous       bool
-	AuthenticatedBy string
-	Attributes      map[string]any
-}
+// Subject is an alias of subject.Subject, which can be read from the context without depending on gin.
+type Subject = subject.Subject
 
 func RequestWithSubject(ginCtx *gin.Context, subject *Subject) {
-	reqCtx := ginCtx.Request.Context()
-	newCtx := context.WithValue(reqCtx, subjectKey, subject)
+	newCtx := ContextWithSubject(ginCtx.Request.Context(), subject)
 
 	ginCtx.Request = ginCtx.Request.WithContext(newCtx)
 }
 
+// ContextWithSubject returns a copy of the context carrying the subject, e.g. for servers which are not using gin.
+func ContextWithSubject(ctx context.Context, s *Subject) context.Context {
+	return subject.ContextWithSubject(ctx, s)
+}
+
 func GetSubject(ctx context.Context) *Subject {
-	if user, ok := ctx.Value(subjectKey).(*Subject); ok {
-		return user
-	}
+	return subject.GetSubject(ctx)
+}
 
-	panic(fmt.Errorf("there is no subject in the context"))
+// LookupSubject returns the subject of the context and whether there is one.
+func LookupSubject(ctx context.Context) (*Subject, bool) {
+	return subject.LookupSubject(ctx)
 }
 
 func OnlyConfiguredAuthenticators(config cfg.Config, name string, authenticators map[string]Authenticator) (map[string]Authenticator, error) {
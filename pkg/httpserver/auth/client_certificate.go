@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"crypto/x509"
+	"fmt"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"github.com/justtrackio/gosoline/pkg/funk"
+)
+
+const (
+	ByClientCertificate   = "clientCertificate"
+	AttributeCertSubject  = "certificateSubject"
+	AttributeCertIssuer   = "certificateIssuer"
+	AttributeCertSerial   = "certificateSerial"
+	AttributeCertDnsNames = "certificateDnsNames"
+	AttributeCertUris     = "certificateUris"
+	AttributeCertOrgs     = "certificateOrganizations"
+)
+
+type clientCertificateAuthenticator struct{}
+
+// NewClientCertificateAuthenticator authenticates requests by the client certificate verified during the tls handshake.
+// It requires the server to terminate tls with a client auth mode verifying client certificates.
+func NewClientCertificateAuthenticator() Authenticator {
+	return NewClientCertificateAuthenticatorWithInterfaces()
+}
+
+func NewClientCertificateAuthenticatorWithInterfaces() Authenticator {
+	return &clientCertificateAuthenticator{}
+}
+
+func (a *clientCertificateAuthenticator) IsValid(ginCtx *gin.Context) (bool, error) {
+	if ginCtx.Request.TLS == nil {
+		return false, fmt.Errorf("the request was not received via tls")
+	}
+
+	chains := ginCtx.Request.TLS.VerifiedChains
+	if len(chains) == 0 || len(chains[0]) == 0 {
+		return false, fmt.Errorf("no verified client certificate provided")
+	}
+
+	RequestWithSubject(ginCtx, SubjectFromCertificate(chains[0][0]))
+
+	return true, nil
+}
+
+// SubjectFromCertificate creates the subject of a verified client certificate. The name of the subject is the common
+// name of the certificate or, if it has none, its first uri (e.g. a spiffe id) or dns name.
+func SubjectFromCertificate(cert *x509.Certificate) *Subject {
+	uris := funk.Map(cert.URIs, func(uri *url.URL) string {
+		return uri.String()
+	})
+
+	name := cert.Subject.CommonName
+	if name == "" && len(uris) > 0 {
+		name = uris[0]
+	}
+
+	if name == "" && len(cert.DNSNames) > 0 {
+		name = cert.DNSNames[0]
+	}
+
+	return &Subject{
+		Name:            name,
+		Anonymous:       false,
+		AuthenticatedBy: ByClientCertificate,
+		Attributes: map[string]any{
+			AttributeCertSubject:  cert.Subject.String(),
+			AttributeCertIssuer:   cert.Issuer.String(),
+			AttributeCertSerial:   cert.SerialNumber.String(),
+			AttributeCertDnsNames: cert.DNSNames,
+			AttributeCertUris:     uris,
+			AttributeCertOrgs:     cert.Subject.Organization,
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package auth_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/justtrackio/gosoline/pkg/httpserver/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientCertificate_NoTls(t *testing.T) {
+	ginCtx := getClientCertificateContext(nil)
+
+	valid, err := auth.NewClientCertificateAuthenticator().IsValid(ginCtx)
+	assert.False(t, valid)
+	assert.EqualError(t, err, "the request was not received via tls")
+}
+
+func TestClientCertificate_NoVerifiedCertificate(t *testing.T) {
+	ginCtx := getClientCertificateContext(&tls.ConnectionState{})
+
+	valid, err := auth.NewClientCertificateAuthenticator().IsValid(ginCtx)
+	assert.False(t, valid)
+	assert.EqualError(t, err, "no verified client certificate provided")
+}
+
+func TestClientCertificate_Valid(t *testing.T) {
+	cert := &x509.Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      pkix.Name{Organization: []string{"justtrack"}},
+		Issuer:       pkix.Name{CommonName: "internal-ca"},
+		URIs:         []*url.URL{{Scheme: "spiffe", Host: "cluster.local", Path: "/ns/default/sa/billing"}},
+		DNSNames:     []string{"billing.default.svc"},
+	}
+
+	ginCtx := getClientCertificateContext(&tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{cert}},
+	})
+
+	valid, err := auth.NewClientCertificateAuthenticator().IsValid(ginCtx)
+	assert.True(t, valid)
+	assert.NoError(t, err)
+
+	subject := auth.GetSubject(ginCtx.Request.Context())
+	assert.Equal(t, &auth.Subject{
+		Name:            "spiffe://cluster.local/ns/default/sa/billing",
+		Anonymous:       false,
+		AuthenticatedBy: auth.ByClientCertificate,
+		Attributes: map[string]any{
+			auth.AttributeCertSubject:  "O=justtrack",
+			auth.AttributeCertIssuer:   "CN=internal-ca",
+			auth.AttributeCertSerial:   "42",
+			auth.AttributeCertDnsNames: []string{"billing.default.svc"},
+			auth.AttributeCertUris:     []string{"spiffe://cluster.local/ns/default/sa/billing"},
+			auth.AttributeCertOrgs:     []string{"justtrack"},
+		},
+	}, subject)
+}
+
+func getClientCertificateContext(state *tls.ConnectionState) *gin.Context {
+	ginCtx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ginCtx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	ginCtx.Request.TLS = state
+
+	return ginCtx
+}
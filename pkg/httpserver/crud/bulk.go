@@ -0,0 +1,278 @@
+package crud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/jinzhu/gorm"
+	"github.com/jinzhu/inflection"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	dbRepo "github.com/justtrackio/gosoline/pkg/db-repo"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/validation"
+)
+
+const (
+	// BulkModeTransaction writes all items of a bulk request in a single transaction. If one item fails, none of the
+	// items is written.
+	BulkModeTransaction = "transaction"
+	// BulkModeBestEffort writes every item of a bulk request on its own. Failing items don't affect the other items.
+	BulkModeBestEffort = "best_effort"
+
+	bulkParamMode = "mode"
+)
+
+var errBulkItemFailed = errors.New("an item of the bulk request failed")
+
+// TransactionalRepository is a Repository which can write the items of a bulk request in a single transaction, like
+// the repositories of the db-repo package.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name TransactionalRepository
+type TransactionalRepository interface {
+	Repository
+	Transaction(ctx context.Context, do func(ctx context.Context, repo dbRepo.Repository, tx *gorm.DB) error) error
+}
+
+// BulkPatchItem is a single item of a bulk patch request. The input is applied as json merge patch onto the model
+// with the given id.
+type BulkPatchItem struct {
+	Id    *uint      `json:"id" binding:"required"`
+	Input patchInput `json:"input" binding:"required"`
+}
+
+// BulkItemResult is the outcome of a single item of a bulk request. The status is the http status a single request
+// writing the item would have returned.
+type BulkItemResult struct {
+	Status int    `json:"status"`
+	Id     *uint  `json:"id,omitempty"`
+	Result any    `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// BulkOutput contains the results of a bulk request in the order of the items of the request.
+type BulkOutput struct {
+	Results []BulkItemResult `json:"results"`
+}
+
+// AddBulkCrudHandlers adds the bulk create, update, patch and delete handlers: /v{version}/{plural of basePath}/bulk
+// The handlers accept an array of items and respond with the status of every item (HTTP 207). The query parameter mode
+// selects if the items are written in a single transaction (default) or best effort.
+func AddBulkCrudHandlers(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler Handler) error {
+	if err := AddBulkCreateHandler(config, logger, d, version, basePath, handler); err != nil {
+		return fmt.Errorf("failed to add bulk create handler: %w", err)
+	}
+
+	if err := AddBulkUpdateHandler(config, logger, d, version, basePath, handler); err != nil {
+		return fmt.Errorf("failed to add bulk update handler: %w", err)
+	}
+
+	if err := AddBulkPatchHandler(config, logger, d, version, basePath, handler); err != nil {
+		return fmt.Errorf("failed to add bulk patch handler: %w", err)
+	}
+
+	if err := AddBulkDeleteHandler(config, logger, d, version, basePath, handler); err != nil {
+		return fmt.Errorf("failed to add bulk delete handler: %w", err)
+	}
+
+	return nil
+}
+
+func AddBulkCreateHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler CreateHandler) error {
+	ch, err := newBulkCreateHandler(config, logger, handler)
+	if err != nil {
+		return fmt.Errorf("failed to create bulk create handler: %w", err)
+	}
+
+	d.POST(getBulkHandlerPath(version, basePath), httpserver.CreateJsonHandler(ch))
+
+	return nil
+}
+
+func AddBulkUpdateHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler UpdateHandler) error {
+	uh, err := newBulkUpdateHandler(config, logger, handler)
+	if err != nil {
+		return fmt.Errorf("failed to create bulk update handler: %w", err)
+	}
+
+	d.PUT(getBulkHandlerPath(version, basePath), httpserver.CreateJsonHandler(uh))
+
+	return nil
+}
+
+func AddBulkPatchHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler PatchHandler) error {
+	ph, err := newBulkPatchHandler(config, logger, handler)
+	if err != nil {
+		return fmt.Errorf("failed to create bulk patch handler: %w", err)
+	}
+
+	d.PATCH(getBulkHandlerPath(version, basePath), httpserver.CreateJsonHandler(ph))
+
+	return nil
+}
+
+func AddBulkDeleteHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler BaseHandler) error {
+	dh, err := newBulkDeleteHandler(config, logger, handler)
+	if err != nil {
+		return fmt.Errorf("failed to create bulk delete handler: %w", err)
+	}
+
+	d.DELETE(getBulkHandlerPath(version, basePath), httpserver.CreateJsonHandler(dh))
+
+	return nil
+}
+
+func getBulkHandlerPath(version int, basePath string) string {
+	return fmt.Sprintf("/v%d/%s/bulk", version, inflection.Plural(basePath))
+}
+
+// bulkWrite writes the item with the given index of a bulk request using the repository and returns the id of the
+// model and the output of the item.
+type bulkWrite func(ctx context.Context, repo Repository, index int) (id *uint, out any, err error)
+
+// bulkExecutor writes the items of a bulk request in the mode requested by the client and collects their results.
+type bulkExecutor struct {
+	logger   log.Logger
+	settings Settings
+}
+
+func (e bulkExecutor) execute(ctx context.Context, request *httpserver.Request, repo Repository, count int, write bulkWrite) (*httpserver.Response, error) {
+	if count == 0 {
+		return HandleErrorOnWrite(ctx, e.logger, &validation.Error{
+			Errors: []error{errors.New("the bulk request contains no items")},
+		})
+	}
+
+	if e.settings.BulkMaxItems > 0 && count > e.settings.BulkMaxItems {
+		return HandleErrorOnWrite(ctx, e.logger, &validation.Error{
+			Errors: []error{fmt.Errorf("the bulk request contains %d items, at most %d are allowed", count, e.settings.BulkMaxItems)},
+		})
+	}
+
+	mode := BulkModeTransaction
+	if request.Url != nil && request.Url.Query().Has(bulkParamMode) {
+		mode = request.Url.Query().Get(bulkParamMode)
+	}
+
+	results := make([]BulkItemResult, count)
+
+	switch mode {
+	case BulkModeTransaction:
+		txRepo, ok := repo.(TransactionalRepository)
+		if !ok {
+			return HandleErrorOnWrite(ctx, e.logger, &validation.Error{
+				Errors: []error{fmt.Errorf("the repository does not support transactions, use the mode %s", BulkModeBestEffort)},
+			})
+		}
+
+		if err := e.executeTransaction(ctx, txRepo, results, write); err != nil {
+			return HandleErrorOnWrite(ctx, e.logger, err)
+		}
+	case BulkModeBestEffort:
+		for i := range results {
+			results[i], _ = e.writeItem(ctx, repo, i, write)
+		}
+	default:
+		return HandleErrorOnWrite(ctx, e.logger, &validation.Error{
+			Errors: []error{fmt.Errorf("unknown bulk mode %q, expected %s or %s", mode, BulkModeTransaction, BulkModeBestEffort)},
+		})
+	}
+
+	return httpserver.NewJsonResponse(BulkOutput{Results: results}, httpserver.WithStatusCode(http.StatusMultiStatus)), nil
+}
+
+// executeTransaction writes the items until the first one fails. The failed item keeps its result and all other items
+// are marked as failed dependency, as the transaction has been rolled back.
+func (e bulkExecutor) executeTransaction(ctx context.Context, repo TransactionalRepository, results []BulkItemResult, write bulkWrite) error {
+	failed := -1
+
+	err := repo.Transaction(ctx, func(ctx context.Context, txRepo dbRepo.Repository, _ *gorm.DB) error {
+		for i := range results {
+			var ok bool
+
+			if results[i], ok = e.writeItem(ctx, txRepo, i, write); !ok {
+				failed = i
+
+				return errBulkItemFailed
+			}
+		}
+
+		return nil
+	})
+
+	if failed < 0 {
+		return err
+	}
+
+	if !errors.Is(err, errBulkItemFailed) {
+		e.logger.Error(ctx, "failed to roll back bulk transaction: %w", err)
+	}
+
+	for i := range results {
+		if i == failed {
+			continue
+		}
+
+		results[i] = BulkItemResult{
+			Status: http.StatusFailedDependency,
+			Id:     results[i].Id,
+			Error:  "the transaction was rolled back",
+		}
+	}
+
+	return nil
+}
+
+func (e bulkExecutor) writeItem(ctx context.Context, repo Repository, index int, write bulkWrite) (BulkItemResult, bool) {
+	id, out, err := write(ctx, repo, index)
+	if err == nil {
+		return BulkItemResult{
+			Status: http.StatusOK,
+			Id:     id,
+			Result: out,
+		}, true
+	}
+
+	logger := e.logger
+	if id != nil {
+		logger = logger.WithFields(log.Fields{
+			"entity_id": *id,
+		})
+	}
+
+	result := BulkItemResult{
+		Status: http.StatusInternalServerError,
+		Id:     id,
+		Error:  err.Error(),
+	}
+
+	resp, err := HandleErrorOnWrite(ctx, logger, err)
+	validErr := &validation.Error{}
+
+	switch {
+	case resp != nil:
+		result.Status = resp.StatusCode
+	case errors.As(err, &validErr):
+		result.Status = http.StatusBadRequest
+	default:
+		logger.Error(ctx, "failed to write item %d of bulk request: %w", index, err)
+	}
+
+	if result.Status >= http.StatusInternalServerError {
+		result.Error = "internal server error"
+	}
+
+	return result, false
+}
+
+// bulkInputType returns the type of the items of a bulk update request: the id of the model and the input of the
+// update.
+func bulkInputType(input any) reflect.Type {
+	return reflect.StructOf([]reflect.StructField{
+		{Name: "Id", Type: reflect.TypeOf((*uint)(nil)), Tag: `json:"id" binding:"required"`},
+		{Name: "Input", Type: reflect.TypeOf(input), Tag: `json:"input" binding:"required"`},
+	})
+}
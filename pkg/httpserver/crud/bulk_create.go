@@ -0,0 +1,86 @@
+package crud
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type bulkCreateHandler struct {
+	transformer CreateHandler
+	settings    Settings
+	executor    bulkExecutor
+}
+
+func NewBulkCreateHandler(config cfg.Config, logger log.Logger, transformer CreateHandler) (gin.HandlerFunc, error) {
+	ch, err := newBulkCreateHandler(config, logger, transformer)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpserver.CreateJsonHandler(ch), nil
+}
+
+func newBulkCreateHandler(config cfg.Config, logger log.Logger, transformer CreateHandler) (bulkCreateHandler, error) {
+	settings := Settings{}
+	if err := config.UnmarshalKey(SettingsConfigKey, &settings); err != nil {
+		return bulkCreateHandler{}, fmt.Errorf("failed to unmarshal bulk create handler settings: %w", err)
+	}
+
+	ch := bulkCreateHandler{
+		transformer: transformer,
+		settings:    settings,
+		executor: bulkExecutor{
+			logger:   logger,
+			settings: settings,
+		},
+	}
+
+	return ch, nil
+}
+
+func (ch bulkCreateHandler) GetInput() any {
+	inputType := reflect.TypeOf(ch.transformer.GetCreateInput())
+
+	return reflect.New(reflect.SliceOf(inputType)).Interface()
+}
+
+func (ch bulkCreateHandler) GetOutput() any {
+	return &BulkOutput{}
+}
+
+func (ch bulkCreateHandler) Handle(reqCtx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
+	// replace context with a new one to prevent cancellations from client side
+	// include a new timeout to ensure that requests will be cancelled
+	ctx, cancel := exec.WithDelayedCancelContext(reqCtx, ch.settings.WriteTimeout)
+	defer cancel()
+
+	inputs := reflect.ValueOf(request.Body).Elem()
+	apiView := GetApiViewFromHeader(request.Header)
+
+	return ch.executor.execute(ctx, request, ch.transformer.GetRepository(), inputs.Len(), func(ctx context.Context, repo Repository, index int) (*uint, any, error) {
+		model := ch.transformer.GetModel()
+		if err := ch.transformer.TransformCreate(ctx, inputs.Index(index).Interface(), model); err != nil {
+			return nil, nil, err
+		}
+
+		if err := repo.Create(ctx, model); err != nil {
+			return nil, nil, err
+		}
+
+		reload := ch.transformer.GetModel()
+		if err := repo.Read(ctx, model.GetId(), reload); err != nil {
+			return model.GetId(), nil, err
+		}
+
+		out, err := ch.transformer.TransformOutput(ctx, reload, apiView)
+
+		return model.GetId(), out, err
+	})
+}
@@ -0,0 +1,82 @@
+package crud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type bulkDeleteHandler struct {
+	transformer BaseHandler
+	settings    Settings
+	executor    bulkExecutor
+}
+
+// NewBulkDeleteHandler creates a handler deleting several models at once. The request contains the ids of the
+// models: [1, 2, 3]
+func NewBulkDeleteHandler(config cfg.Config, logger log.Logger, transformer BaseHandler) (gin.HandlerFunc, error) {
+	dh, err := newBulkDeleteHandler(config, logger, transformer)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpserver.CreateJsonHandler(dh), nil
+}
+
+func newBulkDeleteHandler(config cfg.Config, logger log.Logger, transformer BaseHandler) (bulkDeleteHandler, error) {
+	settings := Settings{}
+	if err := config.UnmarshalKey(SettingsConfigKey, &settings); err != nil {
+		return bulkDeleteHandler{}, fmt.Errorf("failed to unmarshal bulk delete handler settings: %w", err)
+	}
+
+	dh := bulkDeleteHandler{
+		transformer: transformer,
+		settings:    settings,
+		executor: bulkExecutor{
+			logger:   logger,
+			settings: settings,
+		},
+	}
+
+	return dh, nil
+}
+
+func (dh bulkDeleteHandler) GetInput() any {
+	return &[]uint{}
+}
+
+func (dh bulkDeleteHandler) GetOutput() any {
+	return &BulkOutput{}
+}
+
+func (dh bulkDeleteHandler) Handle(reqCtx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
+	// replace context with a new one to prevent cancellations from client side
+	// include a new timeout to ensure that requests will be cancelled
+	ctx, cancel := exec.WithDelayedCancelContext(reqCtx, dh.settings.WriteTimeout)
+	defer cancel()
+
+	ids := *request.Body.(*[]uint)
+	apiView := GetApiViewFromHeader(request.Header)
+
+	return dh.executor.execute(ctx, request, dh.transformer.GetRepository(), len(ids), func(ctx context.Context, repo Repository, index int) (*uint, any, error) {
+		id := &ids[index]
+
+		model := dh.transformer.GetModel()
+		if err := repo.Read(ctx, id, model); err != nil {
+			return id, nil, err
+		}
+
+		if err := repo.Delete(ctx, model); err != nil {
+			return id, nil, err
+		}
+
+		out, err := dh.transformer.TransformOutput(ctx, model, apiView)
+
+		return id, out, err
+	})
+}
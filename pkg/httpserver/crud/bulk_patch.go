@@ -0,0 +1,100 @@
+package crud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type bulkPatchHandler struct {
+	transformer PatchHandler
+	settings    Settings
+	executor    bulkExecutor
+}
+
+// NewBulkPatchHandler creates a handler patching several models at once. Every item of the request consists of the
+// id of the model and the json merge patch: [{"id": 1, "input": {...}}]
+func NewBulkPatchHandler(config cfg.Config, logger log.Logger, transformer PatchHandler) (gin.HandlerFunc, error) {
+	ph, err := newBulkPatchHandler(config, logger, transformer)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpserver.CreateJsonHandler(ph), nil
+}
+
+func newBulkPatchHandler(config cfg.Config, logger log.Logger, transformer PatchHandler) (bulkPatchHandler, error) {
+	settings := Settings{}
+	if err := config.UnmarshalKey(SettingsConfigKey, &settings); err != nil {
+		return bulkPatchHandler{}, fmt.Errorf("failed to unmarshal bulk patch handler settings: %w", err)
+	}
+
+	ph := bulkPatchHandler{
+		transformer: transformer,
+		settings:    settings,
+		executor: bulkExecutor{
+			logger:   logger,
+			settings: settings,
+		},
+	}
+
+	return ph, nil
+}
+
+func (ph bulkPatchHandler) GetInput() any {
+	return &[]BulkPatchItem{}
+}
+
+func (ph bulkPatchHandler) GetOutput() any {
+	return &BulkOutput{}
+}
+
+func (ph bulkPatchHandler) Handle(reqCtx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
+	// replace context with a new one to prevent cancellations from client side
+	// include a new timeout to ensure that requests will be cancelled
+	ctx, cancel := exec.WithDelayedCancelContext(reqCtx, ph.settings.WriteTimeout)
+	defer cancel()
+
+	items := *request.Body.(*[]BulkPatchItem)
+	apiView := GetApiViewFromHeader(request.Header)
+
+	return ph.executor.execute(ctx, request, ph.transformer.GetRepository(), len(items), func(ctx context.Context, repo Repository, index int) (*uint, any, error) {
+		item := items[index]
+
+		model := ph.transformer.GetModel()
+		if err := repo.Read(ctx, item.Id, model); err != nil {
+			return item.Id, nil, err
+		}
+
+		updateInput, err := ph.transformer.TransformPatch(ctx, model)
+		if err != nil {
+			return item.Id, nil, fmt.Errorf("failed to transform patch: %w", err)
+		}
+
+		if err = applyMergePatch(updateInput, &item.Input); err != nil {
+			return item.Id, nil, err
+		}
+
+		if err = ph.transformer.TransformUpdate(ctx, updateInput, model); err != nil {
+			return item.Id, nil, fmt.Errorf("failed to transform update: %w", err)
+		}
+
+		if err = repo.Update(ctx, model); err != nil {
+			return item.Id, nil, err
+		}
+
+		reload := ph.transformer.GetModel()
+		if err = repo.Read(ctx, item.Id, reload); err != nil {
+			return item.Id, nil, err
+		}
+
+		out, err := ph.transformer.TransformOutput(ctx, reload, apiView)
+
+		return item.Id, out, err
+	})
+}
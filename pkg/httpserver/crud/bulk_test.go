@@ -0,0 +1,206 @@
+package crud_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	configMocks "github.com/justtrackio/gosoline/pkg/cfg/mocks"
+	"github.com/justtrackio/gosoline/pkg/db-repo"
+	dbRepoMocks "github.com/justtrackio/gosoline/pkg/db-repo/mocks"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/httpserver/crud"
+	"github.com/justtrackio/gosoline/pkg/httpserver/crud/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type bulkHandler struct {
+	handler
+	TxRepo *mocks.TransactionalRepository
+}
+
+func (h bulkHandler) GetRepository() crud.Repository {
+	return h.TxRepo
+}
+
+type bulkTestSuite struct {
+	suite.Suite
+
+	config  *configMocks.Config
+	logger  logMocks.LoggerMock
+	handler bulkHandler
+	txRepo  *dbRepoMocks.Repository
+}
+
+func TestBulkTestSuite(t *testing.T) {
+	suite.Run(t, new(bulkTestSuite))
+}
+
+func (s *bulkTestSuite) SetupTest() {
+	s.config = configMocks.NewConfig(s.T())
+	s.config.EXPECT().UnmarshalKey("crud", mock.AnythingOfType("*crud.Settings")).Run(func(key string, val any, additionalDefaults ...cfg.UnmarshalDefaults) {
+		settings := val.(*crud.Settings)
+		settings.WriteTimeout = time.Minute
+		settings.BulkMaxItems = 3
+	}).Return(nil)
+
+	s.logger = logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	s.handler = bulkHandler{
+		handler: newHandler(s.T()),
+		TxRepo:  mocks.NewTransactionalRepository(s.T()),
+	}
+	s.txRepo = dbRepoMocks.NewRepository(s.T())
+}
+
+func (s *bulkTestSuite) expectTransaction() {
+	s.handler.TxRepo.EXPECT().Transaction(matcher.Context, mock.Anything).RunAndReturn(func(ctx context.Context, do func(context.Context, db_repo.Repository, *gorm.DB) error) error {
+		return do(ctx, s.txRepo, nil)
+	}).Once()
+}
+
+func (s *bulkTestSuite) expectRead(repo *mock.Mock, id uint, name string, err error) {
+	repo.On("Read", matcher.Context, mdl.Box(id), &Model{}).Run(func(args mock.Arguments) {
+		model := args.Get(2).(*Model)
+		model.Id = mdl.Box(id)
+		model.Name = mdl.Box(name)
+	}).Return(err).Once()
+}
+
+func (s *bulkTestSuite) TestCreate_Transaction() {
+	s.expectTransaction()
+
+	for i, name := range []string{"foo", "bar"} {
+		id := uint(i + 1)
+
+		s.txRepo.EXPECT().Create(matcher.Context, &Model{Name: mdl.Box(name)}).Run(func(_ context.Context, value db_repo.ModelBased) {
+			value.(*Model).Id = mdl.Box(id)
+		}).Return(nil).Once()
+		s.expectRead(&s.txRepo.Mock, id, name, nil)
+	}
+
+	handler, err := crud.NewBulkCreateHandler(s.config, s.logger, s.handler)
+	s.Require().NoError(err)
+
+	response := httpserver.HttpTest("POST", "/bulk", "/bulk", `[{"name":"foo"},{"name":"bar"}]`, handler)
+
+	s.Equal(http.StatusMultiStatus, response.Code)
+	s.JSONEq(`{"results":[
+		{"status":200,"id":1,"result":{"id":1,"name":"foo","createdAt":null,"updatedAt":null}},
+		{"status":200,"id":2,"result":{"id":2,"name":"bar","createdAt":null,"updatedAt":null}}
+	]}`, response.Body.String())
+}
+
+func (s *bulkTestSuite) TestCreate_InvalidItem() {
+	handler, err := crud.NewBulkCreateHandler(s.config, s.logger, s.handler)
+	s.Require().NoError(err)
+
+	response := httpserver.HttpTest("POST", "/bulk", "/bulk", `[{"name":"foo"},{}]`, handler)
+
+	s.Equal(http.StatusBadRequest, response.Code)
+}
+
+func (s *bulkTestSuite) TestCreate_TooManyItems() {
+	handler, err := crud.NewBulkCreateHandler(s.config, s.logger, s.handler)
+	s.Require().NoError(err)
+
+	response := httpserver.HttpTest("POST", "/bulk", "/bulk", `[{"name":"a"},{"name":"b"},{"name":"c"},{"name":"d"}]`, handler)
+
+	s.Equal(http.StatusBadRequest, response.Code)
+	s.JSONEq(`{"err":"validation: the bulk request contains 4 items, at most 3 are allowed"}`, response.Body.String())
+}
+
+func (s *bulkTestSuite) TestUpdate_TransactionRolledBack() {
+	s.expectTransaction()
+
+	s.expectRead(&s.txRepo.Mock, 1, "foo", nil)
+	s.txRepo.EXPECT().Update(matcher.Context, &Model{Model: db_repo.Model{Id: mdl.Box(uint(1))}, Name: mdl.Box("updated foo")}).Return(nil).Once()
+	s.expectRead(&s.txRepo.Mock, 1, "updated foo", nil)
+	s.expectRead(&s.txRepo.Mock, 2, "", db_repo.NewRecordNotFoundError(2, "model", gorm.ErrRecordNotFound))
+
+	handler, err := crud.NewBulkUpdateHandler(s.config, s.logger, s.handler)
+	s.Require().NoError(err)
+
+	body := `[{"id":1,"input":{"name":"updated foo"}},{"id":2,"input":{"name":"updated bar"}},{"id":3,"input":{"name":"updated baz"}}]`
+	response := httpserver.HttpTest("PUT", "/bulk", "/bulk", body, handler)
+
+	s.Equal(http.StatusMultiStatus, response.Code)
+	s.JSONEq(`{"results":[
+		{"status":424,"id":1,"error":"the transaction was rolled back"},
+		{"status":404,"id":2,"error":"could not find model of type model with id 2: record not found"},
+		{"status":424,"error":"the transaction was rolled back"}
+	]}`, response.Body.String())
+}
+
+func (s *bulkTestSuite) TestUpdate_MissingId() {
+	handler, err := crud.NewBulkUpdateHandler(s.config, s.logger, s.handler)
+	s.Require().NoError(err)
+
+	response := httpserver.HttpTest("PUT", "/bulk", "/bulk", `[{"input":{"name":"updated"}}]`, handler)
+
+	s.Equal(http.StatusBadRequest, response.Code)
+}
+
+func (s *bulkTestSuite) TestPatch_BestEffort() {
+	s.expectRead(&s.handler.TxRepo.Mock, 1, "foo", nil)
+	s.handler.TxRepo.EXPECT().Update(matcher.Context, &Model{Model: db_repo.Model{Id: mdl.Box(uint(1))}, Name: mdl.Box("patched")}).Return(nil).Once()
+	s.expectRead(&s.handler.TxRepo.Mock, 1, "patched", nil)
+	s.expectRead(&s.handler.TxRepo.Mock, 2, "bar", nil)
+	s.handler.TxRepo.EXPECT().Update(matcher.Context, &Model{Model: db_repo.Model{Id: mdl.Box(uint(2))}, Name: mdl.Box("patched")}).Return(fmt.Errorf("connection lost")).Once()
+
+	handler, err := crud.NewBulkPatchHandler(s.config, s.logger, s.handler)
+	s.Require().NoError(err)
+
+	body := `[{"id":1,"input":{"name":"patched"}},{"id":2,"input":{"name":"patched"}}]`
+	response := httpserver.HttpTest("PATCH", "/bulk", "/bulk?mode=best_effort", body, handler)
+
+	s.Equal(http.StatusMultiStatus, response.Code)
+	s.JSONEq(`{"results":[
+		{"status":200,"id":1,"result":{"id":1,"name":"patched","createdAt":null,"updatedAt":null}},
+		{"status":500,"id":2,"error":"internal server error"}
+	]}`, response.Body.String())
+}
+
+func (s *bulkTestSuite) TestDelete_BestEffort() {
+	s.expectRead(&s.handler.TxRepo.Mock, 1, "foo", nil)
+	s.handler.TxRepo.EXPECT().Delete(matcher.Context, &Model{Model: db_repo.Model{Id: mdl.Box(uint(1))}, Name: mdl.Box("foo")}).Return(nil).Once()
+	s.expectRead(&s.handler.TxRepo.Mock, 2, "", db_repo.NewRecordNotFoundError(2, "model", gorm.ErrRecordNotFound))
+
+	handler, err := crud.NewBulkDeleteHandler(s.config, s.logger, s.handler)
+	s.Require().NoError(err)
+
+	response := httpserver.HttpTest("DELETE", "/bulk", "/bulk?mode=best_effort", `[1,2]`, handler)
+
+	s.Equal(http.StatusMultiStatus, response.Code)
+	s.JSONEq(`{"results":[
+		{"status":200,"id":1,"result":{"id":1,"name":"foo","createdAt":null,"updatedAt":null}},
+		{"status":404,"id":2,"error":"could not find model of type model with id 2: record not found"}
+	]}`, response.Body.String())
+}
+
+func (s *bulkTestSuite) TestDelete_NoTransactions() {
+	handler, err := crud.NewBulkDeleteHandler(s.config, s.logger, newHandler(s.T()))
+	s.Require().NoError(err)
+
+	response := httpserver.HttpTest("DELETE", "/bulk", "/bulk", `[1]`, handler)
+
+	s.Equal(http.StatusBadRequest, response.Code)
+	s.JSONEq(`{"err":"validation: the repository does not support transactions, use the mode best_effort"}`, response.Body.String())
+}
+
+func (s *bulkTestSuite) TestDelete_UnknownMode() {
+	handler, err := crud.NewBulkDeleteHandler(s.config, s.logger, s.handler)
+	s.Require().NoError(err)
+
+	response := httpserver.HttpTest("DELETE", "/bulk", "/bulk?mode=eventually", `[1]`, handler)
+
+	s.Equal(http.StatusBadRequest, response.Code)
+	s.JSONEq(`{"err":"validation: unknown bulk mode \"eventually\", expected transaction or best_effort"}`, response.Body.String())
+}
@@ -0,0 +1,95 @@
+package crud
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type bulkUpdateHandler struct {
+	transformer UpdateHandler
+	settings    Settings
+	executor    bulkExecutor
+}
+
+// NewBulkUpdateHandler creates a handler updating several models at once. Every item of the request consists of the
+// id of the model and the update input: [{"id": 1, "input": {...}}]
+func NewBulkUpdateHandler(config cfg.Config, logger log.Logger, transformer UpdateHandler) (gin.HandlerFunc, error) {
+	uh, err := newBulkUpdateHandler(config, logger, transformer)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpserver.CreateJsonHandler(uh), nil
+}
+
+func newBulkUpdateHandler(config cfg.Config, logger log.Logger, transformer UpdateHandler) (bulkUpdateHandler, error) {
+	settings := Settings{}
+	if err := config.UnmarshalKey(SettingsConfigKey, &settings); err != nil {
+		return bulkUpdateHandler{}, fmt.Errorf("failed to unmarshal bulk update handler settings: %w", err)
+	}
+
+	uh := bulkUpdateHandler{
+		transformer: transformer,
+		settings:    settings,
+		executor: bulkExecutor{
+			logger:   logger,
+			settings: settings,
+		},
+	}
+
+	return uh, nil
+}
+
+func (uh bulkUpdateHandler) GetInput() any {
+	itemType := bulkInputType(uh.transformer.GetUpdateInput())
+
+	return reflect.New(reflect.SliceOf(itemType)).Interface()
+}
+
+func (uh bulkUpdateHandler) GetOutput() any {
+	return &BulkOutput{}
+}
+
+func (uh bulkUpdateHandler) Handle(reqCtx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
+	// replace context with a new one to prevent cancellations from client side
+	// include a new timeout to ensure that requests will be cancelled
+	ctx, cancel := exec.WithDelayedCancelContext(reqCtx, uh.settings.WriteTimeout)
+	defer cancel()
+
+	items := reflect.ValueOf(request.Body).Elem()
+	apiView := GetApiViewFromHeader(request.Header)
+
+	return uh.executor.execute(ctx, request, uh.transformer.GetRepository(), items.Len(), func(ctx context.Context, repo Repository, index int) (*uint, any, error) {
+		id := items.Index(index).Field(0).Interface().(*uint)
+		input := items.Index(index).Field(1).Interface()
+
+		model := uh.transformer.GetModel()
+		if err := repo.Read(ctx, id, model); err != nil {
+			return id, nil, err
+		}
+
+		if err := uh.transformer.TransformUpdate(ctx, input, model); err != nil {
+			return id, nil, err
+		}
+
+		if err := repo.Update(ctx, model); err != nil {
+			return id, nil, err
+		}
+
+		reload := uh.transformer.GetModel()
+		if err := repo.Read(ctx, id, reload); err != nil {
+			return id, nil, err
+		}
+
+		out, err := uh.transformer.TransformOutput(ctx, reload, apiView)
+
+		return id, out, err
+	})
+}
@@ -18,9 +18,18 @@ type createHandler struct {
 }
 
 func NewCreateHandler(config cfg.Config, logger log.Logger, transformer CreateHandler) (gin.HandlerFunc, error) {
+	ch, err := newCreateHandler(config, logger, transformer)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpserver.CreateJsonHandler(ch), nil
+}
+
+func newCreateHandler(config cfg.Config, logger log.Logger, transformer CreateHandler) (createHandler, error) {
 	settings := Settings{}
 	if err := config.UnmarshalKey(SettingsConfigKey, &settings); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal create handler settings: %w", err)
+		return createHandler{}, fmt.Errorf("failed to unmarshal create handler settings: %w", err)
 	}
 
 	ch := createHandler{
@@ -29,13 +38,17 @@ func NewCreateHandler(config cfg.Config, logger log.Logger, transformer CreateHa
 		settings:    settings,
 	}
 
-	return httpserver.CreateJsonHandler(ch), nil
+	return ch, nil
 }
 
 func (ch createHandler) GetInput() any {
 	return ch.transformer.GetCreateInput()
 }
 
+func (ch createHandler) GetOutput() any {
+	return getOutput(ch.transformer)
+}
+
 func (ch createHandler) Handle(reqCtx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
 	// replace context with a new one to prevent cancellations from client side
 	// include a new timeout to ensure that requests will be cancelled
@@ -16,6 +16,11 @@ type Model struct {
 	Name *string `json:"name"`
 }
 
+type VersionedModel struct {
+	Model
+	db_repo.Versioning
+}
+
 type Output struct {
 	Id        *uint      `json:"id"`
 	Name      *string    `json:"name"`
@@ -110,6 +115,36 @@ func (h handler) List(_ context.Context, _ *db_repo.QueryBuilder, _ string) (any
 	}, nil
 }
 
+type versionedHandler struct {
+	handler
+}
+
+func (h versionedHandler) GetModel() db_repo.ModelBased {
+	return &VersionedModel{}
+}
+
+func (h versionedHandler) TransformCreate(ctx context.Context, inp any, model db_repo.ModelBased) (err error) {
+	return h.handler.TransformCreate(ctx, inp, &model.(*VersionedModel).Model)
+}
+
+func (h versionedHandler) TransformUpdate(ctx context.Context, inp any, model db_repo.ModelBased) (err error) {
+	return h.handler.TransformUpdate(ctx, inp, &model.(*VersionedModel).Model)
+}
+
+func (h versionedHandler) TransformPatch(ctx context.Context, model db_repo.ModelBased) (any, error) {
+	return h.handler.TransformPatch(ctx, &model.(*VersionedModel).Model)
+}
+
+func (h versionedHandler) TransformOutput(ctx context.Context, model db_repo.ModelBased, apiView string) (any, error) {
+	return h.handler.TransformOutput(ctx, &model.(*VersionedModel).Model, apiView)
+}
+
+func newVersionedHandler(t *testing.T) versionedHandler {
+	return versionedHandler{
+		handler: newHandler(t),
+	}
+}
+
 func newHandler(t *testing.T) handler {
 	repo := mocks.NewRepository(t)
 
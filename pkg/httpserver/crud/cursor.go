@@ -0,0 +1,162 @@
+package crud
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/justtrackio/gosoline/pkg/httpserver/sql"
+)
+
+// cursorPage turns the results of a cursor paginated list into the results of the returned page and its next and prev
+// cursors. The results contain one more row than the limit if there is another page in the direction of the request.
+// The cursors are built from the values returned by the BaseCursorListHandler or, if the list handler doesn't
+// implement it, from the fields of the results.
+type cursorPage struct {
+	handler BaseListHandler
+	keys    []sql.CursorKey
+	page    *sql.Page
+	request *sql.Cursor
+}
+
+func (p cursorPage) build(rows reflect.Value) (page reflect.Value, next string, prev string, err error) {
+	hasMore := rows.Len() > p.page.Limit
+	if hasMore {
+		rows = rows.Slice(0, p.page.Limit)
+	}
+
+	if p.request.Direction == sql.CursorPrev {
+		rows = reverseRows(rows)
+	}
+
+	if rows.Len() == 0 {
+		return rows, "", "", nil
+	}
+
+	// there is a page before this one if we came from it or a prev request found more rows, there is a page after
+	// this one if a next request found more rows or we came from it
+	hasPrev := *p.page.Cursor != "" && p.request.Direction == sql.CursorNext || hasMore && p.request.Direction == sql.CursorPrev
+	hasNext := hasMore && p.request.Direction == sql.CursorNext || p.request.Direction == sql.CursorPrev
+
+	if hasNext {
+		if next, err = p.encode(sql.CursorNext, rows.Index(rows.Len()-1)); err != nil {
+			return reflect.Value{}, "", "", err
+		}
+	}
+
+	if hasPrev {
+		if prev, err = p.encode(sql.CursorPrev, rows.Index(0)); err != nil {
+			return reflect.Value{}, "", "", err
+		}
+	}
+
+	return rows, next, prev, nil
+}
+
+func (p cursorPage) encode(direction string, row reflect.Value) (string, error) {
+	if handler, ok := p.handler.(BaseCursorListHandler); ok {
+		return p.encodeHandlerValues(handler, direction, row)
+	}
+
+	values := make([]any, len(p.keys))
+
+	for i, key := range p.keys {
+		value, ok := findCursorValue(row, key)
+		if !ok {
+			return "", fmt.Errorf("can not find the value of the cursor field %s in the results of type %s", key.Field, row.Type())
+		}
+
+		values[i] = value.Interface()
+	}
+
+	return sql.EncodeCursor(direction, p.keys, values)
+}
+
+func (p cursorPage) encodeHandlerValues(handler BaseCursorListHandler, direction string, row reflect.Value) (string, error) {
+	fields := make([]string, len(p.keys))
+	for i, key := range p.keys {
+		fields[i] = key.Field
+	}
+
+	values, err := handler.CursorValues(row.Interface(), fields)
+	if err != nil {
+		return "", fmt.Errorf("can not get the cursor values of a result: %w", err)
+	}
+
+	if len(values) != len(fields) {
+		return "", fmt.Errorf("expected %d cursor values for the fields %v but got %d", len(fields), fields, len(values))
+	}
+
+	return sql.EncodeCursor(direction, p.keys, values)
+}
+
+func reverseRows(rows reflect.Value) reflect.Value {
+	reversed := reflect.MakeSlice(rows.Type(), rows.Len(), rows.Len())
+
+	for i := 0; i < rows.Len(); i++ {
+		reversed.Index(rows.Len() - 1 - i).Set(rows.Index(i))
+	}
+
+	return reversed
+}
+
+// findCursorValue looks up the value of a cursor key in a model row. A struct field matches the key if its json
+// name is the name of the field mapping or if its column name (gorm tag or snake case of the field name) is the
+// column of the key.
+func findCursorValue(row reflect.Value, key sql.CursorKey) (reflect.Value, bool) {
+	for row.Kind() == reflect.Pointer || row.Kind() == reflect.Interface {
+		if row.IsNil() {
+			return reflect.Value{}, false
+		}
+
+		row = row.Elem()
+	}
+
+	if row.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	column := key.Column
+	if i := strings.LastIndex(column, "."); i >= 0 {
+		column = column[i+1:]
+	}
+	column = strings.Trim(column, "`")
+
+	for i := 0; i < row.NumField(); i++ {
+		field := row.Type().Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if field.Anonymous {
+			if value, ok := findCursorValue(row.Field(i), key); ok {
+				return value, true
+			}
+
+			continue
+		}
+
+		if cursorFieldMatches(field, key.Field, column) {
+			return row.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+func cursorFieldMatches(field reflect.StructField, name string, column string) bool {
+	jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+	if jsonName == name {
+		return true
+	}
+
+	for _, setting := range strings.Split(field.Tag.Get("gorm"), ";") {
+		if gormColumn, ok := strings.CutPrefix(setting, "column:"); ok {
+			return gormColumn == column
+		}
+	}
+
+	return strcase.ToSnake(field.Name) == column || jsonName == "" && strings.EqualFold(field.Name, name)
+}
@@ -0,0 +1,95 @@
+package crud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/ddb"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type ddbCreateHandler struct {
+	logger      log.Logger
+	transformer DdbCreateHandler
+	settings    Settings
+	keys        *ddbKeys
+}
+
+func NewDdbCreateHandler(config cfg.Config, logger log.Logger, transformer DdbCreateHandler) (gin.HandlerFunc, error) {
+	ch, err := newDdbCreateHandler(config, logger, transformer)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpserver.CreateJsonHandler(ch), nil
+}
+
+func newDdbCreateHandler(config cfg.Config, logger log.Logger, transformer DdbCreateHandler) (ddbCreateHandler, error) {
+	settings, err := readDdbSettings(config)
+	if err != nil {
+		return ddbCreateHandler{}, err
+	}
+
+	keys, err := readDdbKeys(transformer.GetModel())
+	if err != nil {
+		return ddbCreateHandler{}, err
+	}
+
+	ch := ddbCreateHandler{
+		transformer: transformer,
+		logger:      logger,
+		settings:    settings,
+		keys:        keys,
+	}
+
+	return ch, nil
+}
+
+func (ch ddbCreateHandler) GetInput() any {
+	return ch.transformer.GetCreateInput()
+}
+
+func (ch ddbCreateHandler) GetOutput() any {
+	return getDdbOutput(ch.transformer)
+}
+
+func (ch ddbCreateHandler) Handle(reqCtx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
+	// replace context with a new one to prevent cancellations from client side
+	// include a new timeout to ensure that requests will be cancelled
+	ctx, cancel := exec.WithDelayedCancelContext(reqCtx, ch.settings.WriteTimeout)
+	defer cancel()
+
+	model := ch.transformer.GetModel()
+	err := ch.transformer.TransformCreate(ctx, request.Body, model)
+	if err != nil {
+		return HandleErrorOnWrite(ctx, ch.logger, err)
+	}
+
+	// an existing item must not be overwritten by a create
+	repo := ch.transformer.GetRepository()
+	qb := repo.PutItemBuilder().WithCondition(ddb.AttributeNotExists(ch.keys.hash.AttributeName))
+
+	result, err := repo.PutItem(ctx, qb, model)
+	if err != nil {
+		return HandleErrorOnWrite(ctx, ch.logger, err)
+	}
+
+	if result.ConditionalCheckFailed {
+		ch.logger.Warn(ctx, "failed to create item: an item with the same key exists already")
+
+		return httpserver.NewStatusResponse(http.StatusConflict), nil
+	}
+
+	apiView := GetApiViewFromHeader(request.Header)
+	out, err := ch.transformer.TransformOutput(ctx, model, apiView)
+	if err != nil {
+		return HandleErrorOnWrite(ctx, ch.logger, fmt.Errorf("failed to transform output: %w", err))
+	}
+
+	return httpserver.NewJsonResponse(out), nil
+}
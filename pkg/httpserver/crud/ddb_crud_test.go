@@ -0,0 +1,120 @@
+package crud_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/ddb"
+	ddbMocks "github.com/justtrackio/gosoline/pkg/ddb/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type DdbModel struct {
+	Id   string `json:"id" ddb:"key=hash"`
+	Sort int    `json:"sort" ddb:"key=range"`
+	Name string `json:"name"`
+}
+
+type DdbInput struct {
+	Name string `json:"name" binding:"required"`
+	Sort int    `json:"sort"`
+}
+
+type ddbHandler struct {
+	Repo     *ddbMocks.Repository
+	metadata *ddb.Metadata
+}
+
+func (h ddbHandler) GetRepository() ddb.Repository {
+	return h.Repo
+}
+
+func (h ddbHandler) GetModel() any {
+	return &DdbModel{}
+}
+
+func (h ddbHandler) GetCreateInput() any {
+	return &DdbInput{}
+}
+
+func (h ddbHandler) GetUpdateInput() any {
+	return &DdbInput{}
+}
+
+func (h ddbHandler) TransformCreate(_ context.Context, inp any, model any) error {
+	m := model.(*DdbModel)
+	m.Id = "id"
+	m.Sort = 1
+	m.Name = inp.(*DdbInput).Name
+
+	return nil
+}
+
+func (h ddbHandler) TransformUpdate(_ context.Context, inp any, model any) error {
+	model.(*DdbModel).Name = inp.(*DdbInput).Name
+
+	if sort := inp.(*DdbInput).Sort; sort != 0 {
+		model.(*DdbModel).Sort = sort
+	}
+
+	return nil
+}
+
+func (h ddbHandler) TransformPatch(_ context.Context, model any) (any, error) {
+	return &DdbInput{
+		Name: model.(*DdbModel).Name,
+		Sort: model.(*DdbModel).Sort,
+	}, nil
+}
+
+func (h ddbHandler) TransformOutput(_ context.Context, model any, _ string) (any, error) {
+	return model, nil
+}
+
+func (h ddbHandler) GetListIndex() string {
+	return ""
+}
+
+func newDdbHandler(t *testing.T) ddbHandler {
+	metadata, err := ddb.NewMetadataFactoryWithInterfaces(&ddb.Settings{
+		Main: ddb.MainSettings{
+			Model: &DdbModel{},
+		},
+	}, "models").GetMetadata()
+	require.NoError(t, err)
+
+	return ddbHandler{
+		Repo:     ddbMocks.NewRepository(t),
+		metadata: metadata,
+	}
+}
+
+func (h ddbHandler) expectGetItem(found bool, name string) {
+	h.Repo.EXPECT().GetItemBuilder().Return(ddb.NewGetItemBuilder(h.metadata, clock.NewFakeClock())).Once()
+	h.Repo.EXPECT().GetItem(matcher.Context, ddbItemKey("id", 1), &DdbModel{}).Run(func(_ context.Context, _ ddb.GetItemBuilder, result any) {
+		if found {
+			*result.(*DdbModel) = DdbModel{Id: "id", Sort: 1, Name: name}
+		}
+	}).Return(&ddb.GetItemResult{IsFound: found}, nil).Once()
+}
+
+// ddbItemKey matches a get item builder reading the item with the given keys.
+func ddbItemKey(id string, sort int) any {
+	return mock.MatchedBy(func(qb ddb.GetItemBuilder) bool {
+		input, err := qb.Build(&DdbModel{})
+		if err != nil {
+			return false
+		}
+
+		return assert.ObjectsAreEqual(map[string]types.AttributeValue{
+			"id":   &types.AttributeValueMemberS{Value: id},
+			"sort": &types.AttributeValueMemberN{Value: strconv.Itoa(sort)},
+		}, input.Key)
+	})
+}
@@ -0,0 +1,89 @@
+package crud
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+)
+
+const (
+	ddbCursorTypeBinary = "b"
+	ddbCursorTypeNumber = "n"
+	ddbCursorTypeString = "s"
+)
+
+type ddbCursorValue struct {
+	Type  string `json:"t"`
+	Value string `json:"v"`
+}
+
+// encodeDdbCursor creates an opaque cursor from the last evaluated key of a query. The keys of a table and its indices
+// can only be strings, numbers or binaries.
+func encodeDdbCursor(key map[string]types.AttributeValue) (string, error) {
+	if len(key) == 0 {
+		return "", nil
+	}
+
+	encoded := make(map[string]ddbCursorValue, len(key))
+
+	for name, value := range key {
+		switch v := value.(type) {
+		case *types.AttributeValueMemberS:
+			encoded[name] = ddbCursorValue{Type: ddbCursorTypeString, Value: v.Value}
+		case *types.AttributeValueMemberN:
+			encoded[name] = ddbCursorValue{Type: ddbCursorTypeNumber, Value: v.Value}
+		case *types.AttributeValueMemberB:
+			encoded[name] = ddbCursorValue{Type: ddbCursorTypeBinary, Value: base64.StdEncoding.EncodeToString(v.Value)}
+		default:
+			return "", fmt.Errorf("the key attribute %s has the unsupported type %T", name, value)
+		}
+	}
+
+	bytes, err := json.Marshal(encoded)
+	if err != nil {
+		return "", fmt.Errorf("can not marshal cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// decodeDdbCursor decodes a cursor created by encodeDdbCursor into the exclusive start key of a query.
+func decodeDdbCursor(cursor string) (map[string]types.AttributeValue, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	encoded := make(map[string]ddbCursorValue)
+	if err = json.Unmarshal(bytes, &encoded); err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	if len(encoded) == 0 {
+		return nil, fmt.Errorf("invalid cursor: it contains no key")
+	}
+
+	key := make(map[string]types.AttributeValue, len(encoded))
+
+	for name, value := range encoded {
+		switch value.Type {
+		case ddbCursorTypeString:
+			key[name] = &types.AttributeValueMemberS{Value: value.Value}
+		case ddbCursorTypeNumber:
+			key[name] = &types.AttributeValueMemberN{Value: value.Value}
+		case ddbCursorTypeBinary:
+			decoded, err := base64.StdEncoding.DecodeString(value.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cursor: the key attribute %s is no valid binary: %w", name, err)
+			}
+
+			key[name] = &types.AttributeValueMemberB{Value: decoded}
+		default:
+			return nil, fmt.Errorf("invalid cursor: unknown value type %q of key attribute %s", value.Type, name)
+		}
+	}
+
+	return key, nil
+}
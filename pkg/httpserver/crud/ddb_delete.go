@@ -0,0 +1,79 @@
+package crud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type ddbDeleteHandler struct {
+	logger      log.Logger
+	transformer DdbBaseHandler
+	settings    Settings
+	keys        *ddbKeys
+}
+
+func NewDdbDeleteHandler(config cfg.Config, logger log.Logger, transformer DdbBaseHandler) (gin.HandlerFunc, error) {
+	dh, err := newDdbDeleteHandler(config, logger, transformer)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpserver.CreateHandler(dh), nil
+}
+
+func newDdbDeleteHandler(config cfg.Config, logger log.Logger, transformer DdbBaseHandler) (ddbDeleteHandler, error) {
+	settings, err := readDdbSettings(config)
+	if err != nil {
+		return ddbDeleteHandler{}, err
+	}
+
+	keys, err := readDdbKeys(transformer.GetModel())
+	if err != nil {
+		return ddbDeleteHandler{}, err
+	}
+
+	dh := ddbDeleteHandler{
+		transformer: transformer,
+		logger:      logger,
+		settings:    settings,
+		keys:        keys,
+	}
+
+	return dh, nil
+}
+
+func (dh ddbDeleteHandler) GetOutput() any {
+	return getDdbOutput(dh.transformer)
+}
+
+func (dh ddbDeleteHandler) Handle(reqCtx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
+	// replace context with a new one to prevent cancellations from client side
+	// include a new timeout to ensure that requests will be cancelled
+	ctx, cancel := exec.WithDelayedCancelContext(reqCtx, dh.settings.WriteTimeout)
+	defer cancel()
+
+	repo := dh.transformer.GetRepository()
+	model := dh.transformer.GetModel()
+
+	if err := readDdbItem(ctx, dh.keys, request, repo, model); err != nil {
+		return HandleErrorOnWrite(ctx, dh.logger, err)
+	}
+
+	if _, err := repo.DeleteItem(ctx, repo.DeleteItemBuilder(), model); err != nil {
+		return HandleErrorOnWrite(ctx, dh.logger, err)
+	}
+
+	apiView := GetApiViewFromHeader(request.Header)
+	out, err := dh.transformer.TransformOutput(ctx, model, apiView)
+	if err != nil {
+		return HandleErrorOnWrite(ctx, dh.logger, fmt.Errorf("failed to transform output: %w", err))
+	}
+
+	return httpserver.NewJsonResponse(out), nil
+}
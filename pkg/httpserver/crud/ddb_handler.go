@@ -0,0 +1,385 @@
+package crud
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/jinzhu/inflection"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/ddb"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/validation"
+)
+
+const (
+	ddbParamHash  = "hash"
+	ddbParamRange = "range"
+)
+
+//go:generate go run github.com/vektra/mockery/v2 --name DdbBaseHandler
+type DdbBaseHandler interface {
+	GetRepository() ddb.Repository
+	GetModel() any
+	TransformOutput(ctx context.Context, model any, apiView string) (output any, err error)
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name DdbBaseCreateHandler
+type DdbBaseCreateHandler interface {
+	GetCreateInput() any
+	TransformCreate(ctx context.Context, input any, model any) (err error)
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name DdbCreateHandler
+type DdbCreateHandler interface {
+	DdbBaseHandler
+	DdbBaseCreateHandler
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name DdbBaseUpdateHandler
+type DdbBaseUpdateHandler interface {
+	GetUpdateInput() any
+	TransformUpdate(ctx context.Context, input any, model any) (err error)
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name DdbUpdateHandler
+type DdbUpdateHandler interface {
+	DdbBaseHandler
+	DdbBaseUpdateHandler
+}
+
+type DdbBasePatchHandler interface {
+	TransformPatch(ctx context.Context, model any) (updateInput any, err error)
+	TransformUpdate(ctx context.Context, input any, model any) (err error)
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name DdbPatchHandler
+type DdbPatchHandler interface {
+	DdbBaseHandler
+	DdbBasePatchHandler
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name DdbBaseListHandler
+type DdbBaseListHandler interface {
+	// GetListIndex returns the name of the index queried by the list handler. An empty name queries the table itself.
+	GetListIndex() string
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name DdbListHandler
+type DdbListHandler interface {
+	DdbBaseHandler
+	DdbBaseListHandler
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name DdbHandler
+type DdbHandler interface {
+	DdbBaseHandler
+	DdbBaseCreateHandler
+	DdbBaseUpdateHandler
+	DdbBasePatchHandler
+	DdbBaseListHandler
+}
+
+// AddDdbCrudHandlers adds the CRUDL handlers for a model stored in a ddb.Repository. The items are addressed by their
+// hash key and, if the table has one, their range key: /v{version}/{basePath}/:hash/:range
+func AddDdbCrudHandlers(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler DdbHandler) error {
+	if err := AddDdbCreateHandler(config, logger, d, version, basePath, handler); err != nil {
+		return fmt.Errorf("failed to add create handler: %w", err)
+	}
+
+	if err := AddDdbReadHandler(config, logger, d, version, basePath, handler); err != nil {
+		return fmt.Errorf("failed to add read handler: %w", err)
+	}
+
+	if err := AddDdbUpdateHandler(config, logger, d, version, basePath, handler); err != nil {
+		return fmt.Errorf("failed to add update handler: %w", err)
+	}
+
+	if err := AddDdbPatchHandler(config, logger, d, version, basePath, handler); err != nil {
+		return fmt.Errorf("failed to add patch handler: %w", err)
+	}
+
+	if err := AddDdbDeleteHandler(config, logger, d, version, basePath, handler); err != nil {
+		return fmt.Errorf("failed to add delete handler: %w", err)
+	}
+
+	if err := AddDdbListHandler(config, logger, d, version, basePath, handler); err != nil {
+		return fmt.Errorf("failed to add list handler: %w", err)
+	}
+
+	return nil
+}
+
+func AddDdbCreateHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler DdbCreateHandler) error {
+	ch, err := newDdbCreateHandler(config, logger, handler)
+	if err != nil {
+		return fmt.Errorf("failed to create handler: %w", err)
+	}
+
+	d.POST(fmt.Sprintf("/v%d/%s", version, basePath), httpserver.CreateJsonHandler(ch))
+
+	return nil
+}
+
+func AddDdbReadHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler DdbBaseHandler) error {
+	keys, err := readDdbKeys(handler.GetModel())
+	if err != nil {
+		return err
+	}
+
+	rh, err := newDdbReadHandler(config, logger, handler)
+	if err != nil {
+		return fmt.Errorf("failed to create read handler: %w", err)
+	}
+
+	d.GET(keys.path(version, basePath), httpserver.CreateHandler(rh))
+
+	return nil
+}
+
+func AddDdbUpdateHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler DdbUpdateHandler) error {
+	keys, err := readDdbKeys(handler.GetModel())
+	if err != nil {
+		return err
+	}
+
+	uh, err := newDdbUpdateHandler(config, logger, handler)
+	if err != nil {
+		return fmt.Errorf("failed to create update handler: %w", err)
+	}
+
+	d.PUT(keys.path(version, basePath), httpserver.CreateJsonHandler(uh))
+
+	return nil
+}
+
+func AddDdbPatchHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler DdbPatchHandler) error {
+	keys, err := readDdbKeys(handler.GetModel())
+	if err != nil {
+		return err
+	}
+
+	ph, err := newDdbPatchHandler(config, logger, handler)
+	if err != nil {
+		return fmt.Errorf("failed to create patch handler: %w", err)
+	}
+
+	d.PATCH(keys.path(version, basePath), httpserver.CreateJsonHandler(ph))
+
+	return nil
+}
+
+func AddDdbDeleteHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler DdbBaseHandler) error {
+	keys, err := readDdbKeys(handler.GetModel())
+	if err != nil {
+		return err
+	}
+
+	dh, err := newDdbDeleteHandler(config, logger, handler)
+	if err != nil {
+		return fmt.Errorf("failed to create delete handler: %w", err)
+	}
+
+	d.DELETE(keys.path(version, basePath), httpserver.CreateHandler(dh))
+
+	return nil
+}
+
+func AddDdbListHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler DdbListHandler) error {
+	lh, err := newDdbListHandler(config, logger, handler)
+	if err != nil {
+		return fmt.Errorf("failed to create list handler: %w", err)
+	}
+
+	plural := inflection.Plural(basePath)
+	d.POST(fmt.Sprintf("/v%d/%s", version, plural), httpserver.CreateJsonHandler(lh))
+
+	return nil
+}
+
+// ddbKeys describes the keys of the items of a ddb model and how they are read from the path of a request.
+type ddbKeys struct {
+	hash      *ddb.Attribute
+	rangeKey  *ddb.Attribute
+	hashType  reflect.Type
+	rangeType reflect.Type
+}
+
+func readDdbKeys(model any) (*ddbKeys, error) {
+	attributes, err := ddb.ReadAttributes(model)
+	if err != nil {
+		return nil, fmt.Errorf("can not read the attributes of model %T: %w", model, err)
+	}
+
+	keys := &ddbKeys{}
+
+	if keys.hash, err = attributes.GetByTag("key", "hash"); err != nil {
+		return nil, fmt.Errorf("can not read the hash key of model %T: %w", model, err)
+	}
+
+	if keys.hash == nil {
+		return nil, fmt.Errorf("the model %T has no hash key", model)
+	}
+
+	if keys.rangeKey, err = attributes.GetByTag("key", "range"); err != nil {
+		return nil, fmt.Errorf("can not read the range key of model %T: %w", model, err)
+	}
+
+	modelType := reflect.TypeOf(model)
+	for modelType.Kind() == reflect.Pointer {
+		modelType = modelType.Elem()
+	}
+
+	hashField, _ := modelType.FieldByName(keys.hash.FieldName)
+	keys.hashType = hashField.Type
+
+	if keys.rangeKey != nil {
+		rangeField, _ := modelType.FieldByName(keys.rangeKey.FieldName)
+		keys.rangeType = rangeField.Type
+	}
+
+	return keys, nil
+}
+
+func (k ddbKeys) path(version int, basePath string) string {
+	if k.rangeKey == nil {
+		return fmt.Sprintf("/v%d/%s/:%s", version, basePath, ddbParamHash)
+	}
+
+	return fmt.Sprintf("/v%d/%s/:%s/:%s", version, basePath, ddbParamHash, ddbParamRange)
+}
+
+// fromRequest reads the keys of the item from the path of the request and converts them into the types of the key
+// fields of the model.
+func (k ddbKeys) fromRequest(request *httpserver.Request) (hashValue any, rangeValue any, err error) {
+	if hashValue, err = parseDdbKey(request.Params.ByName(ddbParamHash), k.hashType); err != nil {
+		return nil, nil, fmt.Errorf("invalid hash key: %w", err)
+	}
+
+	if k.rangeKey == nil {
+		return hashValue, nil, nil
+	}
+
+	if rangeValue, err = parseDdbKey(request.Params.ByName(ddbParamRange), k.rangeType); err != nil {
+		return nil, nil, fmt.Errorf("invalid range key: %w", err)
+	}
+
+	return hashValue, rangeValue, nil
+}
+
+// checkUnchanged fails if the keys of the model differ from the keys in the path of the request, as an update can't
+// move an item to other keys.
+func (k ddbKeys) checkUnchanged(request *httpserver.Request, model any) error {
+	hashValue, rangeValue, err := k.fromRequest(request)
+	if err != nil {
+		return &validation.Error{
+			Errors: []error{err},
+		}
+	}
+
+	if !reflect.DeepEqual(readDdbKeyField(model, k.hash.FieldName), hashValue) {
+		return &validation.Error{
+			Errors: []error{fmt.Errorf("the hash key of an item can not be changed")},
+		}
+	}
+
+	if k.rangeKey != nil && !reflect.DeepEqual(readDdbKeyField(model, k.rangeKey.FieldName), rangeValue) {
+		return &validation.Error{
+			Errors: []error{fmt.Errorf("the range key of an item can not be changed")},
+		}
+	}
+
+	return nil
+}
+
+func readDdbKeyField(model any, fieldName string) any {
+	rv := reflect.ValueOf(model)
+	for rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+
+	field := rv.FieldByName(fieldName)
+	for field.Kind() == reflect.Pointer {
+		if field.IsNil() {
+			return nil
+		}
+
+		field = field.Elem()
+	}
+
+	return field.Interface()
+}
+
+func parseDdbKey(value string, keyType reflect.Type) (any, error) {
+	for keyType.Kind() == reflect.Pointer {
+		keyType = keyType.Elem()
+	}
+
+	var err error
+	var parsed any
+
+	switch keyType.Kind() {
+	case reflect.String:
+		parsed = value
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err = strconv.ParseInt(value, 10, keyType.Bits())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err = strconv.ParseUint(value, 10, keyType.Bits())
+	case reflect.Float32, reflect.Float64:
+		parsed, err = strconv.ParseFloat(value, keyType.Bits())
+	default:
+		return nil, fmt.Errorf("keys of type %s are not supported", keyType)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reflect.ValueOf(parsed).Convert(keyType).Interface(), nil
+}
+
+// readDdbItem reads the item addressed by the path of the request into the model.
+func readDdbItem(ctx context.Context, keys *ddbKeys, request *httpserver.Request, repo ddb.Repository, model any) error {
+	hashValue, rangeValue, err := keys.fromRequest(request)
+	if err != nil {
+		return &validation.Error{
+			Errors: []error{err},
+		}
+	}
+
+	qb := repo.GetItemBuilder().WithHash(hashValue)
+	if keys.rangeKey != nil {
+		qb = qb.WithRange(rangeValue)
+	}
+
+	result, err := repo.GetItem(ctx, qb, model)
+	if err != nil {
+		return err
+	}
+
+	if !result.IsFound {
+		return fmt.Errorf("%w: hash %v, range %v", ErrItemNotFound, hashValue, rangeValue)
+	}
+
+	return nil
+}
+
+// getDdbOutput returns a value of the type of the output of the handler for the openapi document.
+func getDdbOutput(transformer DdbBaseHandler) any {
+	if withOutput, ok := transformer.(httpserver.HandlerWithOutput); ok {
+		return withOutput.GetOutput()
+	}
+
+	return transformer.GetModel()
+}
+
+func readDdbSettings(config cfg.Config) (Settings, error) {
+	settings := Settings{}
+	if err := config.UnmarshalKey(SettingsConfigKey, &settings); err != nil {
+		return settings, fmt.Errorf("failed to unmarshal handler settings: %w", err)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,300 @@
+package crud
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/gin-gonic/gin"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/ddb"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/validation"
+)
+
+const (
+	DdbOpBeginsWith = "BEGINS_WITH"
+	DdbOpBetween    = "BETWEEN"
+	DdbOpContains   = "CONTAINS"
+	DdbOpEq         = "="
+	DdbOpExists     = "EXISTS"
+	DdbOpGt         = ">"
+	DdbOpGte        = ">="
+	DdbOpLt         = "<"
+	DdbOpLte        = "<="
+	DdbOpNeq        = "!="
+	DdbOpNotExists  = "NOT EXISTS"
+)
+
+// DdbListInput queries the items with the given hash key (of the index of the list handler). The range key condition,
+// the filters and the limit are optional. The cursor continues a list with the next cursor of its previous page, all
+// other fields have to stay the same for it.
+type DdbListInput struct {
+	Hash       any                `json:"hash" binding:"required"`
+	Range      *DdbRangeCondition `json:"range"`
+	Filter     []DdbFilterMatch   `json:"filter"`
+	Limit      int                `json:"limit"`
+	Descending bool               `json:"descending"`
+	Cursor     string             `json:"cursor"`
+}
+
+type DdbRangeCondition struct {
+	Operator string `json:"operator" binding:"required,oneof='=' '<' '<=' '>' '>=' 'BETWEEN' 'BEGINS_WITH'"`
+	Values   []any  `json:"values"`
+}
+
+// DdbFilterMatch filters the queried items by an attribute. All matches of a list input have to apply to an item.
+type DdbFilterMatch struct {
+	Attribute string `json:"attribute" binding:"required"`
+	Operator  string `json:"operator" binding:"required,oneof='=' '!=' '<' '<=' '>' '>=' 'BETWEEN' 'BEGINS_WITH' 'CONTAINS' 'EXISTS' 'NOT EXISTS'"`
+	Values    []any  `json:"values"`
+}
+
+// DdbListOutput contains a page of the queried items. Next is empty if there are no more items.
+type DdbListOutput struct {
+	Results any    `json:"results"`
+	Next    string `json:"next,omitempty"`
+}
+
+type ddbListHandler struct {
+	transformer DdbListHandler
+	logger      log.Logger
+	fields      []string
+}
+
+func NewDdbListHandler(config cfg.Config, logger log.Logger, transformer DdbListHandler) (gin.HandlerFunc, error) {
+	lh, err := newDdbListHandler(config, logger, transformer)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpserver.CreateJsonHandler(lh), nil
+}
+
+func newDdbListHandler(_ cfg.Config, logger log.Logger, transformer DdbListHandler) (ddbListHandler, error) {
+	fields, err := ddb.MetadataReadFields(transformer.GetModel())
+	if err != nil {
+		return ddbListHandler{}, fmt.Errorf("can not read the fields of the model: %w", err)
+	}
+
+	lh := ddbListHandler{
+		transformer: transformer,
+		logger:      logger,
+		fields:      fields,
+	}
+
+	return lh, nil
+}
+
+func (lh ddbListHandler) GetInput() any {
+	return &DdbListInput{}
+}
+
+func (lh ddbListHandler) GetOutput() any {
+	results := reflect.TypeOf(getDdbOutput(lh.transformer))
+	if results == nil {
+		return &DdbListOutput{}
+	}
+
+	// Output with the type of the results, so the openapi document can describe them
+	output := reflect.StructOf([]reflect.StructField{
+		{Name: "Results", Type: reflect.SliceOf(results), Tag: `json:"results"`},
+		{Name: "Next", Type: reflect.TypeOf(""), Tag: `json:"next,omitempty"`},
+	})
+
+	return reflect.New(output).Interface()
+}
+
+func (lh ddbListHandler) Handle(ctx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
+	inp := request.Body.(*DdbListInput)
+
+	qb, err := lh.buildQuery(inp)
+	if err != nil {
+		return HandleErrorOnRead(ctx, lh.logger, &validation.Error{
+			Errors: []error{err},
+		})
+	}
+
+	// the items are read into models instead of letting the handler list them, as the query has to return the key the
+	// page ended at
+	model := lh.transformer.GetModel()
+	models := reflect.New(reflect.SliceOf(reflect.TypeOf(model)))
+
+	result, err := lh.transformer.GetRepository().Query(ctx, qb, models.Interface())
+	if err != nil {
+		return HandleErrorOnRead(ctx, lh.logger, err)
+	}
+
+	next, err := encodeDdbCursor(result.LastEvaluatedKey)
+	if err != nil {
+		return HandleErrorOnRead(ctx, lh.logger, fmt.Errorf("can not encode the next cursor: %w", err))
+	}
+
+	apiView := GetApiViewFromHeader(request.Header)
+	results := make([]any, models.Elem().Len())
+
+	for i := range results {
+		if results[i], err = lh.transformer.TransformOutput(ctx, models.Elem().Index(i).Interface(), apiView); err != nil {
+			return HandleErrorOnRead(ctx, lh.logger, fmt.Errorf("failed to transform output: %w", err))
+		}
+	}
+
+	resp := httpserver.NewJsonResponse(DdbListOutput{
+		Results: results,
+		Next:    next,
+	})
+	resp.AddHeader(httpserver.ApiViewKey, apiView)
+
+	return resp, nil
+}
+
+func (lh ddbListHandler) buildQuery(inp *DdbListInput) (ddb.QueryBuilder, error) {
+	qb := lh.transformer.GetRepository().QueryBuilder().WithHash(inp.Hash)
+
+	if index := lh.transformer.GetListIndex(); index != "" {
+		qb = qb.WithIndex(index)
+	}
+
+	if inp.Range != nil {
+		var err error
+		if qb, err = withDdbRangeCondition(qb, inp.Range); err != nil {
+			return nil, fmt.Errorf("invalid range condition: %w", err)
+		}
+	}
+
+	conditions := make([]expression.ConditionBuilder, 0, len(inp.Filter))
+
+	for _, match := range inp.Filter {
+		if !slices.Contains(lh.fields, match.Attribute) {
+			return nil, fmt.Errorf("invalid filter: the model has no attribute %s", match.Attribute)
+		}
+
+		condition, err := buildDdbFilterCondition(match)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter on attribute %s: %w", match.Attribute, err)
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	switch len(conditions) {
+	case 0:
+	case 1:
+		qb = qb.WithFilter(conditions[0])
+	default:
+		qb = qb.WithFilter(ddb.And(conditions[0], conditions[1], conditions[2:]...))
+	}
+
+	if inp.Limit > 0 {
+		qb = qb.WithLimit(inp.Limit)
+	}
+
+	if inp.Descending {
+		qb = qb.WithDescendingOrder()
+	}
+
+	if inp.Cursor != "" {
+		startKey, err := decodeDdbCursor(inp.Cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		qb = qb.WithExclusiveStartKey(startKey)
+	}
+
+	return qb, nil
+}
+
+func withDdbRangeCondition(qb ddb.QueryBuilder, condition *DdbRangeCondition) (ddb.QueryBuilder, error) {
+	operator := strings.ToUpper(condition.Operator)
+
+	if err := checkDdbValueCount(operator, condition.Values); err != nil {
+		return nil, err
+	}
+
+	switch operator {
+	case DdbOpEq:
+		return qb.WithRangeEq(condition.Values[0]), nil
+	case DdbOpLt:
+		return qb.WithRangeLt(condition.Values[0]), nil
+	case DdbOpLte:
+		return qb.WithRangeLte(condition.Values[0]), nil
+	case DdbOpGt:
+		return qb.WithRangeGt(condition.Values[0]), nil
+	case DdbOpGte:
+		return qb.WithRangeGte(condition.Values[0]), nil
+	case DdbOpBetween:
+		return qb.WithRangeBetween(condition.Values[0], condition.Values[1]), nil
+	case DdbOpBeginsWith:
+		prefix, ok := condition.Values[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("the prefix has to be a string, got %T", condition.Values[0])
+		}
+
+		return qb.WithRangeBeginsWith(prefix), nil
+	default:
+		return nil, fmt.Errorf("unknown operator %q", condition.Operator)
+	}
+}
+
+func buildDdbFilterCondition(match DdbFilterMatch) (expression.ConditionBuilder, error) {
+	operator := strings.ToUpper(match.Operator)
+	name := expression.Name(match.Attribute)
+
+	if err := checkDdbValueCount(operator, match.Values); err != nil {
+		return expression.ConditionBuilder{}, err
+	}
+
+	switch operator {
+	case DdbOpEq:
+		return ddb.Eq(match.Attribute, match.Values[0]), nil
+	case DdbOpNeq:
+		return ddb.NotEq(match.Attribute, match.Values[0]), nil
+	case DdbOpLt:
+		return ddb.Lt(match.Attribute, match.Values[0]), nil
+	case DdbOpLte:
+		return ddb.Lte(match.Attribute, match.Values[0]), nil
+	case DdbOpGt:
+		return ddb.Gt(match.Attribute, match.Values[0]), nil
+	case DdbOpGte:
+		return ddb.Gte(match.Attribute, match.Values[0]), nil
+	case DdbOpBetween:
+		return ddb.Between(match.Attribute, match.Values[0], match.Values[1]), nil
+	case DdbOpBeginsWith:
+		prefix, ok := match.Values[0].(string)
+		if !ok {
+			return expression.ConditionBuilder{}, fmt.Errorf("the prefix has to be a string, got %T", match.Values[0])
+		}
+
+		return expression.BeginsWith(name, prefix), nil
+	case DdbOpContains:
+		return expression.Contains(name, match.Values[0]), nil
+	case DdbOpExists:
+		return ddb.AttributeExists(match.Attribute), nil
+	case DdbOpNotExists:
+		return ddb.AttributeNotExists(match.Attribute), nil
+	default:
+		return expression.ConditionBuilder{}, fmt.Errorf("unknown operator %q", match.Operator)
+	}
+}
+
+func checkDdbValueCount(operator string, values []any) error {
+	expected := 1
+
+	switch operator {
+	case DdbOpBetween:
+		expected = 2
+	case DdbOpExists, DdbOpNotExists:
+		expected = 0
+	}
+
+	if len(values) != expected {
+		return fmt.Errorf("the operator %s requires %d value(s), got %d", operator, expected, len(values))
+	}
+
+	return nil
+}
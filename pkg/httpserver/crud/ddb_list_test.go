@@ -0,0 +1,126 @@
+package crud_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/expression"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	configMocks "github.com/justtrackio/gosoline/pkg/cfg/mocks"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/ddb"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/httpserver/crud"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDdbListHandler_Handle(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	transformer := newDdbHandler(t)
+	clk := clock.NewFakeClock()
+	transformer.Repo.EXPECT().QueryBuilder().RunAndReturn(func() ddb.QueryBuilder {
+		return ddb.NewQueryBuilder(transformer.metadata, clk)
+	})
+
+	var actual ddb.QueryBuilder
+	transformer.Repo.EXPECT().Query(matcher.Context, mock.Anything, mock.AnythingOfType("*[]*crud_test.DdbModel")).Run(func(_ context.Context, qb ddb.QueryBuilder, result any) {
+		actual = qb
+		*result.(*[]*DdbModel) = []*DdbModel{{Id: "id", Sort: 2, Name: "foobar"}}
+	}).Return(&ddb.QueryResult{
+		LastEvaluatedKey: map[string]types.AttributeValue{
+			"id":   &types.AttributeValueMemberS{Value: "id"},
+			"sort": &types.AttributeValueMemberN{Value: "2"},
+		},
+	}, nil).Once()
+
+	handler, err := crud.NewDdbListHandler(configMocks.NewConfig(t), logger, transformer)
+	require.NoError(t, err)
+
+	body := `{"hash":"id","range":{"operator":">=","values":[2]},"filter":[{"attribute":"name","operator":"BEGINS_WITH","values":["foo"]}],"limit":1,"descending":true}`
+	response := httpserver.HttpTest("POST", "/", "/", body, handler)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	output := struct {
+		Results []DdbModel `json:"results"`
+		Next    string     `json:"next"`
+	}{}
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &output))
+	assert.Equal(t, []DdbModel{{Id: "id", Sort: 2, Name: "foobar"}}, output.Results)
+	assert.NotEmpty(t, output.Next)
+
+	expected := ddb.NewQueryBuilder(transformer.metadata, clk).
+		WithHash("id").
+		WithRangeGte(float64(2)).
+		WithFilter(expression.BeginsWith(expression.Name("name"), "foo")).
+		WithLimit(1).
+		WithDescendingOrder()
+	assertDdbQuery(t, expected, actual)
+
+	// the next cursor continues the query after the last evaluated key
+	transformer.Repo.EXPECT().Query(matcher.Context, mock.Anything, mock.AnythingOfType("*[]*crud_test.DdbModel")).Run(func(_ context.Context, qb ddb.QueryBuilder, _ any) {
+		actual = qb
+	}).Return(&ddb.QueryResult{}, nil).Once()
+
+	body = fmt.Sprintf(`{"hash":"id","limit":1,"cursor":%q}`, output.Next)
+	response = httpserver.HttpTest("POST", "/", "/", body, handler)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.JSONEq(t, `{"results":[]}`, response.Body.String())
+
+	expected = ddb.NewQueryBuilder(transformer.metadata, clk).
+		WithHash("id").
+		WithLimit(1).
+		WithExclusiveStartKey(map[string]types.AttributeValue{
+			"id":   &types.AttributeValueMemberS{Value: "id"},
+			"sort": &types.AttributeValueMemberN{Value: "2"},
+		})
+	assertDdbQuery(t, expected, actual)
+}
+
+func TestDdbListHandler_HandleInvalidCursor(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	transformer := newDdbHandler(t)
+	transformer.Repo.EXPECT().QueryBuilder().Return(ddb.NewQueryBuilder(transformer.metadata, clock.NewFakeClock()))
+
+	handler, err := crud.NewDdbListHandler(configMocks.NewConfig(t), logger, transformer)
+	require.NoError(t, err)
+
+	body := `{"hash":"id","cursor":"eyJpZCI6eyJ0IjoieCIsInYiOiJpZCJ9fQ"}`
+	response := httpserver.HttpTest("POST", "/", "/", body, handler)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	assert.JSONEq(t, `{"err":"validation: invalid cursor: unknown value type \"x\" of key attribute id"}`, response.Body.String())
+}
+
+func TestDdbListHandler_HandleInvalidFilter(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	transformer := newDdbHandler(t)
+	transformer.Repo.EXPECT().QueryBuilder().Return(ddb.NewQueryBuilder(transformer.metadata, clock.NewFakeClock()))
+
+	handler, err := crud.NewDdbListHandler(configMocks.NewConfig(t), logger, transformer)
+	require.NoError(t, err)
+
+	body := `{"hash":"id","filter":[{"attribute":"secret","operator":"EXISTS"}]}`
+	response := httpserver.HttpTest("POST", "/", "/", body, handler)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	assert.JSONEq(t, `{"err":"validation: invalid filter: the model has no attribute secret"}`, response.Body.String())
+}
+
+func assertDdbQuery(t *testing.T, expected ddb.QueryBuilder, actual ddb.QueryBuilder) {
+	expectedOp, err := expected.Build(&[]DdbModel{})
+	require.NoError(t, err)
+
+	actualOp, err := actual.Build(&[]DdbModel{})
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedOp, actualOp)
+}
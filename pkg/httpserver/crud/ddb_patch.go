@@ -0,0 +1,102 @@
+package crud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type ddbPatchHandler struct {
+	logger      log.Logger
+	transformer DdbPatchHandler
+	settings    Settings
+	keys        *ddbKeys
+}
+
+func NewDdbPatchHandler(config cfg.Config, logger log.Logger, transformer DdbPatchHandler) (gin.HandlerFunc, error) {
+	ph, err := newDdbPatchHandler(config, logger, transformer)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpserver.CreateJsonHandler(ph), nil
+}
+
+func newDdbPatchHandler(config cfg.Config, logger log.Logger, transformer DdbPatchHandler) (ddbPatchHandler, error) {
+	settings, err := readDdbSettings(config)
+	if err != nil {
+		return ddbPatchHandler{}, err
+	}
+
+	keys, err := readDdbKeys(transformer.GetModel())
+	if err != nil {
+		return ddbPatchHandler{}, err
+	}
+
+	ph := ddbPatchHandler{
+		transformer: transformer,
+		logger:      logger,
+		settings:    settings,
+		keys:        keys,
+	}
+
+	return ph, nil
+}
+
+func (ph ddbPatchHandler) GetInput() any {
+	return new(patchInput)
+}
+
+func (ph ddbPatchHandler) GetOutput() any {
+	return getDdbOutput(ph.transformer)
+}
+
+func (ph ddbPatchHandler) Handle(reqCtx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
+	// replace context with a new one to prevent cancellations from client side
+	// include a new timeout to ensure that requests will be cancelled
+	ctx, cancel := exec.WithDelayedCancelContext(reqCtx, ph.settings.WriteTimeout)
+	defer cancel()
+
+	var err error
+	var updateInput any
+
+	repo := ph.transformer.GetRepository()
+	model := ph.transformer.GetModel()
+
+	if err = readDdbItem(ctx, ph.keys, request, repo, model); err != nil {
+		return HandleErrorOnWrite(ctx, ph.logger, err)
+	}
+
+	if updateInput, err = ph.transformer.TransformPatch(ctx, model); err != nil {
+		return HandleErrorOnWrite(ctx, ph.logger, fmt.Errorf("failed to transform patch: %w", err))
+	}
+
+	if err = applyMergePatch(updateInput, request.Body); err != nil {
+		return HandleErrorOnWrite(ctx, ph.logger, err)
+	}
+
+	if err = ph.transformer.TransformUpdate(ctx, updateInput, model); err != nil {
+		return HandleErrorOnWrite(ctx, ph.logger, fmt.Errorf("failed to transform update: %w", err))
+	}
+
+	if err = ph.keys.checkUnchanged(request, model); err != nil {
+		return HandleErrorOnWrite(ctx, ph.logger, err)
+	}
+
+	if err = putDdbItem(ctx, ph.keys, repo, model); err != nil {
+		return HandleErrorOnWrite(ctx, ph.logger, err)
+	}
+
+	apiView := GetApiViewFromHeader(request.Header)
+	out, err := ph.transformer.TransformOutput(ctx, model, apiView)
+	if err != nil {
+		return HandleErrorOnWrite(ctx, ph.logger, fmt.Errorf("failed to transform output: %w", err))
+	}
+
+	return httpserver.NewJsonResponse(out), nil
+}
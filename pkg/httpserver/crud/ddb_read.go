@@ -0,0 +1,61 @@
+package crud
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type ddbReadHandler struct {
+	logger      log.Logger
+	transformer DdbBaseHandler
+	keys        *ddbKeys
+}
+
+func NewDdbReadHandler(config cfg.Config, logger log.Logger, transformer DdbBaseHandler) (gin.HandlerFunc, error) {
+	rh, err := newDdbReadHandler(config, logger, transformer)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpserver.CreateHandler(rh), nil
+}
+
+func newDdbReadHandler(_ cfg.Config, logger log.Logger, transformer DdbBaseHandler) (ddbReadHandler, error) {
+	keys, err := readDdbKeys(transformer.GetModel())
+	if err != nil {
+		return ddbReadHandler{}, err
+	}
+
+	rh := ddbReadHandler{
+		transformer: transformer,
+		logger:      logger,
+		keys:        keys,
+	}
+
+	return rh, nil
+}
+
+func (rh ddbReadHandler) GetOutput() any {
+	return getDdbOutput(rh.transformer)
+}
+
+func (rh ddbReadHandler) Handle(ctx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
+	repo := rh.transformer.GetRepository()
+	model := rh.transformer.GetModel()
+
+	if err := readDdbItem(ctx, rh.keys, request, repo, model); err != nil {
+		return HandleErrorOnRead(ctx, rh.logger, err)
+	}
+
+	apiView := GetApiViewFromHeader(request.Header)
+	out, err := rh.transformer.TransformOutput(ctx, model, apiView)
+	if err != nil {
+		return HandleErrorOnRead(ctx, rh.logger, err)
+	}
+
+	return httpserver.NewJsonResponse(out), nil
+}
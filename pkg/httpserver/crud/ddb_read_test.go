@@ -0,0 +1,53 @@
+package crud_test
+
+import (
+	"net/http"
+	"testing"
+
+	configMocks "github.com/justtrackio/gosoline/pkg/cfg/mocks"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/httpserver/crud"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDdbReadHandler_Handle(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	transformer := newDdbHandler(t)
+	transformer.expectGetItem(true, "foobar")
+
+	handler, err := crud.NewDdbReadHandler(configMocks.NewConfig(t), logger, transformer)
+	require.NoError(t, err)
+
+	response := httpserver.HttpTest("GET", "/:hash/:range", "/id/1", "", handler)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.JSONEq(t, `{"id":"id","sort":1,"name":"foobar"}`, response.Body.String())
+}
+
+func TestDdbReadHandler_HandleNotFound(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	transformer := newDdbHandler(t)
+	transformer.expectGetItem(false, "")
+
+	handler, err := crud.NewDdbReadHandler(configMocks.NewConfig(t), logger, transformer)
+	require.NoError(t, err)
+
+	response := httpserver.HttpTest("GET", "/:hash/:range", "/id/1", "", handler)
+
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func TestDdbReadHandler_HandleInvalidKey(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	transformer := newDdbHandler(t)
+
+	handler, err := crud.NewDdbReadHandler(configMocks.NewConfig(t), logger, transformer)
+	require.NoError(t, err)
+
+	response := httpserver.HttpTest("GET", "/:hash/:range", "/id/one", "", handler)
+
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+	assert.JSONEq(t, `{"err":"validation: invalid range key: strconv.ParseInt: parsing \"one\": invalid syntax"}`, response.Body.String())
+}
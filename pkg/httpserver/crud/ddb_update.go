@@ -0,0 +1,108 @@
+package crud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/ddb"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type ddbUpdateHandler struct {
+	logger      log.Logger
+	transformer DdbUpdateHandler
+	settings    Settings
+	keys        *ddbKeys
+}
+
+func NewDdbUpdateHandler(config cfg.Config, logger log.Logger, transformer DdbUpdateHandler) (gin.HandlerFunc, error) {
+	uh, err := newDdbUpdateHandler(config, logger, transformer)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpserver.CreateJsonHandler(uh), nil
+}
+
+func newDdbUpdateHandler(config cfg.Config, logger log.Logger, transformer DdbUpdateHandler) (ddbUpdateHandler, error) {
+	settings, err := readDdbSettings(config)
+	if err != nil {
+		return ddbUpdateHandler{}, err
+	}
+
+	keys, err := readDdbKeys(transformer.GetModel())
+	if err != nil {
+		return ddbUpdateHandler{}, err
+	}
+
+	uh := ddbUpdateHandler{
+		transformer: transformer,
+		logger:      logger,
+		settings:    settings,
+		keys:        keys,
+	}
+
+	return uh, nil
+}
+
+func (uh ddbUpdateHandler) GetInput() any {
+	return uh.transformer.GetUpdateInput()
+}
+
+func (uh ddbUpdateHandler) GetOutput() any {
+	return getDdbOutput(uh.transformer)
+}
+
+func (uh ddbUpdateHandler) Handle(reqCtx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
+	// replace context with a new one to prevent cancellations from client side
+	// include a new timeout to ensure that requests will be cancelled
+	ctx, cancel := exec.WithDelayedCancelContext(reqCtx, uh.settings.WriteTimeout)
+	defer cancel()
+
+	repo := uh.transformer.GetRepository()
+	model := uh.transformer.GetModel()
+
+	if err := readDdbItem(ctx, uh.keys, request, repo, model); err != nil {
+		return HandleErrorOnWrite(ctx, uh.logger, err)
+	}
+
+	if err := uh.transformer.TransformUpdate(ctx, request.Body, model); err != nil {
+		return HandleErrorOnWrite(ctx, uh.logger, err)
+	}
+
+	if err := uh.keys.checkUnchanged(request, model); err != nil {
+		return HandleErrorOnWrite(ctx, uh.logger, err)
+	}
+
+	if err := putDdbItem(ctx, uh.keys, repo, model); err != nil {
+		return HandleErrorOnWrite(ctx, uh.logger, err)
+	}
+
+	apiView := GetApiViewFromHeader(request.Header)
+	out, err := uh.transformer.TransformOutput(ctx, model, apiView)
+	if err != nil {
+		return HandleErrorOnWrite(ctx, uh.logger, fmt.Errorf("failed to transform output: %w", err))
+	}
+
+	return httpserver.NewJsonResponse(out), nil
+}
+
+// putDdbItem writes an updated item back on the condition that it wasn't deleted in the meantime.
+func putDdbItem(ctx context.Context, keys *ddbKeys, repo ddb.Repository, model any) error {
+	qb := repo.PutItemBuilder().WithCondition(ddb.AttributeExists(keys.hash.AttributeName))
+
+	result, err := repo.PutItem(ctx, qb, model)
+	if err != nil {
+		return err
+	}
+
+	if result.ConditionalCheckFailed {
+		return fmt.Errorf("%w: it was deleted during the update", ErrItemNotFound)
+	}
+
+	return nil
+}
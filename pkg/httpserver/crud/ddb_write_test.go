@@ -0,0 +1,157 @@
+package crud_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	configMocks "github.com/justtrackio/gosoline/pkg/cfg/mocks"
+	"github.com/justtrackio/gosoline/pkg/ddb"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/httpserver/crud"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ddbWriteTestSuite struct {
+	suite.Suite
+
+	config      *configMocks.Config
+	transformer ddbHandler
+}
+
+func TestDdbWriteTestSuite(t *testing.T) {
+	suite.Run(t, new(ddbWriteTestSuite))
+}
+
+func (s *ddbWriteTestSuite) SetupTest() {
+	s.config = configMocks.NewConfig(s.T())
+	s.config.EXPECT().UnmarshalKey("crud", mock.AnythingOfType("*crud.Settings")).Run(func(key string, val any, additionalDefaults ...cfg.UnmarshalDefaults) {
+		settings := val.(*crud.Settings)
+		settings.WriteTimeout = time.Minute
+	}).Return(nil)
+
+	s.transformer = newDdbHandler(s.T())
+}
+
+func (s *ddbWriteTestSuite) logger() logMocks.LoggerMock {
+	return logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+}
+
+func (s *ddbWriteTestSuite) expectPutItem(condition string, name string, conditionalCheckFailed bool) {
+	s.transformer.Repo.EXPECT().PutItemBuilder().Return(ddb.NewPutItemBuilder(s.transformer.metadata)).Once()
+
+	qb := mock.MatchedBy(func(qb ddb.PutItemBuilder) bool {
+		input, err := qb.Build(&DdbModel{})
+
+		return err == nil && *input.ConditionExpression == condition
+	})
+
+	s.transformer.Repo.EXPECT().PutItem(matcher.Context, qb, &DdbModel{Id: "id", Sort: 1, Name: name}).
+		Return(&ddb.PutItemResult{ConditionalCheckFailed: conditionalCheckFailed}, nil).
+		Once()
+}
+
+func (s *ddbWriteTestSuite) TestCreate() {
+	s.expectPutItem("attribute_not_exists (#0)", "created", false)
+
+	handler, err := crud.NewDdbCreateHandler(s.config, s.logger(), s.transformer)
+	s.Require().NoError(err)
+
+	response := httpserver.HttpTest("POST", "/", "/", `{"name":"created"}`, handler)
+
+	s.Equal(http.StatusOK, response.Code)
+	s.JSONEq(`{"id":"id","sort":1,"name":"created"}`, response.Body.String())
+}
+
+func (s *ddbWriteTestSuite) TestCreate_Exists() {
+	s.expectPutItem("attribute_not_exists (#0)", "created", true)
+
+	handler, err := crud.NewDdbCreateHandler(s.config, s.logger(), s.transformer)
+	s.Require().NoError(err)
+
+	response := httpserver.HttpTest("POST", "/", "/", `{"name":"created"}`, handler)
+
+	s.Equal(http.StatusConflict, response.Code)
+}
+
+func (s *ddbWriteTestSuite) TestUpdate() {
+	s.transformer.expectGetItem(true, "foobar")
+	s.expectPutItem("attribute_exists (#0)", "updated", false)
+
+	handler, err := crud.NewDdbUpdateHandler(s.config, s.logger(), s.transformer)
+	s.Require().NoError(err)
+
+	response := httpserver.HttpTest("PUT", "/:hash/:range", "/id/1", `{"name":"updated"}`, handler)
+
+	s.Equal(http.StatusOK, response.Code)
+	s.JSONEq(`{"id":"id","sort":1,"name":"updated"}`, response.Body.String())
+}
+
+func (s *ddbWriteTestSuite) TestUpdate_Deleted() {
+	s.transformer.expectGetItem(true, "foobar")
+	s.expectPutItem("attribute_exists (#0)", "updated", true)
+
+	handler, err := crud.NewDdbUpdateHandler(s.config, s.logger(), s.transformer)
+	s.Require().NoError(err)
+
+	response := httpserver.HttpTest("PUT", "/:hash/:range", "/id/1", `{"name":"updated"}`, handler)
+
+	s.Equal(http.StatusNotFound, response.Code)
+}
+
+func (s *ddbWriteTestSuite) TestUpdate_KeyChanged() {
+	s.transformer.expectGetItem(true, "foobar")
+
+	handler, err := crud.NewDdbUpdateHandler(s.config, s.logger(), s.transformer)
+	s.Require().NoError(err)
+
+	response := httpserver.HttpTest("PUT", "/:hash/:range", "/id/1", `{"name":"updated","sort":2}`, handler)
+
+	s.Equal(http.StatusBadRequest, response.Code)
+	s.JSONEq(`{"err":"validation: the range key of an item can not be changed"}`, response.Body.String())
+}
+
+func (s *ddbWriteTestSuite) TestPatch() {
+	s.transformer.expectGetItem(true, "foobar")
+	s.expectPutItem("attribute_exists (#0)", "patched", false)
+
+	handler, err := crud.NewDdbPatchHandler(s.config, s.logger(), s.transformer)
+	s.Require().NoError(err)
+
+	response := httpserver.HttpTest("PATCH", "/:hash/:range", "/id/1", `{"name":"patched"}`, handler)
+
+	s.Equal(http.StatusOK, response.Code)
+	s.JSONEq(`{"id":"id","sort":1,"name":"patched"}`, response.Body.String())
+}
+
+func (s *ddbWriteTestSuite) TestPatch_KeyChanged() {
+	s.transformer.expectGetItem(true, "foobar")
+
+	handler, err := crud.NewDdbPatchHandler(s.config, s.logger(), s.transformer)
+	s.Require().NoError(err)
+
+	response := httpserver.HttpTest("PATCH", "/:hash/:range", "/id/1", `{"sort":2}`, handler)
+
+	s.Equal(http.StatusBadRequest, response.Code)
+	s.JSONEq(`{"err":"validation: the range key of an item can not be changed"}`, response.Body.String())
+}
+
+func (s *ddbWriteTestSuite) TestDelete() {
+	s.transformer.expectGetItem(true, "foobar")
+	s.transformer.Repo.EXPECT().DeleteItemBuilder().Return(ddb.NewDeleteItemBuilder(s.transformer.metadata)).Once()
+	s.transformer.Repo.EXPECT().DeleteItem(matcher.Context, mock.Anything, &DdbModel{Id: "id", Sort: 1, Name: "foobar"}).
+		Return(&ddb.DeleteItemResult{}, nil).
+		Once()
+
+	handler, err := crud.NewDdbDeleteHandler(s.config, s.logger(), s.transformer)
+	s.Require().NoError(err)
+
+	response := httpserver.HttpTest("DELETE", "/:hash/:range", "/id/1", "", handler)
+
+	s.Equal(http.StatusOK, response.Code)
+	s.JSONEq(`{"id":"id","sort":1,"name":"foobar"}`, response.Body.String())
+}
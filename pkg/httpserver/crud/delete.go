@@ -20,9 +20,18 @@ type deleteHandler struct {
 }
 
 func NewDeleteHandler(config cfg.Config, logger log.Logger, transformer BaseHandler) (gin.HandlerFunc, error) {
+	dh, err := newDeleteHandler(config, logger, transformer)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpserver.CreateHandler(dh), nil
+}
+
+func newDeleteHandler(config cfg.Config, logger log.Logger, transformer BaseHandler) (deleteHandler, error) {
 	settings := Settings{}
 	if err := config.UnmarshalKey(SettingsConfigKey, &settings); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal delete handler settings: %w", err)
+		return deleteHandler{}, fmt.Errorf("failed to unmarshal delete handler settings: %w", err)
 	}
 	dh := deleteHandler{
 		transformer: transformer,
@@ -30,7 +39,11 @@ func NewDeleteHandler(config cfg.Config, logger log.Logger, transformer BaseHand
 		settings:    settings,
 	}
 
-	return httpserver.CreateHandler(dh), nil
+	return dh, nil
+}
+
+func (dh deleteHandler) GetOutput() any {
+	return getOutput(dh.transformer)
 }
 
 func (dh deleteHandler) Handle(reqCtx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
@@ -57,11 +70,16 @@ func (dh deleteHandler) Handle(reqCtx context.Context, request *httpserver.Reque
 		return HandleErrorOnWrite(ctx, dh.logger, err)
 	}
 
-	err = repo.Delete(ctx, model)
+	err = checkIfMatch(request.Header, model)
 	if err != nil {
 		return HandleErrorOnWrite(ctx, dh.logger, err)
 	}
 
+	err = repo.Delete(ctx, model)
+	if err != nil {
+		return HandleErrorOnWrite(ctx, dh.logger, checkWriteConflict(request.Header, err))
+	}
+
 	apiView := GetApiViewFromHeader(request.Header)
 	out, err := dh.transformer.TransformOutput(ctx, model, apiView)
 	if err != nil {
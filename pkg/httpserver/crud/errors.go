@@ -13,12 +13,15 @@ import (
 	"github.com/justtrackio/gosoline/pkg/log"
 )
 
-var ErrModelNotChanged = fmt.Errorf("nothing has changed on model")
+var (
+	ErrModelNotChanged = fmt.Errorf("nothing has changed on model")
+	ErrItemNotFound    = fmt.Errorf("could not find the item")
+)
 
 // HandleErrorOnRead handles errors for read operations.
 // Covers many default errors and responses like
 //   - context.Canceled, context.DeadlineExceed -> HTTP 499
-//   - dbRepo.RecordNotFoundError | dbRepo.NoQueryResultsError -> HTTP 404
+//   - dbRepo.RecordNotFoundError | dbRepo.NoQueryResultsError | ErrItemNotFound -> HTTP 404
 func HandleErrorOnRead(ctx context.Context, logger log.Logger, err error) (*httpserver.Response, error) {
 	if exec.IsRequestCanceled(err) {
 		logger.Info(ctx, "read model(s) aborted: %s", err.Error())
@@ -26,7 +29,7 @@ func HandleErrorOnRead(ctx context.Context, logger log.Logger, err error) (*http
 		return httpserver.NewStatusResponse(httpserver.HttpStatusClientWentAway), nil
 	}
 
-	if dbRepo.IsRecordNotFoundError(err) || dbRepo.IsNoQueryResultsError(err) {
+	if dbRepo.IsRecordNotFoundError(err) || dbRepo.IsNoQueryResultsError(err) || errors.Is(err, ErrItemNotFound) {
 		logger.Warn(ctx, "failed to read model(s): %s", err.Error())
 
 		return httpserver.NewStatusResponse(http.StatusNotFound), nil
@@ -39,9 +42,11 @@ func HandleErrorOnRead(ctx context.Context, logger log.Logger, err error) (*http
 // HandleErrorOnWrite handles errors for write operations.
 // Covers many default errors and responses like
 //   - context.Canceled, context.DeadlineExceed -> HTTP 500
-//   - dbRepo.RecordNotFoundError | dbRepo.NoQueryResultsError -> HTTP 404
+//   - dbRepo.RecordNotFoundError | dbRepo.NoQueryResultsError | ErrItemNotFound -> HTTP 404
 //   - ErrModelNotChanged -> HTTP 304
 //   - db.IsDuplicateEntryError -> HTTP 409
+//   - dbRepo.VersionConflictError -> HTTP 409
+//   - ErrPreconditionFailed -> HTTP 412
 func HandleErrorOnWrite(ctx context.Context, logger log.Logger, err error) (*httpserver.Response, error) {
 	if exec.IsRequestCanceled(err) {
 		logger.Error(ctx, "failed to update model(s): %w", err)
@@ -49,7 +54,7 @@ func HandleErrorOnWrite(ctx context.Context, logger log.Logger, err error) (*htt
 		return httpserver.NewStatusResponse(http.StatusInternalServerError), nil
 	}
 
-	if dbRepo.IsRecordNotFoundError(err) || dbRepo.IsNoQueryResultsError(err) {
+	if dbRepo.IsRecordNotFoundError(err) || dbRepo.IsNoQueryResultsError(err) || errors.Is(err, ErrItemNotFound) {
 		logger.Warn(ctx, "failed to fetch model(s): %s", err.Error())
 
 		return httpserver.NewStatusResponse(http.StatusNotFound), nil
@@ -65,6 +70,18 @@ func HandleErrorOnWrite(ctx context.Context, logger log.Logger, err error) (*htt
 		return httpserver.NewStatusResponse(http.StatusConflict), nil
 	}
 
+	if errors.Is(err, ErrPreconditionFailed) {
+		logger.Info(ctx, "rejecting write: %s", err.Error())
+
+		return httpserver.NewStatusResponse(http.StatusPreconditionFailed), nil
+	}
+
+	if dbRepo.IsVersionConflictError(err) {
+		logger.Warn(ctx, "failed to write model(s): %s", err.Error())
+
+		return httpserver.NewStatusResponse(http.StatusConflict), nil
+	}
+
 	// rely on the outside handling of access forbidden and HTTP 500
 	return nil, err
 }
@@ -0,0 +1,103 @@
+package crud
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strings"
+
+	dbRepo "github.com/justtrackio/gosoline/pkg/db-repo"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+)
+
+const (
+	HeaderETag    = "ETag"
+	HeaderIfMatch = "If-Match"
+)
+
+var ErrPreconditionFailed = fmt.Errorf("the model does not match the If-Match precondition")
+
+// GetETag returns the entity tag of a model. It is derived from the version of a dbRepo.Versioned model, whose writes
+// are conditional on the version. Other models get a weak entity tag derived from their update time. As the check of
+// the If-Match header and the write of such a model aren't atomic, a concurrent write between them isn't detected.
+func GetETag(model dbRepo.ModelBased) string {
+	if versioned, ok := model.(dbRepo.Versioned); ok {
+		return fmt.Sprintf(`"v%d"`, versioned.GetVersion())
+	}
+
+	if timestamped, ok := model.(dbRepo.TimestampAware); ok && timestamped.GetUpdatedAt() != nil {
+		return fmt.Sprintf(`W/"%d"`, timestamped.GetUpdatedAt().UnixNano())
+	}
+
+	return ""
+}
+
+// checkIfMatch fails with ErrPreconditionFailed if the request has an If-Match header which doesn't match the entity
+// tag of the model. The tags of versioned models are compared strongly, so a weak tag never matches them. The weak tags
+// of other models are compared weakly.
+func checkIfMatch(header http.Header, model dbRepo.ModelBased) error {
+	if !hasIfMatch(header) {
+		return nil
+	}
+
+	etag := GetETag(model)
+
+	for _, value := range header.Values(HeaderIfMatch) {
+		for _, tag := range strings.Split(value, ",") {
+			tag = strings.TrimSpace(tag)
+
+			if tag == "*" || etagMatches(etag, tag) {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("%w: the model is at %s", ErrPreconditionFailed, etag)
+}
+
+func etagMatches(etag string, tag string) bool {
+	if etag == "" {
+		return false
+	}
+
+	if weak, ok := strings.CutPrefix(etag, "W/"); ok {
+		return strings.TrimPrefix(tag, "W/") == weak
+	}
+
+	return tag == etag
+}
+
+// checkWriteConflict turns the conflict of a conditional write into a failed precondition if the client made the
+// request conditional.
+func checkWriteConflict(header http.Header, err error) error {
+	if hasIfMatch(header) && dbRepo.IsVersionConflictError(err) {
+		return fmt.Errorf("%w: %w", ErrPreconditionFailed, err)
+	}
+
+	return err
+}
+
+func hasIfMatch(header http.Header) bool {
+	return len(header.Values(HeaderIfMatch)) > 0
+}
+
+// listETag returns a weak entity tag of the output of a list handler, as the list doesn't have a version of its own.
+func listETag(out Output) (string, error) {
+	bytes, err := json.Marshal(out)
+	if err != nil {
+		return "", fmt.Errorf("can not marshal list output: %w", err)
+	}
+
+	sum := sha256.Sum256(bytes)
+
+	return fmt.Sprintf(`W/"%x"`, sum[:16]), nil
+}
+
+func withETag(resp *httpserver.Response, model dbRepo.ModelBased) *httpserver.Response {
+	if etag := GetETag(model); etag != "" {
+		resp.AddHeader(HeaderETag, etag)
+	}
+
+	return resp
+}
@@ -0,0 +1,30 @@
+package crud_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/db-repo"
+	"github.com/justtrackio/gosoline/pkg/httpserver/crud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetETag(t *testing.T) {
+	versioned := &VersionedModel{
+		Versioning: db_repo.Versioning{
+			Version: 3,
+		},
+	}
+	assert.Equal(t, `"v3"`, crud.GetETag(versioned))
+
+	updatedAt := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	unversioned := &db_repo.Model{
+		Timestamps: db_repo.Timestamps{
+			UpdatedAt: &updatedAt,
+		},
+	}
+	assert.Equal(t, fmt.Sprintf(`W/"%d"`, updatedAt.UnixNano()), crud.GetETag(unversioned))
+
+	assert.Equal(t, "", crud.GetETag(&db_repo.Model{}))
+}
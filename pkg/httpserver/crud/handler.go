@@ -23,6 +23,9 @@ type Settings struct {
 	// Applies to create, update and delete handlers.
 	// Write timeout is the maximum duration before canceling any write operation.
 	WriteTimeout time.Duration `cfg:"write_timeout" default:"10m" validate:"min=1000000000"`
+	// Applies to bulk handlers.
+	// Bulk max items is the maximum number of items of a single bulk request. A value of 0 disables the limit.
+	BulkMaxItems int `cfg:"bulk_max_items" default:"1000" validate:"min=0"`
 }
 
 //go:generate go run github.com/vektra/mockery/v2 --name Repository
@@ -83,6 +86,15 @@ type BaseListHandler interface {
 	List(ctx context.Context, qb *dbRepo.QueryBuilder, apiView string) (out any, err error)
 }
 
+// BaseCursorListHandler can be implemented by list handlers whose List results don't contain the fields the list is
+// ordered by. The cursors of a cursor paginated list are built from the values returned for the first and the last
+// result of a page, in the order of the given fields. Without it, the values are looked up in the fields of the results.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name BaseCursorListHandler
+type BaseCursorListHandler interface {
+	CursorValues(result any, fields []string) (values []any, err error)
+}
+
 //go:generate go run github.com/vektra/mockery/v2 --name ListHandler
 type ListHandler interface {
 	BaseHandler
@@ -125,12 +137,12 @@ func AddCrudHandlers(config cfg.Config, logger log.Logger, d *httpserver.Definit
 func AddCreateHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler CreateHandler) error {
 	path, _ := getHandlerPaths(version, basePath)
 
-	createHandler, err := NewCreateHandler(config, logger, handler)
+	ch, err := newCreateHandler(config, logger, handler)
 	if err != nil {
 		return fmt.Errorf("failed to create handler: %w", err)
 	}
 
-	d.POST(path, createHandler)
+	d.POST(path, httpserver.CreateJsonHandler(ch))
 
 	return nil
 }
@@ -138,18 +150,19 @@ func AddCreateHandler(config cfg.Config, logger log.Logger, d *httpserver.Defini
 func AddReadHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler BaseHandler) {
 	_, idPath := getHandlerPaths(version, basePath)
 
-	d.GET(idPath, NewReadHandler(config, logger, handler))
+	rh := newReadHandler(config, logger, handler)
+	d.GET(idPath, httpserver.CreateHandler(rh))
 }
 
 func AddUpdateHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler UpdateHandler) error {
 	_, idPath := getHandlerPaths(version, basePath)
 
-	updateHandler, err := NewUpdateHandler(config, logger, handler)
+	uh, err := newUpdateHandler(config, logger, handler)
 	if err != nil {
 		return fmt.Errorf("failed to create update handler: %w", err)
 	}
 
-	d.PUT(idPath, updateHandler)
+	d.PUT(idPath, httpserver.CreateJsonHandler(uh))
 
 	return nil
 }
@@ -157,12 +170,12 @@ func AddUpdateHandler(config cfg.Config, logger log.Logger, d *httpserver.Defini
 func AddPatchHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler PatchHandler) error {
 	_, idPath := getHandlerPaths(version, basePath)
 
-	patchHandler, err := NewPatchHandler(config, logger, handler)
+	ph, err := newPatchHandler(config, logger, handler)
 	if err != nil {
 		return fmt.Errorf("failed to create patch handler: %w", err)
 	}
 
-	d.PATCH(idPath, patchHandler)
+	d.PATCH(idPath, httpserver.CreateJsonHandler(ph))
 
 	return nil
 }
@@ -170,12 +183,12 @@ func AddPatchHandler(config cfg.Config, logger log.Logger, d *httpserver.Definit
 func AddDeleteHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler BaseHandler) error {
 	_, idPath := getHandlerPaths(version, basePath)
 
-	deleteHandler, err := NewDeleteHandler(config, logger, handler)
+	dh, err := newDeleteHandler(config, logger, handler)
 	if err != nil {
 		return fmt.Errorf("failed to create delete handler: %w", err)
 	}
 
-	d.DELETE(idPath, deleteHandler)
+	d.DELETE(idPath, httpserver.CreateHandler(dh))
 
 	return nil
 }
@@ -183,7 +196,18 @@ func AddDeleteHandler(config cfg.Config, logger log.Logger, d *httpserver.Defini
 func AddListHandler(config cfg.Config, logger log.Logger, d *httpserver.Definitions, version int, basePath string, handler ListHandler) {
 	plural := inflection.Plural(basePath)
 	path := fmt.Sprintf("/v%d/%s", version, plural)
-	d.POST(path, NewListHandler(config, logger, handler))
+	lh := newListHandler(config, logger, handler)
+	d.POST(path, httpserver.CreateJsonHandler(lh))
+}
+
+// getOutput returns a value of the type of the output of the handler for the openapi document. A handler transforming
+// its models into another type describes its output by implementing httpserver.HandlerWithOutput.
+func getOutput(transformer BaseHandler) any {
+	if withOutput, ok := transformer.(httpserver.HandlerWithOutput); ok {
+		return withOutput.GetOutput()
+	}
+
+	return transformer.GetModel()
 }
 
 func getHandlerPaths(version int, basePath string) (path string, idPath string) {
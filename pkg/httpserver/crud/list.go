@@ -2,6 +2,8 @@ package crud
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 
 	"github.com/gin-gonic/gin"
 	"github.com/justtrackio/gosoline/pkg/cfg"
@@ -12,8 +14,10 @@ import (
 )
 
 type Output struct {
-	Total   int `json:"total"`
-	Results any `json:"results"`
+	Total   int    `json:"total"`
+	Results any    `json:"results"`
+	Next    string `json:"next,omitempty"`
+	Prev    string `json:"prev,omitempty"`
 }
 
 type listHandler struct {
@@ -21,22 +25,47 @@ type listHandler struct {
 	logger      log.Logger
 }
 
-func NewListHandler(_ cfg.Config, logger log.Logger, transformer ListHandler) gin.HandlerFunc {
+func NewListHandler(config cfg.Config, logger log.Logger, transformer ListHandler) gin.HandlerFunc {
+	return httpserver.CreateJsonHandler(newListHandler(config, logger, transformer))
+}
+
+func newListHandler(_ cfg.Config, logger log.Logger, transformer ListHandler) listHandler {
 	lh := listHandler{
 		transformer: transformer,
 		logger:      logger,
 	}
 
-	return httpserver.CreateJsonHandler(lh)
+	return lh
 }
 
 func (lh listHandler) GetInput() any {
 	return sql.NewInput()
 }
 
+func (lh listHandler) GetOutput() any {
+	results := reflect.TypeOf(getOutput(lh.transformer))
+	if results == nil {
+		return &Output{}
+	}
+
+	// Output with the type of the results, so the openapi document can describe them
+	output := reflect.StructOf([]reflect.StructField{
+		{Name: "Total", Type: reflect.TypeOf(0), Tag: `json:"total"`},
+		{Name: "Results", Type: reflect.SliceOf(results), Tag: `json:"results"`},
+		{Name: "Next", Type: reflect.TypeOf(""), Tag: `json:"next,omitempty"`},
+		{Name: "Prev", Type: reflect.TypeOf(""), Tag: `json:"prev,omitempty"`},
+	})
+
+	return reflect.New(output).Interface()
+}
+
 func (lh listHandler) Handle(ctx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
 	inp := request.Body.(*sql.Input)
 
+	if inp.Page.IsCursor() {
+		return lh.handleCursorPage(ctx, request, inp)
+	}
+
 	repo := lh.transformer.GetRepository()
 	metadata := repo.GetMetadata()
 
@@ -65,8 +94,110 @@ func (lh listHandler) Handle(ctx context.Context, request *httpserver.Request) (
 		Results: results,
 	}
 
+	etag, err := listETag(out)
+	if err != nil {
+		return HandleErrorOnRead(ctx, lh.logger, err)
+	}
+
+	resp := httpserver.NewJsonResponse(out)
+	resp.AddHeader(httpserver.ApiViewKey, apiView)
+	resp.AddHeader(HeaderETag, etag)
+
+	return resp, nil
+}
+
+// handleCursorPage lists one more row than requested to find out if there is another page and counts the rows
+// without the cursor, so the total is the same for all pages. Like for offset pages, the results are returned by
+// List, which gets the query builder selecting the rows after the cursor.
+func (lh listHandler) handleCursorPage(ctx context.Context, request *httpserver.Request, inp *sql.Input) (*httpserver.Response, error) {
+	repo := lh.transformer.GetRepository()
+	metadata := repo.GetMetadata()
+	lqb := sql.NewOrmQueryBuilder(metadata, sql.WithModel(lh.transformer.GetModel()))
+
+	page := cursorPage{
+		handler: lh.transformer,
+		page:    inp.Page,
+		request: &sql.Cursor{Direction: sql.CursorNext},
+	}
+
+	keys, err := lqb.CursorKeys(inp)
+	if err == nil && *inp.Page.Cursor != "" {
+		page.request, err = sql.DecodeCursor(*inp.Page.Cursor, keys)
+	}
+
+	if err != nil {
+		return HandleErrorOnRead(ctx, lh.logger, &validation.Error{
+			Errors: []error{err},
+		})
+	}
+
+	page.keys = keys
+
+	listInp := *inp
+	listInp.Page = &sql.Page{
+		Limit:  inp.Page.Limit + 1,
+		Offset: inp.Page.Offset,
+		Cursor: inp.Page.Cursor,
+	}
+
+	countInp := *inp
+	countInp.Page = nil
+
+	qb, err := lqb.Build(&listInp)
+	if err != nil {
+		return HandleErrorOnRead(ctx, lh.logger, &validation.Error{
+			Errors: []error{err},
+		})
+	}
+
+	countQb, err := lqb.Build(&countInp)
+	if err != nil {
+		return HandleErrorOnRead(ctx, lh.logger, &validation.Error{
+			Errors: []error{err},
+		})
+	}
+
+	apiView := GetApiViewFromHeader(request.Header)
+	results, err := lh.transformer.List(ctx, qb, apiView)
+	if err != nil {
+		return HandleErrorOnRead(ctx, lh.logger, err)
+	}
+
+	rows := reflect.ValueOf(results)
+	if results == nil {
+		rows = reflect.ValueOf([]any{})
+	}
+
+	if rows.Kind() != reflect.Slice {
+		return HandleErrorOnRead(ctx, lh.logger, fmt.Errorf("the results of a cursor paginated list have to be a slice but are of type %T", results))
+	}
+
+	model := lh.transformer.GetModel()
+	total, err := repo.Count(ctx, countQb, model)
+	if err != nil {
+		return HandleErrorOnRead(ctx, lh.logger, err)
+	}
+
+	rows, next, prev, err := page.build(rows)
+	if err != nil {
+		return HandleErrorOnRead(ctx, lh.logger, err)
+	}
+
+	out := Output{
+		Total:   total,
+		Results: rows.Interface(),
+		Next:    next,
+		Prev:    prev,
+	}
+
+	etag, err := listETag(out)
+	if err != nil {
+		return HandleErrorOnRead(ctx, lh.logger, err)
+	}
+
 	resp := httpserver.NewJsonResponse(out)
 	resp.AddHeader(httpserver.ApiViewKey, apiView)
+	resp.AddHeader(HeaderETag, etag)
 
 	return resp, nil
 }
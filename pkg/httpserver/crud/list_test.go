@@ -1,6 +1,7 @@
 package crud_test
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
@@ -8,9 +9,12 @@ import (
 	"github.com/justtrackio/gosoline/pkg/db-repo"
 	"github.com/justtrackio/gosoline/pkg/httpserver"
 	"github.com/justtrackio/gosoline/pkg/httpserver/crud"
+	"github.com/justtrackio/gosoline/pkg/httpserver/sql"
 	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/mdl"
 	"github.com/justtrackio/gosoline/pkg/test/matcher"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestListHandler_Handle(t *testing.T) {
@@ -44,3 +48,116 @@ func TestListHandler_Handle(t *testing.T) {
 
 	transformer.Repo.AssertExpectations(t)
 }
+
+type cursorHandler struct {
+	handler
+}
+
+type cursorOutput struct {
+	Name *string `json:"name"`
+	id   uint
+}
+
+// List leaves out the id, so the cursors can't be built from the results without CursorValues
+func (h cursorHandler) List(ctx context.Context, qb *db_repo.QueryBuilder, _ string) (any, error) {
+	var models []*Model
+	if err := h.Repo.Query(ctx, qb, &models); err != nil {
+		return nil, err
+	}
+
+	results := make([]*cursorOutput, len(models))
+	for i, model := range models {
+		results[i] = &cursorOutput{Name: model.Name, id: *model.Id}
+	}
+
+	return results, nil
+}
+
+func (h cursorHandler) CursorValues(result any, _ []string) ([]any, error) {
+	output := result.(*cursorOutput)
+
+	return []any{output.Name, output.id}, nil
+}
+
+func TestListHandler_HandleCursor(t *testing.T) {
+	newModel := func(id uint, name *string) *Model {
+		return &Model{
+			Model: db_repo.Model{Id: mdl.Box(id)},
+			Name:  name,
+		}
+	}
+
+	keys := []sql.CursorKey{
+		{Field: "name", Column: "name", Direction: sql.DirectionAsc, Nullable: true},
+		{Field: "id", Column: "id", Direction: sql.DirectionAsc},
+	}
+	cursor := func(direction string, name *string, id uint) string {
+		encoded, err := sql.EncodeCursor(direction, keys, []any{name, id})
+		assert.NoError(t, err)
+
+		return encoded
+	}
+
+	tests := map[string]struct {
+		cursor   string
+		results  []*Model
+		expected string
+	}{
+		"first page": {
+			cursor:   "",
+			results:  []*Model{newModel(1, mdl.Box("a")), newModel(2, mdl.Box("b")), newModel(3, mdl.Box("c"))},
+			expected: `{"total":4,"results":[{"name":"a"},{"name":"b"}],"next":"` + cursor(sql.CursorNext, mdl.Box("b"), 2) + `"}`,
+		},
+		"last page": {
+			cursor:   cursor(sql.CursorNext, mdl.Box("b"), 2),
+			results:  []*Model{newModel(3, mdl.Box("c")), newModel(4, nil)},
+			expected: `{"total":4,"results":[{"name":"c"},{"name":null}],"prev":"` + cursor(sql.CursorPrev, mdl.Box("c"), 3) + `"}`,
+		},
+		"back to the first page": {
+			cursor:   cursor(sql.CursorPrev, mdl.Box("c"), 3),
+			results:  []*Model{newModel(2, mdl.Box("b")), newModel(1, mdl.Box("a"))},
+			expected: `{"total":4,"results":[{"name":"a"},{"name":"b"}],"next":"` + cursor(sql.CursorNext, mdl.Box("b"), 2) + `"}`,
+		},
+		"back from a null order key": {
+			cursor:   cursor(sql.CursorPrev, nil, 4),
+			results:  []*Model{newModel(3, mdl.Box("c")), newModel(2, mdl.Box("b")), newModel(1, mdl.Box("a"))},
+			expected: `{"total":4,"results":[{"name":"b"},{"name":"c"}],"next":"` + cursor(sql.CursorNext, mdl.Box("c"), 3) + `","prev":"` + cursor(sql.CursorPrev, mdl.Box("b"), 2) + `"}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := configMocks.NewConfig(t)
+			logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+			transformer := cursorHandler{
+				handler: newHandler(t),
+			}
+			handler := crud.NewListHandler(config, logger, transformer)
+
+			qb := db_repo.NewQueryBuilder()
+			qb.Table("footable")
+			qb.Where("", []any{}...)
+			qb.GroupBy("id")
+			qb.OrderBy("name", "ASC")
+
+			transformer.Repo.EXPECT().GetMetadata().Return(db_repo.Metadata{
+				TableName:  "footable",
+				PrimaryKey: "id",
+				Mappings: db_repo.FieldMappings{
+					"id":   db_repo.NewFieldMapping("id"),
+					"name": db_repo.NewFieldMapping("name"),
+				},
+			})
+			transformer.Repo.EXPECT().Query(matcher.Context, mock.AnythingOfType("*db_repo.QueryBuilder"), mock.Anything).Run(func(_ context.Context, _ *db_repo.QueryBuilder, result any) {
+				*result.(*[]*Model) = test.results
+			}).Return(nil)
+			transformer.Repo.EXPECT().Count(matcher.Context, qb, &Model{}).Return(4, nil)
+
+			body := `{"order":[{"field":"name","direction":"ASC"}],"page":{"limit":2,"cursor":"` + test.cursor + `"}}`
+			response := httpserver.HttpTest("PUT", "/", "/", body, handler)
+
+			assert.Equal(t, http.StatusOK, response.Code)
+			assert.JSONEq(t, test.expected, response.Body.String())
+		})
+	}
+}
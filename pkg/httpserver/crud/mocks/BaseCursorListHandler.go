@@ -0,0 +1,91 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// BaseCursorListHandler is an autogenerated mock type for the BaseCursorListHandler type
+type BaseCursorListHandler struct {
+	mock.Mock
+}
+
+type BaseCursorListHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BaseCursorListHandler) EXPECT() *BaseCursorListHandler_Expecter {
+	return &BaseCursorListHandler_Expecter{mock: &_m.Mock}
+}
+
+// CursorValues provides a mock function with given fields: result, fields
+func (_m *BaseCursorListHandler) CursorValues(result interface{}, fields []string) ([]interface{}, error) {
+	ret := _m.Called(result, fields)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CursorValues")
+	}
+
+	var r0 []interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}, []string) ([]interface{}, error)); ok {
+		return rf(result, fields)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}, []string) []interface{}); ok {
+		r0 = rf(result, fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}, []string) error); ok {
+		r1 = rf(result, fields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BaseCursorListHandler_CursorValues_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CursorValues'
+type BaseCursorListHandler_CursorValues_Call struct {
+	*mock.Call
+}
+
+// CursorValues is a helper method to define mock.On call
+//   - result interface{}
+//   - fields []string
+func (_e *BaseCursorListHandler_Expecter) CursorValues(result interface{}, fields interface{}) *BaseCursorListHandler_CursorValues_Call {
+	return &BaseCursorListHandler_CursorValues_Call{Call: _e.mock.On("CursorValues", result, fields)}
+}
+
+func (_c *BaseCursorListHandler_CursorValues_Call) Run(run func(result interface{}, fields []string)) *BaseCursorListHandler_CursorValues_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *BaseCursorListHandler_CursorValues_Call) Return(values []interface{}, err error) *BaseCursorListHandler_CursorValues_Call {
+	_c.Call.Return(values, err)
+	return _c
+}
+
+func (_c *BaseCursorListHandler_CursorValues_Call) RunAndReturn(run func(interface{}, []string) ([]interface{}, error)) *BaseCursorListHandler_CursorValues_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBaseCursorListHandler creates a new instance of BaseCursorListHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBaseCursorListHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BaseCursorListHandler {
+	mock := &BaseCursorListHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
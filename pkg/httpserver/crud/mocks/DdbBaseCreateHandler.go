@@ -0,0 +1,131 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DdbBaseCreateHandler is an autogenerated mock type for the DdbBaseCreateHandler type
+type DdbBaseCreateHandler struct {
+	mock.Mock
+}
+
+type DdbBaseCreateHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DdbBaseCreateHandler) EXPECT() *DdbBaseCreateHandler_Expecter {
+	return &DdbBaseCreateHandler_Expecter{mock: &_m.Mock}
+}
+
+// GetCreateInput provides a mock function with no fields
+func (_m *DdbBaseCreateHandler) GetCreateInput() interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreateInput")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// DdbBaseCreateHandler_GetCreateInput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreateInput'
+type DdbBaseCreateHandler_GetCreateInput_Call struct {
+	*mock.Call
+}
+
+// GetCreateInput is a helper method to define mock.On call
+func (_e *DdbBaseCreateHandler_Expecter) GetCreateInput() *DdbBaseCreateHandler_GetCreateInput_Call {
+	return &DdbBaseCreateHandler_GetCreateInput_Call{Call: _e.mock.On("GetCreateInput")}
+}
+
+func (_c *DdbBaseCreateHandler_GetCreateInput_Call) Run(run func()) *DdbBaseCreateHandler_GetCreateInput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbBaseCreateHandler_GetCreateInput_Call) Return(_a0 interface{}) *DdbBaseCreateHandler_GetCreateInput_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbBaseCreateHandler_GetCreateInput_Call) RunAndReturn(run func() interface{}) *DdbBaseCreateHandler_GetCreateInput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransformCreate provides a mock function with given fields: ctx, input, model
+func (_m *DdbBaseCreateHandler) TransformCreate(ctx context.Context, input interface{}, model interface{}) error {
+	ret := _m.Called(ctx, input, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformCreate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, interface{}) error); ok {
+		r0 = rf(ctx, input, model)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DdbBaseCreateHandler_TransformCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformCreate'
+type DdbBaseCreateHandler_TransformCreate_Call struct {
+	*mock.Call
+}
+
+// TransformCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input interface{}
+//   - model interface{}
+func (_e *DdbBaseCreateHandler_Expecter) TransformCreate(ctx interface{}, input interface{}, model interface{}) *DdbBaseCreateHandler_TransformCreate_Call {
+	return &DdbBaseCreateHandler_TransformCreate_Call{Call: _e.mock.On("TransformCreate", ctx, input, model)}
+}
+
+func (_c *DdbBaseCreateHandler_TransformCreate_Call) Run(run func(ctx context.Context, input interface{}, model interface{})) *DdbBaseCreateHandler_TransformCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *DdbBaseCreateHandler_TransformCreate_Call) Return(err error) *DdbBaseCreateHandler_TransformCreate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DdbBaseCreateHandler_TransformCreate_Call) RunAndReturn(run func(context.Context, interface{}, interface{}) error) *DdbBaseCreateHandler_TransformCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDdbBaseCreateHandler creates a new instance of DdbBaseCreateHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDdbBaseCreateHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DdbBaseCreateHandler {
+	mock := &DdbBaseCreateHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,192 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	ddb "github.com/justtrackio/gosoline/pkg/ddb"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DdbBaseHandler is an autogenerated mock type for the DdbBaseHandler type
+type DdbBaseHandler struct {
+	mock.Mock
+}
+
+type DdbBaseHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DdbBaseHandler) EXPECT() *DdbBaseHandler_Expecter {
+	return &DdbBaseHandler_Expecter{mock: &_m.Mock}
+}
+
+// GetModel provides a mock function with no fields
+func (_m *DdbBaseHandler) GetModel() interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetModel")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// DdbBaseHandler_GetModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetModel'
+type DdbBaseHandler_GetModel_Call struct {
+	*mock.Call
+}
+
+// GetModel is a helper method to define mock.On call
+func (_e *DdbBaseHandler_Expecter) GetModel() *DdbBaseHandler_GetModel_Call {
+	return &DdbBaseHandler_GetModel_Call{Call: _e.mock.On("GetModel")}
+}
+
+func (_c *DdbBaseHandler_GetModel_Call) Run(run func()) *DdbBaseHandler_GetModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbBaseHandler_GetModel_Call) Return(_a0 interface{}) *DdbBaseHandler_GetModel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbBaseHandler_GetModel_Call) RunAndReturn(run func() interface{}) *DdbBaseHandler_GetModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepository provides a mock function with no fields
+func (_m *DdbBaseHandler) GetRepository() ddb.Repository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepository")
+	}
+
+	var r0 ddb.Repository
+	if rf, ok := ret.Get(0).(func() ddb.Repository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ddb.Repository)
+		}
+	}
+
+	return r0
+}
+
+// DdbBaseHandler_GetRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepository'
+type DdbBaseHandler_GetRepository_Call struct {
+	*mock.Call
+}
+
+// GetRepository is a helper method to define mock.On call
+func (_e *DdbBaseHandler_Expecter) GetRepository() *DdbBaseHandler_GetRepository_Call {
+	return &DdbBaseHandler_GetRepository_Call{Call: _e.mock.On("GetRepository")}
+}
+
+func (_c *DdbBaseHandler_GetRepository_Call) Run(run func()) *DdbBaseHandler_GetRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbBaseHandler_GetRepository_Call) Return(_a0 ddb.Repository) *DdbBaseHandler_GetRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbBaseHandler_GetRepository_Call) RunAndReturn(run func() ddb.Repository) *DdbBaseHandler_GetRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransformOutput provides a mock function with given fields: ctx, model, apiView
+func (_m *DdbBaseHandler) TransformOutput(ctx context.Context, model interface{}, apiView string) (interface{}, error) {
+	ret := _m.Called(ctx, model, apiView)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformOutput")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, string) (interface{}, error)); ok {
+		return rf(ctx, model, apiView)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, string) interface{}); ok {
+		r0 = rf(ctx, model, apiView)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, string) error); ok {
+		r1 = rf(ctx, model, apiView)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DdbBaseHandler_TransformOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformOutput'
+type DdbBaseHandler_TransformOutput_Call struct {
+	*mock.Call
+}
+
+// TransformOutput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model interface{}
+//   - apiView string
+func (_e *DdbBaseHandler_Expecter) TransformOutput(ctx interface{}, model interface{}, apiView interface{}) *DdbBaseHandler_TransformOutput_Call {
+	return &DdbBaseHandler_TransformOutput_Call{Call: _e.mock.On("TransformOutput", ctx, model, apiView)}
+}
+
+func (_c *DdbBaseHandler_TransformOutput_Call) Run(run func(ctx context.Context, model interface{}, apiView string)) *DdbBaseHandler_TransformOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *DdbBaseHandler_TransformOutput_Call) Return(output interface{}, err error) *DdbBaseHandler_TransformOutput_Call {
+	_c.Call.Return(output, err)
+	return _c
+}
+
+func (_c *DdbBaseHandler_TransformOutput_Call) RunAndReturn(run func(context.Context, interface{}, string) (interface{}, error)) *DdbBaseHandler_TransformOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDdbBaseHandler creates a new instance of DdbBaseHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDdbBaseHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DdbBaseHandler {
+	mock := &DdbBaseHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
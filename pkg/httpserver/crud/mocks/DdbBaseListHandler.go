@@ -0,0 +1,77 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// DdbBaseListHandler is an autogenerated mock type for the DdbBaseListHandler type
+type DdbBaseListHandler struct {
+	mock.Mock
+}
+
+type DdbBaseListHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DdbBaseListHandler) EXPECT() *DdbBaseListHandler_Expecter {
+	return &DdbBaseListHandler_Expecter{mock: &_m.Mock}
+}
+
+// GetListIndex provides a mock function with no fields
+func (_m *DdbBaseListHandler) GetListIndex() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListIndex")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// DdbBaseListHandler_GetListIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListIndex'
+type DdbBaseListHandler_GetListIndex_Call struct {
+	*mock.Call
+}
+
+// GetListIndex is a helper method to define mock.On call
+func (_e *DdbBaseListHandler_Expecter) GetListIndex() *DdbBaseListHandler_GetListIndex_Call {
+	return &DdbBaseListHandler_GetListIndex_Call{Call: _e.mock.On("GetListIndex")}
+}
+
+func (_c *DdbBaseListHandler_GetListIndex_Call) Run(run func()) *DdbBaseListHandler_GetListIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbBaseListHandler_GetListIndex_Call) Return(_a0 string) *DdbBaseListHandler_GetListIndex_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbBaseListHandler_GetListIndex_Call) RunAndReturn(run func() string) *DdbBaseListHandler_GetListIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDdbBaseListHandler creates a new instance of DdbBaseListHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDdbBaseListHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DdbBaseListHandler {
+	mock := &DdbBaseListHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
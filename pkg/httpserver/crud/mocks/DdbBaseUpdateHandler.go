@@ -0,0 +1,131 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DdbBaseUpdateHandler is an autogenerated mock type for the DdbBaseUpdateHandler type
+type DdbBaseUpdateHandler struct {
+	mock.Mock
+}
+
+type DdbBaseUpdateHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DdbBaseUpdateHandler) EXPECT() *DdbBaseUpdateHandler_Expecter {
+	return &DdbBaseUpdateHandler_Expecter{mock: &_m.Mock}
+}
+
+// GetUpdateInput provides a mock function with no fields
+func (_m *DdbBaseUpdateHandler) GetUpdateInput() interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUpdateInput")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// DdbBaseUpdateHandler_GetUpdateInput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUpdateInput'
+type DdbBaseUpdateHandler_GetUpdateInput_Call struct {
+	*mock.Call
+}
+
+// GetUpdateInput is a helper method to define mock.On call
+func (_e *DdbBaseUpdateHandler_Expecter) GetUpdateInput() *DdbBaseUpdateHandler_GetUpdateInput_Call {
+	return &DdbBaseUpdateHandler_GetUpdateInput_Call{Call: _e.mock.On("GetUpdateInput")}
+}
+
+func (_c *DdbBaseUpdateHandler_GetUpdateInput_Call) Run(run func()) *DdbBaseUpdateHandler_GetUpdateInput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbBaseUpdateHandler_GetUpdateInput_Call) Return(_a0 interface{}) *DdbBaseUpdateHandler_GetUpdateInput_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbBaseUpdateHandler_GetUpdateInput_Call) RunAndReturn(run func() interface{}) *DdbBaseUpdateHandler_GetUpdateInput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransformUpdate provides a mock function with given fields: ctx, input, model
+func (_m *DdbBaseUpdateHandler) TransformUpdate(ctx context.Context, input interface{}, model interface{}) error {
+	ret := _m.Called(ctx, input, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, interface{}) error); ok {
+		r0 = rf(ctx, input, model)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DdbBaseUpdateHandler_TransformUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformUpdate'
+type DdbBaseUpdateHandler_TransformUpdate_Call struct {
+	*mock.Call
+}
+
+// TransformUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input interface{}
+//   - model interface{}
+func (_e *DdbBaseUpdateHandler_Expecter) TransformUpdate(ctx interface{}, input interface{}, model interface{}) *DdbBaseUpdateHandler_TransformUpdate_Call {
+	return &DdbBaseUpdateHandler_TransformUpdate_Call{Call: _e.mock.On("TransformUpdate", ctx, input, model)}
+}
+
+func (_c *DdbBaseUpdateHandler_TransformUpdate_Call) Run(run func(ctx context.Context, input interface{}, model interface{})) *DdbBaseUpdateHandler_TransformUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *DdbBaseUpdateHandler_TransformUpdate_Call) Return(err error) *DdbBaseUpdateHandler_TransformUpdate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DdbBaseUpdateHandler_TransformUpdate_Call) RunAndReturn(run func(context.Context, interface{}, interface{}) error) *DdbBaseUpdateHandler_TransformUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDdbBaseUpdateHandler creates a new instance of DdbBaseUpdateHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDdbBaseUpdateHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DdbBaseUpdateHandler {
+	mock := &DdbBaseUpdateHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
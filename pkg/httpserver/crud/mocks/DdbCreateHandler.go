@@ -0,0 +1,287 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	ddb "github.com/justtrackio/gosoline/pkg/ddb"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DdbCreateHandler is an autogenerated mock type for the DdbCreateHandler type
+type DdbCreateHandler struct {
+	mock.Mock
+}
+
+type DdbCreateHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DdbCreateHandler) EXPECT() *DdbCreateHandler_Expecter {
+	return &DdbCreateHandler_Expecter{mock: &_m.Mock}
+}
+
+// GetCreateInput provides a mock function with no fields
+func (_m *DdbCreateHandler) GetCreateInput() interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreateInput")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// DdbCreateHandler_GetCreateInput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreateInput'
+type DdbCreateHandler_GetCreateInput_Call struct {
+	*mock.Call
+}
+
+// GetCreateInput is a helper method to define mock.On call
+func (_e *DdbCreateHandler_Expecter) GetCreateInput() *DdbCreateHandler_GetCreateInput_Call {
+	return &DdbCreateHandler_GetCreateInput_Call{Call: _e.mock.On("GetCreateInput")}
+}
+
+func (_c *DdbCreateHandler_GetCreateInput_Call) Run(run func()) *DdbCreateHandler_GetCreateInput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbCreateHandler_GetCreateInput_Call) Return(_a0 interface{}) *DdbCreateHandler_GetCreateInput_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbCreateHandler_GetCreateInput_Call) RunAndReturn(run func() interface{}) *DdbCreateHandler_GetCreateInput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetModel provides a mock function with no fields
+func (_m *DdbCreateHandler) GetModel() interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetModel")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// DdbCreateHandler_GetModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetModel'
+type DdbCreateHandler_GetModel_Call struct {
+	*mock.Call
+}
+
+// GetModel is a helper method to define mock.On call
+func (_e *DdbCreateHandler_Expecter) GetModel() *DdbCreateHandler_GetModel_Call {
+	return &DdbCreateHandler_GetModel_Call{Call: _e.mock.On("GetModel")}
+}
+
+func (_c *DdbCreateHandler_GetModel_Call) Run(run func()) *DdbCreateHandler_GetModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbCreateHandler_GetModel_Call) Return(_a0 interface{}) *DdbCreateHandler_GetModel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbCreateHandler_GetModel_Call) RunAndReturn(run func() interface{}) *DdbCreateHandler_GetModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepository provides a mock function with no fields
+func (_m *DdbCreateHandler) GetRepository() ddb.Repository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepository")
+	}
+
+	var r0 ddb.Repository
+	if rf, ok := ret.Get(0).(func() ddb.Repository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ddb.Repository)
+		}
+	}
+
+	return r0
+}
+
+// DdbCreateHandler_GetRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepository'
+type DdbCreateHandler_GetRepository_Call struct {
+	*mock.Call
+}
+
+// GetRepository is a helper method to define mock.On call
+func (_e *DdbCreateHandler_Expecter) GetRepository() *DdbCreateHandler_GetRepository_Call {
+	return &DdbCreateHandler_GetRepository_Call{Call: _e.mock.On("GetRepository")}
+}
+
+func (_c *DdbCreateHandler_GetRepository_Call) Run(run func()) *DdbCreateHandler_GetRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbCreateHandler_GetRepository_Call) Return(_a0 ddb.Repository) *DdbCreateHandler_GetRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbCreateHandler_GetRepository_Call) RunAndReturn(run func() ddb.Repository) *DdbCreateHandler_GetRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransformCreate provides a mock function with given fields: ctx, input, model
+func (_m *DdbCreateHandler) TransformCreate(ctx context.Context, input interface{}, model interface{}) error {
+	ret := _m.Called(ctx, input, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformCreate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, interface{}) error); ok {
+		r0 = rf(ctx, input, model)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DdbCreateHandler_TransformCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformCreate'
+type DdbCreateHandler_TransformCreate_Call struct {
+	*mock.Call
+}
+
+// TransformCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input interface{}
+//   - model interface{}
+func (_e *DdbCreateHandler_Expecter) TransformCreate(ctx interface{}, input interface{}, model interface{}) *DdbCreateHandler_TransformCreate_Call {
+	return &DdbCreateHandler_TransformCreate_Call{Call: _e.mock.On("TransformCreate", ctx, input, model)}
+}
+
+func (_c *DdbCreateHandler_TransformCreate_Call) Run(run func(ctx context.Context, input interface{}, model interface{})) *DdbCreateHandler_TransformCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *DdbCreateHandler_TransformCreate_Call) Return(err error) *DdbCreateHandler_TransformCreate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DdbCreateHandler_TransformCreate_Call) RunAndReturn(run func(context.Context, interface{}, interface{}) error) *DdbCreateHandler_TransformCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransformOutput provides a mock function with given fields: ctx, model, apiView
+func (_m *DdbCreateHandler) TransformOutput(ctx context.Context, model interface{}, apiView string) (interface{}, error) {
+	ret := _m.Called(ctx, model, apiView)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformOutput")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, string) (interface{}, error)); ok {
+		return rf(ctx, model, apiView)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, string) interface{}); ok {
+		r0 = rf(ctx, model, apiView)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, string) error); ok {
+		r1 = rf(ctx, model, apiView)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DdbCreateHandler_TransformOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformOutput'
+type DdbCreateHandler_TransformOutput_Call struct {
+	*mock.Call
+}
+
+// TransformOutput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model interface{}
+//   - apiView string
+func (_e *DdbCreateHandler_Expecter) TransformOutput(ctx interface{}, model interface{}, apiView interface{}) *DdbCreateHandler_TransformOutput_Call {
+	return &DdbCreateHandler_TransformOutput_Call{Call: _e.mock.On("TransformOutput", ctx, model, apiView)}
+}
+
+func (_c *DdbCreateHandler_TransformOutput_Call) Run(run func(ctx context.Context, model interface{}, apiView string)) *DdbCreateHandler_TransformOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *DdbCreateHandler_TransformOutput_Call) Return(output interface{}, err error) *DdbCreateHandler_TransformOutput_Call {
+	_c.Call.Return(output, err)
+	return _c
+}
+
+func (_c *DdbCreateHandler_TransformOutput_Call) RunAndReturn(run func(context.Context, interface{}, string) (interface{}, error)) *DdbCreateHandler_TransformOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDdbCreateHandler creates a new instance of DdbCreateHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDdbCreateHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DdbCreateHandler {
+	mock := &DdbCreateHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,486 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	ddb "github.com/justtrackio/gosoline/pkg/ddb"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DdbHandler is an autogenerated mock type for the DdbHandler type
+type DdbHandler struct {
+	mock.Mock
+}
+
+type DdbHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DdbHandler) EXPECT() *DdbHandler_Expecter {
+	return &DdbHandler_Expecter{mock: &_m.Mock}
+}
+
+// GetCreateInput provides a mock function with no fields
+func (_m *DdbHandler) GetCreateInput() interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCreateInput")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// DdbHandler_GetCreateInput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCreateInput'
+type DdbHandler_GetCreateInput_Call struct {
+	*mock.Call
+}
+
+// GetCreateInput is a helper method to define mock.On call
+func (_e *DdbHandler_Expecter) GetCreateInput() *DdbHandler_GetCreateInput_Call {
+	return &DdbHandler_GetCreateInput_Call{Call: _e.mock.On("GetCreateInput")}
+}
+
+func (_c *DdbHandler_GetCreateInput_Call) Run(run func()) *DdbHandler_GetCreateInput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbHandler_GetCreateInput_Call) Return(_a0 interface{}) *DdbHandler_GetCreateInput_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbHandler_GetCreateInput_Call) RunAndReturn(run func() interface{}) *DdbHandler_GetCreateInput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetListIndex provides a mock function with no fields
+func (_m *DdbHandler) GetListIndex() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListIndex")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// DdbHandler_GetListIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListIndex'
+type DdbHandler_GetListIndex_Call struct {
+	*mock.Call
+}
+
+// GetListIndex is a helper method to define mock.On call
+func (_e *DdbHandler_Expecter) GetListIndex() *DdbHandler_GetListIndex_Call {
+	return &DdbHandler_GetListIndex_Call{Call: _e.mock.On("GetListIndex")}
+}
+
+func (_c *DdbHandler_GetListIndex_Call) Run(run func()) *DdbHandler_GetListIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbHandler_GetListIndex_Call) Return(_a0 string) *DdbHandler_GetListIndex_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbHandler_GetListIndex_Call) RunAndReturn(run func() string) *DdbHandler_GetListIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetModel provides a mock function with no fields
+func (_m *DdbHandler) GetModel() interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetModel")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// DdbHandler_GetModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetModel'
+type DdbHandler_GetModel_Call struct {
+	*mock.Call
+}
+
+// GetModel is a helper method to define mock.On call
+func (_e *DdbHandler_Expecter) GetModel() *DdbHandler_GetModel_Call {
+	return &DdbHandler_GetModel_Call{Call: _e.mock.On("GetModel")}
+}
+
+func (_c *DdbHandler_GetModel_Call) Run(run func()) *DdbHandler_GetModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbHandler_GetModel_Call) Return(_a0 interface{}) *DdbHandler_GetModel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbHandler_GetModel_Call) RunAndReturn(run func() interface{}) *DdbHandler_GetModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepository provides a mock function with no fields
+func (_m *DdbHandler) GetRepository() ddb.Repository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepository")
+	}
+
+	var r0 ddb.Repository
+	if rf, ok := ret.Get(0).(func() ddb.Repository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ddb.Repository)
+		}
+	}
+
+	return r0
+}
+
+// DdbHandler_GetRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepository'
+type DdbHandler_GetRepository_Call struct {
+	*mock.Call
+}
+
+// GetRepository is a helper method to define mock.On call
+func (_e *DdbHandler_Expecter) GetRepository() *DdbHandler_GetRepository_Call {
+	return &DdbHandler_GetRepository_Call{Call: _e.mock.On("GetRepository")}
+}
+
+func (_c *DdbHandler_GetRepository_Call) Run(run func()) *DdbHandler_GetRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbHandler_GetRepository_Call) Return(_a0 ddb.Repository) *DdbHandler_GetRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbHandler_GetRepository_Call) RunAndReturn(run func() ddb.Repository) *DdbHandler_GetRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUpdateInput provides a mock function with no fields
+func (_m *DdbHandler) GetUpdateInput() interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUpdateInput")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// DdbHandler_GetUpdateInput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUpdateInput'
+type DdbHandler_GetUpdateInput_Call struct {
+	*mock.Call
+}
+
+// GetUpdateInput is a helper method to define mock.On call
+func (_e *DdbHandler_Expecter) GetUpdateInput() *DdbHandler_GetUpdateInput_Call {
+	return &DdbHandler_GetUpdateInput_Call{Call: _e.mock.On("GetUpdateInput")}
+}
+
+func (_c *DdbHandler_GetUpdateInput_Call) Run(run func()) *DdbHandler_GetUpdateInput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbHandler_GetUpdateInput_Call) Return(_a0 interface{}) *DdbHandler_GetUpdateInput_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbHandler_GetUpdateInput_Call) RunAndReturn(run func() interface{}) *DdbHandler_GetUpdateInput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransformCreate provides a mock function with given fields: ctx, input, model
+func (_m *DdbHandler) TransformCreate(ctx context.Context, input interface{}, model interface{}) error {
+	ret := _m.Called(ctx, input, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformCreate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, interface{}) error); ok {
+		r0 = rf(ctx, input, model)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DdbHandler_TransformCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformCreate'
+type DdbHandler_TransformCreate_Call struct {
+	*mock.Call
+}
+
+// TransformCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input interface{}
+//   - model interface{}
+func (_e *DdbHandler_Expecter) TransformCreate(ctx interface{}, input interface{}, model interface{}) *DdbHandler_TransformCreate_Call {
+	return &DdbHandler_TransformCreate_Call{Call: _e.mock.On("TransformCreate", ctx, input, model)}
+}
+
+func (_c *DdbHandler_TransformCreate_Call) Run(run func(ctx context.Context, input interface{}, model interface{})) *DdbHandler_TransformCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *DdbHandler_TransformCreate_Call) Return(err error) *DdbHandler_TransformCreate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DdbHandler_TransformCreate_Call) RunAndReturn(run func(context.Context, interface{}, interface{}) error) *DdbHandler_TransformCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransformOutput provides a mock function with given fields: ctx, model, apiView
+func (_m *DdbHandler) TransformOutput(ctx context.Context, model interface{}, apiView string) (interface{}, error) {
+	ret := _m.Called(ctx, model, apiView)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformOutput")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, string) (interface{}, error)); ok {
+		return rf(ctx, model, apiView)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, string) interface{}); ok {
+		r0 = rf(ctx, model, apiView)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, string) error); ok {
+		r1 = rf(ctx, model, apiView)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DdbHandler_TransformOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformOutput'
+type DdbHandler_TransformOutput_Call struct {
+	*mock.Call
+}
+
+// TransformOutput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model interface{}
+//   - apiView string
+func (_e *DdbHandler_Expecter) TransformOutput(ctx interface{}, model interface{}, apiView interface{}) *DdbHandler_TransformOutput_Call {
+	return &DdbHandler_TransformOutput_Call{Call: _e.mock.On("TransformOutput", ctx, model, apiView)}
+}
+
+func (_c *DdbHandler_TransformOutput_Call) Run(run func(ctx context.Context, model interface{}, apiView string)) *DdbHandler_TransformOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *DdbHandler_TransformOutput_Call) Return(output interface{}, err error) *DdbHandler_TransformOutput_Call {
+	_c.Call.Return(output, err)
+	return _c
+}
+
+func (_c *DdbHandler_TransformOutput_Call) RunAndReturn(run func(context.Context, interface{}, string) (interface{}, error)) *DdbHandler_TransformOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransformPatch provides a mock function with given fields: ctx, model
+func (_m *DdbHandler) TransformPatch(ctx context.Context, model interface{}) (interface{}, error) {
+	ret := _m.Called(ctx, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformPatch")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}) (interface{}, error)); ok {
+		return rf(ctx, model)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}) interface{}); ok {
+		r0 = rf(ctx, model)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}) error); ok {
+		r1 = rf(ctx, model)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DdbHandler_TransformPatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformPatch'
+type DdbHandler_TransformPatch_Call struct {
+	*mock.Call
+}
+
+// TransformPatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model interface{}
+func (_e *DdbHandler_Expecter) TransformPatch(ctx interface{}, model interface{}) *DdbHandler_TransformPatch_Call {
+	return &DdbHandler_TransformPatch_Call{Call: _e.mock.On("TransformPatch", ctx, model)}
+}
+
+func (_c *DdbHandler_TransformPatch_Call) Run(run func(ctx context.Context, model interface{})) *DdbHandler_TransformPatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *DdbHandler_TransformPatch_Call) Return(updateInput interface{}, err error) *DdbHandler_TransformPatch_Call {
+	_c.Call.Return(updateInput, err)
+	return _c
+}
+
+func (_c *DdbHandler_TransformPatch_Call) RunAndReturn(run func(context.Context, interface{}) (interface{}, error)) *DdbHandler_TransformPatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransformUpdate provides a mock function with given fields: ctx, input, model
+func (_m *DdbHandler) TransformUpdate(ctx context.Context, input interface{}, model interface{}) error {
+	ret := _m.Called(ctx, input, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, interface{}) error); ok {
+		r0 = rf(ctx, input, model)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DdbHandler_TransformUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformUpdate'
+type DdbHandler_TransformUpdate_Call struct {
+	*mock.Call
+}
+
+// TransformUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input interface{}
+//   - model interface{}
+func (_e *DdbHandler_Expecter) TransformUpdate(ctx interface{}, input interface{}, model interface{}) *DdbHandler_TransformUpdate_Call {
+	return &DdbHandler_TransformUpdate_Call{Call: _e.mock.On("TransformUpdate", ctx, input, model)}
+}
+
+func (_c *DdbHandler_TransformUpdate_Call) Run(run func(ctx context.Context, input interface{}, model interface{})) *DdbHandler_TransformUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *DdbHandler_TransformUpdate_Call) Return(err error) *DdbHandler_TransformUpdate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DdbHandler_TransformUpdate_Call) RunAndReturn(run func(context.Context, interface{}, interface{}) error) *DdbHandler_TransformUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDdbHandler creates a new instance of DdbHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDdbHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DdbHandler {
+	mock := &DdbHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
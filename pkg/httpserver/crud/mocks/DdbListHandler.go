@@ -0,0 +1,237 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	ddb "github.com/justtrackio/gosoline/pkg/ddb"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DdbListHandler is an autogenerated mock type for the DdbListHandler type
+type DdbListHandler struct {
+	mock.Mock
+}
+
+type DdbListHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DdbListHandler) EXPECT() *DdbListHandler_Expecter {
+	return &DdbListHandler_Expecter{mock: &_m.Mock}
+}
+
+// GetListIndex provides a mock function with no fields
+func (_m *DdbListHandler) GetListIndex() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListIndex")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// DdbListHandler_GetListIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListIndex'
+type DdbListHandler_GetListIndex_Call struct {
+	*mock.Call
+}
+
+// GetListIndex is a helper method to define mock.On call
+func (_e *DdbListHandler_Expecter) GetListIndex() *DdbListHandler_GetListIndex_Call {
+	return &DdbListHandler_GetListIndex_Call{Call: _e.mock.On("GetListIndex")}
+}
+
+func (_c *DdbListHandler_GetListIndex_Call) Run(run func()) *DdbListHandler_GetListIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbListHandler_GetListIndex_Call) Return(_a0 string) *DdbListHandler_GetListIndex_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbListHandler_GetListIndex_Call) RunAndReturn(run func() string) *DdbListHandler_GetListIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetModel provides a mock function with no fields
+func (_m *DdbListHandler) GetModel() interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetModel")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// DdbListHandler_GetModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetModel'
+type DdbListHandler_GetModel_Call struct {
+	*mock.Call
+}
+
+// GetModel is a helper method to define mock.On call
+func (_e *DdbListHandler_Expecter) GetModel() *DdbListHandler_GetModel_Call {
+	return &DdbListHandler_GetModel_Call{Call: _e.mock.On("GetModel")}
+}
+
+func (_c *DdbListHandler_GetModel_Call) Run(run func()) *DdbListHandler_GetModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbListHandler_GetModel_Call) Return(_a0 interface{}) *DdbListHandler_GetModel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbListHandler_GetModel_Call) RunAndReturn(run func() interface{}) *DdbListHandler_GetModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepository provides a mock function with no fields
+func (_m *DdbListHandler) GetRepository() ddb.Repository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepository")
+	}
+
+	var r0 ddb.Repository
+	if rf, ok := ret.Get(0).(func() ddb.Repository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ddb.Repository)
+		}
+	}
+
+	return r0
+}
+
+// DdbListHandler_GetRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepository'
+type DdbListHandler_GetRepository_Call struct {
+	*mock.Call
+}
+
+// GetRepository is a helper method to define mock.On call
+func (_e *DdbListHandler_Expecter) GetRepository() *DdbListHandler_GetRepository_Call {
+	return &DdbListHandler_GetRepository_Call{Call: _e.mock.On("GetRepository")}
+}
+
+func (_c *DdbListHandler_GetRepository_Call) Run(run func()) *DdbListHandler_GetRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbListHandler_GetRepository_Call) Return(_a0 ddb.Repository) *DdbListHandler_GetRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbListHandler_GetRepository_Call) RunAndReturn(run func() ddb.Repository) *DdbListHandler_GetRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransformOutput provides a mock function with given fields: ctx, model, apiView
+func (_m *DdbListHandler) TransformOutput(ctx context.Context, model interface{}, apiView string) (interface{}, error) {
+	ret := _m.Called(ctx, model, apiView)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformOutput")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, string) (interface{}, error)); ok {
+		return rf(ctx, model, apiView)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, string) interface{}); ok {
+		r0 = rf(ctx, model, apiView)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, string) error); ok {
+		r1 = rf(ctx, model, apiView)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DdbListHandler_TransformOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformOutput'
+type DdbListHandler_TransformOutput_Call struct {
+	*mock.Call
+}
+
+// TransformOutput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model interface{}
+//   - apiView string
+func (_e *DdbListHandler_Expecter) TransformOutput(ctx interface{}, model interface{}, apiView interface{}) *DdbListHandler_TransformOutput_Call {
+	return &DdbListHandler_TransformOutput_Call{Call: _e.mock.On("TransformOutput", ctx, model, apiView)}
+}
+
+func (_c *DdbListHandler_TransformOutput_Call) Run(run func(ctx context.Context, model interface{}, apiView string)) *DdbListHandler_TransformOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *DdbListHandler_TransformOutput_Call) Return(output interface{}, err error) *DdbListHandler_TransformOutput_Call {
+	_c.Call.Return(output, err)
+	return _c
+}
+
+func (_c *DdbListHandler_TransformOutput_Call) RunAndReturn(run func(context.Context, interface{}, string) (interface{}, error)) *DdbListHandler_TransformOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDdbListHandler creates a new instance of DdbListHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDdbListHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DdbListHandler {
+	mock := &DdbListHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
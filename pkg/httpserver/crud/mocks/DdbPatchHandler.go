@@ -0,0 +1,299 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	ddb "github.com/justtrackio/gosoline/pkg/ddb"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DdbPatchHandler is an autogenerated mock type for the DdbPatchHandler type
+type DdbPatchHandler struct {
+	mock.Mock
+}
+
+type DdbPatchHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DdbPatchHandler) EXPECT() *DdbPatchHandler_Expecter {
+	return &DdbPatchHandler_Expecter{mock: &_m.Mock}
+}
+
+// GetModel provides a mock function with no fields
+func (_m *DdbPatchHandler) GetModel() interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetModel")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// DdbPatchHandler_GetModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetModel'
+type DdbPatchHandler_GetModel_Call struct {
+	*mock.Call
+}
+
+// GetModel is a helper method to define mock.On call
+func (_e *DdbPatchHandler_Expecter) GetModel() *DdbPatchHandler_GetModel_Call {
+	return &DdbPatchHandler_GetModel_Call{Call: _e.mock.On("GetModel")}
+}
+
+func (_c *DdbPatchHandler_GetModel_Call) Run(run func()) *DdbPatchHandler_GetModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbPatchHandler_GetModel_Call) Return(_a0 interface{}) *DdbPatchHandler_GetModel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbPatchHandler_GetModel_Call) RunAndReturn(run func() interface{}) *DdbPatchHandler_GetModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepository provides a mock function with no fields
+func (_m *DdbPatchHandler) GetRepository() ddb.Repository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepository")
+	}
+
+	var r0 ddb.Repository
+	if rf, ok := ret.Get(0).(func() ddb.Repository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ddb.Repository)
+		}
+	}
+
+	return r0
+}
+
+// DdbPatchHandler_GetRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepository'
+type DdbPatchHandler_GetRepository_Call struct {
+	*mock.Call
+}
+
+// GetRepository is a helper method to define mock.On call
+func (_e *DdbPatchHandler_Expecter) GetRepository() *DdbPatchHandler_GetRepository_Call {
+	return &DdbPatchHandler_GetRepository_Call{Call: _e.mock.On("GetRepository")}
+}
+
+func (_c *DdbPatchHandler_GetRepository_Call) Run(run func()) *DdbPatchHandler_GetRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbPatchHandler_GetRepository_Call) Return(_a0 ddb.Repository) *DdbPatchHandler_GetRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbPatchHandler_GetRepository_Call) RunAndReturn(run func() ddb.Repository) *DdbPatchHandler_GetRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransformOutput provides a mock function with given fields: ctx, model, apiView
+func (_m *DdbPatchHandler) TransformOutput(ctx context.Context, model interface{}, apiView string) (interface{}, error) {
+	ret := _m.Called(ctx, model, apiView)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformOutput")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, string) (interface{}, error)); ok {
+		return rf(ctx, model, apiView)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, string) interface{}); ok {
+		r0 = rf(ctx, model, apiView)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, string) error); ok {
+		r1 = rf(ctx, model, apiView)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DdbPatchHandler_TransformOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformOutput'
+type DdbPatchHandler_TransformOutput_Call struct {
+	*mock.Call
+}
+
+// TransformOutput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model interface{}
+//   - apiView string
+func (_e *DdbPatchHandler_Expecter) TransformOutput(ctx interface{}, model interface{}, apiView interface{}) *DdbPatchHandler_TransformOutput_Call {
+	return &DdbPatchHandler_TransformOutput_Call{Call: _e.mock.On("TransformOutput", ctx, model, apiView)}
+}
+
+func (_c *DdbPatchHandler_TransformOutput_Call) Run(run func(ctx context.Context, model interface{}, apiView string)) *DdbPatchHandler_TransformOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *DdbPatchHandler_TransformOutput_Call) Return(output interface{}, err error) *DdbPatchHandler_TransformOutput_Call {
+	_c.Call.Return(output, err)
+	return _c
+}
+
+func (_c *DdbPatchHandler_TransformOutput_Call) RunAndReturn(run func(context.Context, interface{}, string) (interface{}, error)) *DdbPatchHandler_TransformOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransformPatch provides a mock function with given fields: ctx, model
+func (_m *DdbPatchHandler) TransformPatch(ctx context.Context, model interface{}) (interface{}, error) {
+	ret := _m.Called(ctx, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformPatch")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}) (interface{}, error)); ok {
+		return rf(ctx, model)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}) interface{}); ok {
+		r0 = rf(ctx, model)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}) error); ok {
+		r1 = rf(ctx, model)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DdbPatchHandler_TransformPatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformPatch'
+type DdbPatchHandler_TransformPatch_Call struct {
+	*mock.Call
+}
+
+// TransformPatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model interface{}
+func (_e *DdbPatchHandler_Expecter) TransformPatch(ctx interface{}, model interface{}) *DdbPatchHandler_TransformPatch_Call {
+	return &DdbPatchHandler_TransformPatch_Call{Call: _e.mock.On("TransformPatch", ctx, model)}
+}
+
+func (_c *DdbPatchHandler_TransformPatch_Call) Run(run func(ctx context.Context, model interface{})) *DdbPatchHandler_TransformPatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *DdbPatchHandler_TransformPatch_Call) Return(updateInput interface{}, err error) *DdbPatchHandler_TransformPatch_Call {
+	_c.Call.Return(updateInput, err)
+	return _c
+}
+
+func (_c *DdbPatchHandler_TransformPatch_Call) RunAndReturn(run func(context.Context, interface{}) (interface{}, error)) *DdbPatchHandler_TransformPatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransformUpdate provides a mock function with given fields: ctx, input, model
+func (_m *DdbPatchHandler) TransformUpdate(ctx context.Context, input interface{}, model interface{}) error {
+	ret := _m.Called(ctx, input, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, interface{}) error); ok {
+		r0 = rf(ctx, input, model)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DdbPatchHandler_TransformUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformUpdate'
+type DdbPatchHandler_TransformUpdate_Call struct {
+	*mock.Call
+}
+
+// TransformUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input interface{}
+//   - model interface{}
+func (_e *DdbPatchHandler_Expecter) TransformUpdate(ctx interface{}, input interface{}, model interface{}) *DdbPatchHandler_TransformUpdate_Call {
+	return &DdbPatchHandler_TransformUpdate_Call{Call: _e.mock.On("TransformUpdate", ctx, input, model)}
+}
+
+func (_c *DdbPatchHandler_TransformUpdate_Call) Run(run func(ctx context.Context, input interface{}, model interface{})) *DdbPatchHandler_TransformUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *DdbPatchHandler_TransformUpdate_Call) Return(err error) *DdbPatchHandler_TransformUpdate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DdbPatchHandler_TransformUpdate_Call) RunAndReturn(run func(context.Context, interface{}, interface{}) error) *DdbPatchHandler_TransformUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDdbPatchHandler creates a new instance of DdbPatchHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDdbPatchHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DdbPatchHandler {
+	mock := &DdbPatchHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,287 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	ddb "github.com/justtrackio/gosoline/pkg/ddb"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DdbUpdateHandler is an autogenerated mock type for the DdbUpdateHandler type
+type DdbUpdateHandler struct {
+	mock.Mock
+}
+
+type DdbUpdateHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DdbUpdateHandler) EXPECT() *DdbUpdateHandler_Expecter {
+	return &DdbUpdateHandler_Expecter{mock: &_m.Mock}
+}
+
+// GetModel provides a mock function with no fields
+func (_m *DdbUpdateHandler) GetModel() interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetModel")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// DdbUpdateHandler_GetModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetModel'
+type DdbUpdateHandler_GetModel_Call struct {
+	*mock.Call
+}
+
+// GetModel is a helper method to define mock.On call
+func (_e *DdbUpdateHandler_Expecter) GetModel() *DdbUpdateHandler_GetModel_Call {
+	return &DdbUpdateHandler_GetModel_Call{Call: _e.mock.On("GetModel")}
+}
+
+func (_c *DdbUpdateHandler_GetModel_Call) Run(run func()) *DdbUpdateHandler_GetModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbUpdateHandler_GetModel_Call) Return(_a0 interface{}) *DdbUpdateHandler_GetModel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbUpdateHandler_GetModel_Call) RunAndReturn(run func() interface{}) *DdbUpdateHandler_GetModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepository provides a mock function with no fields
+func (_m *DdbUpdateHandler) GetRepository() ddb.Repository {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepository")
+	}
+
+	var r0 ddb.Repository
+	if rf, ok := ret.Get(0).(func() ddb.Repository); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ddb.Repository)
+		}
+	}
+
+	return r0
+}
+
+// DdbUpdateHandler_GetRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepository'
+type DdbUpdateHandler_GetRepository_Call struct {
+	*mock.Call
+}
+
+// GetRepository is a helper method to define mock.On call
+func (_e *DdbUpdateHandler_Expecter) GetRepository() *DdbUpdateHandler_GetRepository_Call {
+	return &DdbUpdateHandler_GetRepository_Call{Call: _e.mock.On("GetRepository")}
+}
+
+func (_c *DdbUpdateHandler_GetRepository_Call) Run(run func()) *DdbUpdateHandler_GetRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbUpdateHandler_GetRepository_Call) Return(_a0 ddb.Repository) *DdbUpdateHandler_GetRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbUpdateHandler_GetRepository_Call) RunAndReturn(run func() ddb.Repository) *DdbUpdateHandler_GetRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUpdateInput provides a mock function with no fields
+func (_m *DdbUpdateHandler) GetUpdateInput() interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUpdateInput")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func() interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// DdbUpdateHandler_GetUpdateInput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUpdateInput'
+type DdbUpdateHandler_GetUpdateInput_Call struct {
+	*mock.Call
+}
+
+// GetUpdateInput is a helper method to define mock.On call
+func (_e *DdbUpdateHandler_Expecter) GetUpdateInput() *DdbUpdateHandler_GetUpdateInput_Call {
+	return &DdbUpdateHandler_GetUpdateInput_Call{Call: _e.mock.On("GetUpdateInput")}
+}
+
+func (_c *DdbUpdateHandler_GetUpdateInput_Call) Run(run func()) *DdbUpdateHandler_GetUpdateInput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DdbUpdateHandler_GetUpdateInput_Call) Return(_a0 interface{}) *DdbUpdateHandler_GetUpdateInput_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DdbUpdateHandler_GetUpdateInput_Call) RunAndReturn(run func() interface{}) *DdbUpdateHandler_GetUpdateInput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransformOutput provides a mock function with given fields: ctx, model, apiView
+func (_m *DdbUpdateHandler) TransformOutput(ctx context.Context, model interface{}, apiView string) (interface{}, error) {
+	ret := _m.Called(ctx, model, apiView)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformOutput")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, string) (interface{}, error)); ok {
+		return rf(ctx, model, apiView)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, string) interface{}); ok {
+		r0 = rf(ctx, model, apiView)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, string) error); ok {
+		r1 = rf(ctx, model, apiView)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DdbUpdateHandler_TransformOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformOutput'
+type DdbUpdateHandler_TransformOutput_Call struct {
+	*mock.Call
+}
+
+// TransformOutput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model interface{}
+//   - apiView string
+func (_e *DdbUpdateHandler_Expecter) TransformOutput(ctx interface{}, model interface{}, apiView interface{}) *DdbUpdateHandler_TransformOutput_Call {
+	return &DdbUpdateHandler_TransformOutput_Call{Call: _e.mock.On("TransformOutput", ctx, model, apiView)}
+}
+
+func (_c *DdbUpdateHandler_TransformOutput_Call) Run(run func(ctx context.Context, model interface{}, apiView string)) *DdbUpdateHandler_TransformOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *DdbUpdateHandler_TransformOutput_Call) Return(output interface{}, err error) *DdbUpdateHandler_TransformOutput_Call {
+	_c.Call.Return(output, err)
+	return _c
+}
+
+func (_c *DdbUpdateHandler_TransformOutput_Call) RunAndReturn(run func(context.Context, interface{}, string) (interface{}, error)) *DdbUpdateHandler_TransformOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransformUpdate provides a mock function with given fields: ctx, input, model
+func (_m *DdbUpdateHandler) TransformUpdate(ctx context.Context, input interface{}, model interface{}) error {
+	ret := _m.Called(ctx, input, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransformUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, interface{}) error); ok {
+		r0 = rf(ctx, input, model)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DdbUpdateHandler_TransformUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransformUpdate'
+type DdbUpdateHandler_TransformUpdate_Call struct {
+	*mock.Call
+}
+
+// TransformUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input interface{}
+//   - model interface{}
+func (_e *DdbUpdateHandler_Expecter) TransformUpdate(ctx interface{}, input interface{}, model interface{}) *DdbUpdateHandler_TransformUpdate_Call {
+	return &DdbUpdateHandler_TransformUpdate_Call{Call: _e.mock.On("TransformUpdate", ctx, input, model)}
+}
+
+func (_c *DdbUpdateHandler_TransformUpdate_Call) Run(run func(ctx context.Context, input interface{}, model interface{})) *DdbUpdateHandler_TransformUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *DdbUpdateHandler_TransformUpdate_Call) Return(err error) *DdbUpdateHandler_TransformUpdate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *DdbUpdateHandler_TransformUpdate_Call) RunAndReturn(run func(context.Context, interface{}, interface{}) error) *DdbUpdateHandler_TransformUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDdbUpdateHandler creates a new instance of DdbUpdateHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDdbUpdateHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DdbUpdateHandler {
+	mock := &DdbUpdateHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,427 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	db_repo "github.com/justtrackio/gosoline/pkg/db-repo"
+
+	gorm "github.com/jinzhu/gorm"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TransactionalRepository is an autogenerated mock type for the TransactionalRepository type
+type TransactionalRepository struct {
+	mock.Mock
+}
+
+type TransactionalRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TransactionalRepository) EXPECT() *TransactionalRepository_Expecter {
+	return &TransactionalRepository_Expecter{mock: &_m.Mock}
+}
+
+// Count provides a mock function with given fields: ctx, qb, model
+func (_m *TransactionalRepository) Count(ctx context.Context, qb *db_repo.QueryBuilder, model db_repo.ModelBased) (int, error) {
+	ret := _m.Called(ctx, qb, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *db_repo.QueryBuilder, db_repo.ModelBased) (int, error)); ok {
+		return rf(ctx, qb, model)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *db_repo.QueryBuilder, db_repo.ModelBased) int); ok {
+		r0 = rf(ctx, qb, model)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *db_repo.QueryBuilder, db_repo.ModelBased) error); ok {
+		r1 = rf(ctx, qb, model)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionalRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type TransactionalRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qb *db_repo.QueryBuilder
+//   - model db_repo.ModelBased
+func (_e *TransactionalRepository_Expecter) Count(ctx interface{}, qb interface{}, model interface{}) *TransactionalRepository_Count_Call {
+	return &TransactionalRepository_Count_Call{Call: _e.mock.On("Count", ctx, qb, model)}
+}
+
+func (_c *TransactionalRepository_Count_Call) Run(run func(ctx context.Context, qb *db_repo.QueryBuilder, model db_repo.ModelBased)) *TransactionalRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*db_repo.QueryBuilder), args[2].(db_repo.ModelBased))
+	})
+	return _c
+}
+
+func (_c *TransactionalRepository_Count_Call) Return(_a0 int, _a1 error) *TransactionalRepository_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionalRepository_Count_Call) RunAndReturn(run func(context.Context, *db_repo.QueryBuilder, db_repo.ModelBased) (int, error)) *TransactionalRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, value
+func (_m *TransactionalRepository) Create(ctx context.Context, value db_repo.ModelBased) error {
+	ret := _m.Called(ctx, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db_repo.ModelBased) error); ok {
+		r0 = rf(ctx, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactionalRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type TransactionalRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - value db_repo.ModelBased
+func (_e *TransactionalRepository_Expecter) Create(ctx interface{}, value interface{}) *TransactionalRepository_Create_Call {
+	return &TransactionalRepository_Create_Call{Call: _e.mock.On("Create", ctx, value)}
+}
+
+func (_c *TransactionalRepository_Create_Call) Run(run func(ctx context.Context, value db_repo.ModelBased)) *TransactionalRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db_repo.ModelBased))
+	})
+	return _c
+}
+
+func (_c *TransactionalRepository_Create_Call) Return(_a0 error) *TransactionalRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionalRepository_Create_Call) RunAndReturn(run func(context.Context, db_repo.ModelBased) error) *TransactionalRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, value
+func (_m *TransactionalRepository) Delete(ctx context.Context, value db_repo.ModelBased) error {
+	ret := _m.Called(ctx, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db_repo.ModelBased) error); ok {
+		r0 = rf(ctx, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactionalRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type TransactionalRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - value db_repo.ModelBased
+func (_e *TransactionalRepository_Expecter) Delete(ctx interface{}, value interface{}) *TransactionalRepository_Delete_Call {
+	return &TransactionalRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, value)}
+}
+
+func (_c *TransactionalRepository_Delete_Call) Run(run func(ctx context.Context, value db_repo.ModelBased)) *TransactionalRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db_repo.ModelBased))
+	})
+	return _c
+}
+
+func (_c *TransactionalRepository_Delete_Call) Return(_a0 error) *TransactionalRepository_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionalRepository_Delete_Call) RunAndReturn(run func(context.Context, db_repo.ModelBased) error) *TransactionalRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetadata provides a mock function with no fields
+func (_m *TransactionalRepository) GetMetadata() db_repo.Metadata {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetadata")
+	}
+
+	var r0 db_repo.Metadata
+	if rf, ok := ret.Get(0).(func() db_repo.Metadata); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(db_repo.Metadata)
+	}
+
+	return r0
+}
+
+// TransactionalRepository_GetMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetadata'
+type TransactionalRepository_GetMetadata_Call struct {
+	*mock.Call
+}
+
+// GetMetadata is a helper method to define mock.On call
+func (_e *TransactionalRepository_Expecter) GetMetadata() *TransactionalRepository_GetMetadata_Call {
+	return &TransactionalRepository_GetMetadata_Call{Call: _e.mock.On("GetMetadata")}
+}
+
+func (_c *TransactionalRepository_GetMetadata_Call) Run(run func()) *TransactionalRepository_GetMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TransactionalRepository_GetMetadata_Call) Return(_a0 db_repo.Metadata) *TransactionalRepository_GetMetadata_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionalRepository_GetMetadata_Call) RunAndReturn(run func() db_repo.Metadata) *TransactionalRepository_GetMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Query provides a mock function with given fields: ctx, qb, result
+func (_m *TransactionalRepository) Query(ctx context.Context, qb *db_repo.QueryBuilder, result interface{}) error {
+	ret := _m.Called(ctx, qb, result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *db_repo.QueryBuilder, interface{}) error); ok {
+		r0 = rf(ctx, qb, result)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactionalRepository_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type TransactionalRepository_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+//   - qb *db_repo.QueryBuilder
+//   - result interface{}
+func (_e *TransactionalRepository_Expecter) Query(ctx interface{}, qb interface{}, result interface{}) *TransactionalRepository_Query_Call {
+	return &TransactionalRepository_Query_Call{Call: _e.mock.On("Query", ctx, qb, result)}
+}
+
+func (_c *TransactionalRepository_Query_Call) Run(run func(ctx context.Context, qb *db_repo.QueryBuilder, result interface{})) *TransactionalRepository_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*db_repo.QueryBuilder), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *TransactionalRepository_Query_Call) Return(_a0 error) *TransactionalRepository_Query_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionalRepository_Query_Call) RunAndReturn(run func(context.Context, *db_repo.QueryBuilder, interface{}) error) *TransactionalRepository_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Read provides a mock function with given fields: ctx, id, out
+func (_m *TransactionalRepository) Read(ctx context.Context, id *uint, out db_repo.ModelBased) error {
+	ret := _m.Called(ctx, id, out)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Read")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *uint, db_repo.ModelBased) error); ok {
+		r0 = rf(ctx, id, out)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactionalRepository_Read_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Read'
+type TransactionalRepository_Read_Call struct {
+	*mock.Call
+}
+
+// Read is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id *uint
+//   - out db_repo.ModelBased
+func (_e *TransactionalRepository_Expecter) Read(ctx interface{}, id interface{}, out interface{}) *TransactionalRepository_Read_Call {
+	return &TransactionalRepository_Read_Call{Call: _e.mock.On("Read", ctx, id, out)}
+}
+
+func (_c *TransactionalRepository_Read_Call) Run(run func(ctx context.Context, id *uint, out db_repo.ModelBased)) *TransactionalRepository_Read_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*uint), args[2].(db_repo.ModelBased))
+	})
+	return _c
+}
+
+func (_c *TransactionalRepository_Read_Call) Return(_a0 error) *TransactionalRepository_Read_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionalRepository_Read_Call) RunAndReturn(run func(context.Context, *uint, db_repo.ModelBased) error) *TransactionalRepository_Read_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transaction provides a mock function with given fields: ctx, do
+func (_m *TransactionalRepository) Transaction(ctx context.Context, do func(context.Context, db_repo.Repository, *gorm.DB) error) error {
+	ret := _m.Called(ctx, do)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context, db_repo.Repository, *gorm.DB) error) error); ok {
+		r0 = rf(ctx, do)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactionalRepository_Transaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transaction'
+type TransactionalRepository_Transaction_Call struct {
+	*mock.Call
+}
+
+// Transaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - do func(context.Context , db_repo.Repository , *gorm.DB) error
+func (_e *TransactionalRepository_Expecter) Transaction(ctx interface{}, do interface{}) *TransactionalRepository_Transaction_Call {
+	return &TransactionalRepository_Transaction_Call{Call: _e.mock.On("Transaction", ctx, do)}
+}
+
+func (_c *TransactionalRepository_Transaction_Call) Run(run func(ctx context.Context, do func(context.Context, db_repo.Repository, *gorm.DB) error)) *TransactionalRepository_Transaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context, db_repo.Repository, *gorm.DB) error))
+	})
+	return _c
+}
+
+func (_c *TransactionalRepository_Transaction_Call) Return(_a0 error) *TransactionalRepository_Transaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionalRepository_Transaction_Call) RunAndReturn(run func(context.Context, func(context.Context, db_repo.Repository, *gorm.DB) error) error) *TransactionalRepository_Transaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, value
+func (_m *TransactionalRepository) Update(ctx context.Context, value db_repo.ModelBased) error {
+	ret := _m.Called(ctx, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, db_repo.ModelBased) error); ok {
+		r0 = rf(ctx, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactionalRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type TransactionalRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - value db_repo.ModelBased
+func (_e *TransactionalRepository_Expecter) Update(ctx interface{}, value interface{}) *TransactionalRepository_Update_Call {
+	return &TransactionalRepository_Update_Call{Call: _e.mock.On("Update", ctx, value)}
+}
+
+func (_c *TransactionalRepository_Update_Call) Run(run func(ctx context.Context, value db_repo.ModelBased)) *TransactionalRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(db_repo.ModelBased))
+	})
+	return _c
+}
+
+func (_c *TransactionalRepository_Update_Call) Return(_a0 error) *TransactionalRepository_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionalRepository_Update_Call) RunAndReturn(run func(context.Context, db_repo.ModelBased) error) *TransactionalRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTransactionalRepository creates a new instance of TransactionalRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransactionalRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TransactionalRepository {
+	mock := &TransactionalRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -24,9 +24,18 @@ type patchHandler struct {
 }
 
 func NewPatchHandler(config cfg.Config, logger log.Logger, transformer PatchHandler) (gin.HandlerFunc, error) {
+	uh, err := newPatchHandler(config, logger, transformer)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpserver.CreateJsonHandler(uh), nil
+}
+
+func newPatchHandler(config cfg.Config, logger log.Logger, transformer PatchHandler) (patchHandler, error) {
 	settings := Settings{}
 	if err := config.UnmarshalKey(SettingsConfigKey, &settings); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal patch handler settings: %w", err)
+		return patchHandler{}, fmt.Errorf("failed to unmarshal patch handler settings: %w", err)
 	}
 	uh := patchHandler{
 		transformer: transformer,
@@ -34,13 +43,17 @@ func NewPatchHandler(config cfg.Config, logger log.Logger, transformer PatchHand
 		settings:    settings,
 	}
 
-	return httpserver.CreateJsonHandler(uh), nil
+	return uh, nil
 }
 
 func (ph patchHandler) GetInput() any {
 	return new(patchInput)
 }
 
+func (ph patchHandler) GetOutput() any {
+	return getOutput(ph.transformer)
+}
+
 func (ph patchHandler) Handle(reqCtx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
 	// replace context with a new one to prevent cancellations from client side
 	// include a new timeout to ensure that requests will be cancelled
@@ -50,9 +63,7 @@ func (ph patchHandler) Handle(reqCtx context.Context, request *httpserver.Reques
 	var ok bool
 	var err error
 	var id *uint
-	var input *patchInput
 	var updateInput any
-	var before, patch, after []byte
 
 	if id, ok = httpserver.GetUintFromRequest(request, "id"); !ok {
 		return HandleErrorOnWrite(ctx, ph.logger, &validation.Error{
@@ -74,34 +85,18 @@ func (ph patchHandler) Handle(reqCtx context.Context, request *httpserver.Reques
 		return HandleErrorOnWrite(ctx, logger, err)
 	}
 
+	if err = checkIfMatch(request.Header, model); err != nil {
+		return HandleErrorOnWrite(ctx, logger, err)
+	}
+
 	// transform model into the update input struct
 	if updateInput, err = ph.transformer.TransformPatch(ctx, model); err != nil {
 		return HandleErrorOnWrite(ctx, logger, fmt.Errorf("failed to transform patch: %w", err))
 	}
 
-	// marshal update input struct into bytes
-	if before, err = json.Marshal(updateInput); err != nil {
-		return HandleErrorOnWrite(ctx, logger, fmt.Errorf("failed to marshal model: %w", err))
-	}
-
-	// read patch as map[string]any
-	if input, ok = request.Body.(*patchInput); !ok {
-		return HandleErrorOnWrite(ctx, logger, fmt.Errorf("invalid request body type: %T", request.Body))
-	}
-
-	// marshal patch into bytes
-	if patch, err = json.Marshal(input); err != nil {
-		return HandleErrorOnWrite(ctx, logger, fmt.Errorf("failed to marshal input: %w", err))
-	}
-
-	// apply patch to update input bytes
-	if after, err = jsonpatch.MergePatch(before, patch); err != nil {
-		return HandleErrorOnWrite(ctx, logger, fmt.Errorf("failed to merge patch: %w", err))
-	}
-
-	// unmarshal patched bytes into update input struct
-	if err = json.Unmarshal(after, updateInput); err != nil {
-		return HandleErrorOnWrite(ctx, logger, fmt.Errorf("failed to unmarshal patched model: %w", err))
+	// apply the patch of the request onto the update input struct
+	if err = applyMergePatch(updateInput, request.Body); err != nil {
+		return HandleErrorOnWrite(ctx, logger, err)
 	}
 
 	// apply update input struct onto model
@@ -111,7 +106,7 @@ func (ph patchHandler) Handle(reqCtx context.Context, request *httpserver.Reques
 
 	// write model back to repository
 	if err = repo.Update(ctx, model); err != nil {
-		return HandleErrorOnWrite(ctx, logger, err)
+		return HandleErrorOnWrite(ctx, logger, checkWriteConflict(request.Header, err))
 	}
 
 	reload := ph.transformer.GetModel()
@@ -125,5 +120,40 @@ func (ph patchHandler) Handle(reqCtx context.Context, request *httpserver.Reques
 		return HandleErrorOnWrite(ctx, logger, err)
 	}
 
-	return httpserver.NewJsonResponse(out), nil
+	return withETag(httpserver.NewJsonResponse(out), reload), nil
+}
+
+// applyMergePatch applies the body of a patch request as json merge patch onto the update input struct.
+func applyMergePatch(updateInput any, body any) error {
+	var err error
+	var ok bool
+	var input *patchInput
+	var before, patch, after []byte
+
+	// marshal update input struct into bytes
+	if before, err = json.Marshal(updateInput); err != nil {
+		return fmt.Errorf("failed to marshal model: %w", err)
+	}
+
+	// read patch as map[string]any
+	if input, ok = body.(*patchInput); !ok {
+		return fmt.Errorf("invalid request body type: %T", body)
+	}
+
+	// marshal patch into bytes
+	if patch, err = json.Marshal(input); err != nil {
+		return fmt.Errorf("failed to marshal input: %w", err)
+	}
+
+	// apply patch to update input bytes
+	if after, err = jsonpatch.MergePatch(before, patch); err != nil {
+		return fmt.Errorf("failed to merge patch: %w", err)
+	}
+
+	// unmarshal patched bytes into update input struct
+	if err = json.Unmarshal(after, updateInput); err != nil {
+		return fmt.Errorf("failed to unmarshal patched model: %w", err)
+	}
+
+	return nil
 }
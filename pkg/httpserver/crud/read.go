@@ -16,13 +16,21 @@ type readHandler struct {
 	transformer BaseHandler
 }
 
-func NewReadHandler(_ cfg.Config, logger log.Logger, transformer BaseHandler) gin.HandlerFunc {
+func NewReadHandler(config cfg.Config, logger log.Logger, transformer BaseHandler) gin.HandlerFunc {
+	return httpserver.CreateHandler(newReadHandler(config, logger, transformer))
+}
+
+func newReadHandler(_ cfg.Config, logger log.Logger, transformer BaseHandler) readHandler {
 	rh := readHandler{
 		transformer: transformer,
 		logger:      logger,
 	}
 
-	return httpserver.CreateHandler(rh)
+	return rh
+}
+
+func (rh readHandler) GetOutput() any {
+	return getOutput(rh.transformer)
 }
 
 func (rh readHandler) Handle(ctx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
@@ -53,5 +61,5 @@ func (rh readHandler) Handle(ctx context.Context, request *httpserver.Request) (
 		return HandleErrorOnRead(ctx, logger, err)
 	}
 
-	return httpserver.NewJsonResponse(out), nil
+	return withETag(httpserver.NewJsonResponse(out), model), nil
 }
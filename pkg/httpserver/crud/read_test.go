@@ -39,3 +39,27 @@ func TestReadHandler_Handle(t *testing.T) {
 
 	transformer.Repo.AssertExpectations(t)
 }
+
+func TestReadHandler_HandleVersioned(t *testing.T) {
+	model := &VersionedModel{}
+
+	config := configMocks.NewConfig(t)
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	transformer := newVersionedHandler(t)
+	transformer.Repo.EXPECT().Read(matcher.Context, mdl.Box(uint(1)), model).Run(func(_ context.Context, _ *uint, out db_repo.ModelBased) {
+		model := out.(*VersionedModel)
+		model.Id = mdl.Box(uint(1))
+		model.Name = mdl.Box("foobar")
+		model.UpdatedAt = &time.Time{}
+		model.CreatedAt = &time.Time{}
+		model.Version = 3
+	}).Return(nil)
+
+	handler := crud.NewReadHandler(config, logger, transformer)
+
+	response := httpserver.HttpTest("GET", "/:id", "/1", "", handler)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.JSONEq(t, `{"id":1,"updatedAt":"0001-01-01T00:00:00Z","createdAt":"0001-01-01T00:00:00Z","name":"foobar"}`, response.Body.String())
+	assert.Equal(t, `"v3"`, response.Header().Get(crud.HeaderETag))
+}
@@ -20,9 +20,18 @@ type updateHandler struct {
 }
 
 func NewUpdateHandler(config cfg.Config, logger log.Logger, transformer UpdateHandler) (gin.HandlerFunc, error) {
+	uh, err := newUpdateHandler(config, logger, transformer)
+	if err != nil {
+		return nil, err
+	}
+
+	return httpserver.CreateJsonHandler(uh), nil
+}
+
+func newUpdateHandler(config cfg.Config, logger log.Logger, transformer UpdateHandler) (updateHandler, error) {
 	settings := Settings{}
 	if err := config.UnmarshalKey(SettingsConfigKey, &settings); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal update handler settings: %w", err)
+		return updateHandler{}, fmt.Errorf("failed to unmarshal update handler settings: %w", err)
 	}
 	uh := updateHandler{
 		transformer: transformer,
@@ -30,13 +39,17 @@ func NewUpdateHandler(config cfg.Config, logger log.Logger, transformer UpdateHa
 		settings:    settings,
 	}
 
-	return httpserver.CreateJsonHandler(uh), nil
+	return uh, nil
 }
 
 func (uh updateHandler) GetInput() any {
 	return uh.transformer.GetUpdateInput()
 }
 
+func (uh updateHandler) GetOutput() any {
+	return getOutput(uh.transformer)
+}
+
 func (uh updateHandler) Handle(reqCtx context.Context, request *httpserver.Request) (*httpserver.Response, error) {
 	// replace context with a new one to prevent cancellations from client side
 	// include a new timeout to ensure that requests will be cancelled
@@ -65,6 +78,11 @@ func (uh updateHandler) Handle(reqCtx context.Context, request *httpserver.Reque
 		return HandleErrorOnWrite(ctx, logger, err)
 	}
 
+	err = checkIfMatch(request.Header, model)
+	if err != nil {
+		return HandleErrorOnWrite(ctx, logger, err)
+	}
+
 	err = uh.transformer.TransformUpdate(ctx, request.Body, model)
 	if err != nil {
 		return HandleErrorOnWrite(ctx, logger, err)
@@ -72,7 +90,7 @@ func (uh updateHandler) Handle(reqCtx context.Context, request *httpserver.Reque
 
 	err = repo.Update(ctx, model)
 	if err != nil {
-		return HandleErrorOnWrite(ctx, logger, err)
+		return HandleErrorOnWrite(ctx, logger, checkWriteConflict(request.Header, err))
 	}
 
 	reload := uh.transformer.GetModel()
@@ -87,5 +105,5 @@ func (uh updateHandler) Handle(reqCtx context.Context, request *httpserver.Reque
 		return HandleErrorOnWrite(ctx, logger, err)
 	}
 
-	return httpserver.NewJsonResponse(out), nil
+	return withETag(httpserver.NewJsonResponse(out), reload), nil
 }
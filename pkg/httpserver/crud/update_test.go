@@ -24,8 +24,10 @@ import (
 type updateTestSuite struct {
 	suite.Suite
 
-	handler       handler
-	updateHandler gin.HandlerFunc
+	handler                handler
+	updateHandler          gin.HandlerFunc
+	versionedHandler       versionedHandler
+	versionedUpdateHandler gin.HandlerFunc
 }
 
 func Test_RunUpdateTestSuite(t *testing.T) {
@@ -46,6 +48,10 @@ func (s *updateTestSuite) SetupTest() {
 	s.handler = newHandler(s.T())
 	s.updateHandler, err = crud.NewUpdateHandler(config, logger, s.handler)
 	s.NoError(err)
+
+	s.versionedHandler = newVersionedHandler(s.T())
+	s.versionedUpdateHandler, err = crud.NewUpdateHandler(config, logger, s.versionedHandler)
+	s.NoError(err)
 }
 
 func (s *updateTestSuite) TestUpdate() {
@@ -78,6 +84,64 @@ func (s *updateTestSuite) TestUpdate() {
 	s.JSONEq(`{"id":1,"updatedAt":"0001-01-01T00:00:00Z","createdAt":"0001-01-01T00:00:00Z","name":"updated"}`, response.Body.String())
 }
 
+func (s *updateTestSuite) TestUpdate_Versioned() {
+	readModel := &VersionedModel{}
+	updateModel := &VersionedModel{
+		Model: Model{
+			Model: db_repo.Model{
+				Id: mdl.Box(uint(1)),
+				Timestamps: db_repo.Timestamps{
+					UpdatedAt: &time.Time{},
+					CreatedAt: &time.Time{},
+				},
+			},
+			Name: mdl.Box("updated"),
+		},
+		Versioning: db_repo.Versioning{
+			Version: 4,
+		},
+	}
+
+	s.versionedHandler.Repo.EXPECT().Update(matcher.Context, updateModel).Return(nil)
+	s.versionedHandler.Repo.EXPECT().Read(matcher.Context, mdl.Box(uint(1)), readModel).
+		Run(func(ctx context.Context, id *uint, out db_repo.ModelBased) {
+			model := out.(*VersionedModel)
+			model.Id = mdl.Box(uint(1))
+			model.Name = mdl.Box("updated")
+			model.UpdatedAt = &time.Time{}
+			model.CreatedAt = &time.Time{}
+			model.Version = 4
+		}).Return(nil)
+
+	body := `{"name": "updated"}`
+	response := httpserver.HttpTest("PUT", "/:id", "/1", body, s.versionedUpdateHandler, func(r *http.Request) {
+		r.Header.Set(crud.HeaderIfMatch, `"v4"`)
+	})
+
+	s.Equal(http.StatusOK, response.Code)
+	s.JSONEq(`{"id":1,"updatedAt":"0001-01-01T00:00:00Z","createdAt":"0001-01-01T00:00:00Z","name":"updated"}`, response.Body.String())
+	s.Equal(`"v4"`, response.Header().Get(crud.HeaderETag))
+}
+
+func (s *updateTestSuite) TestUpdate_IfMatchUpdatedAt() {
+	updatedAt := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	s.handler.Repo.EXPECT().Update(matcher.Context, mock.AnythingOfType("*crud_test.Model")).Return(nil)
+	s.handler.Repo.EXPECT().Read(matcher.Context, mdl.Box(uint(1)), &Model{}).Run(func(_ context.Context, _ *uint, out db_repo.ModelBased) {
+		model := out.(*Model)
+		model.Id = mdl.Box(uint(1))
+		model.UpdatedAt = mdl.Box(updatedAt)
+	}).Return(nil)
+
+	body := `{"name": "updated"}`
+	response := httpserver.HttpTest("PUT", "/:id", "/1", body, s.updateHandler, func(r *http.Request) {
+		r.Header.Set(crud.HeaderIfMatch, fmt.Sprintf(`"%d"`, updatedAt.UnixNano()))
+	})
+
+	s.Equal(http.StatusOK, response.Code)
+	s.Equal(fmt.Sprintf(`W/"%d"`, updatedAt.UnixNano()), response.Header().Get(crud.HeaderETag))
+}
+
 func (s *updateTestSuite) TestUpdate_ValidationError() {
 	readModel := &Model{}
 	updateModel := &Model{
@@ -108,3 +172,48 @@ func (s *updateTestSuite) TestUpdate_ValidationError() {
 	s.Equal(http.StatusBadRequest, response.Code)
 	s.JSONEq(`{"err":"validation: invalid foobar"}`, response.Body.String())
 }
+
+func (s *updateTestSuite) TestUpdate_IfMatchFailed() {
+	s.handler.Repo.EXPECT().Read(matcher.Context, mdl.Box(uint(1)), &Model{}).Run(func(_ context.Context, _ *uint, out db_repo.ModelBased) {
+		model := out.(*Model)
+		model.Id = mdl.Box(uint(1))
+		model.UpdatedAt = &time.Time{}
+	}).Return(nil)
+
+	body := `{"name": "updated"}`
+	response := httpserver.HttpTest("PUT", "/:id", "/1", body, s.updateHandler, func(r *http.Request) {
+		r.Header.Set(crud.HeaderIfMatch, `"v1", "v2"`)
+	})
+
+	s.Equal(http.StatusPreconditionFailed, response.Code)
+}
+
+func (s *updateTestSuite) TestUpdate_VersionConflict() {
+	for name, test := range map[string]struct {
+		ifMatch  string
+		expected int
+	}{
+		"conditional":   {ifMatch: `"v0"`, expected: http.StatusPreconditionFailed},
+		"unconditional": {expected: http.StatusConflict},
+	} {
+		s.Run(name, func() {
+			s.SetupTest()
+
+			s.versionedHandler.Repo.EXPECT().Read(matcher.Context, mdl.Box(uint(1)), &VersionedModel{}).Run(func(_ context.Context, _ *uint, out db_repo.ModelBased) {
+				model := out.(*VersionedModel)
+				model.Id = mdl.Box(uint(1))
+				model.UpdatedAt = &time.Time{}
+			}).Return(nil)
+			s.versionedHandler.Repo.EXPECT().Update(matcher.Context, mock.AnythingOfType("*crud_test.VersionedModel")).Return(db_repo.NewVersionConflictError(1, 3, "model"))
+
+			body := `{"name": "updated"}`
+			response := httpserver.HttpTest("PUT", "/:id", "/1", body, s.versionedUpdateHandler, func(r *http.Request) {
+				if test.ifMatch != "" {
+					r.Header.Set(crud.HeaderIfMatch, test.ifMatch)
+				}
+			})
+
+			s.Equal(test.expected, response.Code)
+		})
+	}
+}
@@ -18,6 +18,15 @@ type Definition struct {
 	httpMethod   string
 	relativePath string
 	handlers     []gin.HandlerFunc
+	description  *RouteDescription
+}
+
+// Describe documents the input and the output of the route in the openapi document. It overrides the description of
+// the handler func created by one of the Create*Handler functions.
+func (d *Definition) Describe(description RouteDescription) *Definition {
+	d.description = &description
+
+	return d
 }
 
 func (d *Definition) getAbsolutePath() string {
@@ -32,7 +41,7 @@ func (d *Definition) getAbsolutePath() string {
 type Definitions struct {
 	basePath   string
 	middleware []gin.HandlerFunc
-	routes     []Definition
+	routes     []*Definition
 
 	children []*Definitions
 	parent   *Definitions
@@ -66,39 +75,49 @@ func (d *Definitions) Use(middleware ...gin.HandlerFunc) {
 	d.middleware = append(d.middleware, middleware...)
 }
 
-func (d *Definitions) Handle(httpMethod, relativePath string, handlers ...gin.HandlerFunc) {
+func (d *Definitions) Handle(httpMethod, relativePath string, handlers ...gin.HandlerFunc) *Definition {
 	relativePath = trimRightPath(relativePath)
 
-	d.routes = append(d.routes, Definition{
+	definition := &Definition{
 		group:        d,
 		httpMethod:   httpMethod,
 		relativePath: relativePath,
 		handlers:     handlers,
-	})
+	}
+
+	for _, handler := range handlers {
+		if description := describeHandlerFunc(handler); description != nil {
+			definition.description = description
+		}
+	}
+
+	d.routes = append(d.routes, definition)
+
+	return definition
 }
 
-func (d *Definitions) PATCH(relativePath string, handlers ...gin.HandlerFunc) {
-	d.Handle(http.PatchRequest, relativePath, handlers...)
+func (d *Definitions) PATCH(relativePath string, handlers ...gin.HandlerFunc) *Definition {
+	return d.Handle(http.PatchRequest, relativePath, handlers...)
 }
 
-func (d *Definitions) POST(relativePath string, handlers ...gin.HandlerFunc) {
-	d.Handle(http.PostRequest, relativePath, handlers...)
+func (d *Definitions) POST(relativePath string, handlers ...gin.HandlerFunc) *Definition {
+	return d.Handle(http.PostRequest, relativePath, handlers...)
 }
 
-func (d *Definitions) GET(relativePath string, handlers ...gin.HandlerFunc) {
-	d.Handle(http.GetRequest, relativePath, handlers...)
+func (d *Definitions) GET(relativePath string, handlers ...gin.HandlerFunc) *Definition {
+	return d.Handle(http.GetRequest, relativePath, handlers...)
 }
 
-func (d *Definitions) DELETE(relativePath string, handlers ...gin.HandlerFunc) {
-	d.Handle(http.DeleteRequest, relativePath, handlers...)
+func (d *Definitions) DELETE(relativePath string, handlers ...gin.HandlerFunc) *Definition {
+	return d.Handle(http.DeleteRequest, relativePath, handlers...)
 }
 
-func (d *Definitions) PUT(relativePath string, handlers ...gin.HandlerFunc) {
-	d.Handle(http.PutRequest, relativePath, handlers...)
+func (d *Definitions) PUT(relativePath string, handlers ...gin.HandlerFunc) *Definition {
+	return d.Handle(http.PutRequest, relativePath, handlers...)
 }
 
-func (d *Definitions) OPTIONS(relativePath string, handlers ...gin.HandlerFunc) {
-	d.Handle(http.OptionsRequest, relativePath, handlers...)
+func (d *Definitions) OPTIONS(relativePath string, handlers ...gin.HandlerFunc) *Definition {
+	return d.Handle(http.OptionsRequest, relativePath, handlers...)
 }
 
 func buildRouter(definitions *Definitions, router gin.IRouter) ([]Definition, error) {
@@ -122,9 +141,9 @@ func buildRouter(definitions *Definitions, router gin.IRouter) ([]Definition, er
 		handlers = append(handlers, d.handlers...)
 
 		grp.Handle(d.httpMethod, d.relativePath, handlers...)
-	}
 
-	definitionList = append(definitionList, definitions.routes...)
+		definitionList = append(definitionList, *d)
+	}
 
 	var err error
 	var childDefinitions []Definition
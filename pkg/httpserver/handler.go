@@ -65,7 +65,7 @@ type HandlerWithStream interface {
 
 // CreateHandler creates a gin.HandlerFunc that handles the request without data binding and without passing the body to the handler
 func CreateHandler(handler HandlerWithoutInput) gin.HandlerFunc {
-	return handleWithoutInput(handler, defaultErrorHandler)
+	return describeHandler(handleWithoutInput(handler, defaultErrorHandler), handler)
 }
 
 // CreateJsonHandler creates a gin.HandlerFunc that handles the request with json binding
@@ -75,7 +75,7 @@ func CreateHandler(handler HandlerWithoutInput) gin.HandlerFunc {
 //	  A string `json:"a" binding:"required"`
 //	}
 func CreateJsonHandler(handler HandlerWithInput) gin.HandlerFunc {
-	return handleWithBindingInput(handler, binding.JSON, defaultErrorHandler)
+	return describeHandler(handleWithBindingInput(handler, binding.JSON, defaultErrorHandler), handler, binding.JSON.Name())
 }
 
 // CreateProtobufHandler creates a gin.HandlerFunc that handles the request with protobuf binding
@@ -85,7 +85,7 @@ func CreateJsonHandler(handler HandlerWithInput) gin.HandlerFunc {
 //	  A string `json:"a" binding:"required"`
 //	}
 func CreateProtobufHandler(handler HandlerWithInput) gin.HandlerFunc {
-	return handleWithBindingInput(handler, protobufBinding, defaultErrorHandler)
+	return describeHandler(handleWithBindingInput(handler, protobufBinding, defaultErrorHandler), handler, protobufBinding.Name())
 }
 
 // CreateMultiPartFormHandler creates a gin.HandlerFunc that handles the request with Form multipart data binding
@@ -95,7 +95,7 @@ func CreateProtobufHandler(handler HandlerWithInput) gin.HandlerFunc {
 //	  File *multipart.FileHeader `form:"file" binding:"required"`
 //	}
 func CreateMultiPartFormHandler(handler HandlerWithInput) gin.HandlerFunc {
-	return handleWithMultiPartFormInput(handler, defaultErrorHandler)
+	return describeHandler(handleWithMultiPartFormInput(handler, defaultErrorHandler), handler, binding.FormMultipart.Name())
 }
 
 // CreateMultipleBindingsHandler creates a gin.HandlerFunc that handles the request with the bindings the input struct specifies
@@ -106,17 +106,22 @@ func CreateMultiPartFormHandler(handler HandlerWithInput) gin.HandlerFunc {
 //	  B string `form:"b" binding:"required"`
 //	}
 func CreateMultipleBindingsHandler(handler HandlerWithMultipleBindings) gin.HandlerFunc {
-	return handleWithMultipleBindings(handler, defaultErrorHandler)
+	bindings := make([]string, 0)
+	for _, b := range handler.GetBindings() {
+		bindings = append(bindings, b.Name())
+	}
+
+	return describeHandler(handleWithMultipleBindings(handler, defaultErrorHandler), handler, bindings...)
 }
 
 // CreateRawHandler creates a gin.HandlerFunc that handles the request without input binding and passes the body to the handler as a string
 func CreateRawHandler(handler HandlerWithoutInput) gin.HandlerFunc {
-	return handleRaw(handler, defaultErrorHandler)
+	return describeHandler(handleRaw(handler, defaultErrorHandler), handler, OpenApiBindingRaw)
 }
 
 // CreateReaderHandler creates a gin.HandlerFunc that handles the request without input binding and passes the body to the handler as io.ReadCloser
 func CreateReaderHandler(handler HandlerWithoutInput) gin.HandlerFunc {
-	return handleReader(handler, defaultErrorHandler)
+	return describeHandler(handleReader(handler, defaultErrorHandler), handler, OpenApiBindingReader)
 }
 
 // CreateQueryHandler creates a gin.HandlerFunc that handles the request and uses the query parameters as input binding
@@ -126,7 +131,7 @@ func CreateReaderHandler(handler HandlerWithoutInput) gin.HandlerFunc {
 //	  A string `form:"a" binding:"required"`
 //	}
 func CreateQueryHandler(handler HandlerWithInput) gin.HandlerFunc {
-	return handleWithBindingInput(handler, binding.Query, defaultErrorHandler)
+	return describeHandler(handleWithBindingInput(handler, binding.Query, defaultErrorHandler), handler, binding.Query.Name())
 }
 
 // CreateUriHandler creates a gin.HandlerFunc that handles the request and uses the uri parameters as input binding
@@ -136,7 +141,7 @@ func CreateQueryHandler(handler HandlerWithInput) gin.HandlerFunc {
 //	  A string `uri:"a" binding:"required"`
 //	}
 func CreateUriHandler(handler HandlerWithInput) gin.HandlerFunc {
-	return handleWithBindingUriInput(handler, defaultErrorHandler)
+	return describeHandler(handleWithBindingUriInput(handler, defaultErrorHandler), handler, binding.Uri.Name())
 }
 
 // CreateSseHandler creates a gin.HandlerFunc that handles the stream request and uses the query parameters as input binding
@@ -146,7 +151,7 @@ func CreateUriHandler(handler HandlerWithInput) gin.HandlerFunc {
 //	  A string `query:"a" binding:"required"`
 //	}
 func CreateSseHandler(handler HandlerWithStream) gin.HandlerFunc {
-	return handleWithStream(handler, binding.Query, defaultErrorHandler)
+	return describeHandler(handleWithStream(handler, binding.Query, defaultErrorHandler), handler, binding.Query.Name())
 }
 
 // CreateStreamHandler creates a gin.HandlerFunc that handles the stream request and uses the json body as input binding
@@ -156,7 +161,7 @@ func CreateSseHandler(handler HandlerWithStream) gin.HandlerFunc {
 //	  A string `json:"a" binding:"required"`
 //	}
 func CreateStreamHandler(handler HandlerWithStream) gin.HandlerFunc {
-	return handleWithStream(handler, binding.JSON, defaultErrorHandler)
+	return describeHandler(handleWithStream(handler, binding.JSON, defaultErrorHandler), handler, binding.JSON.Name())
 }
 
 // CreateDownloadHandler creates a gin.HandlerFunc that handles the stream request and uses the query parameters as input binding
@@ -166,7 +171,7 @@ func CreateStreamHandler(handler HandlerWithStream) gin.HandlerFunc {
 //	  A string `query:"a" binding:"required"`
 //	}
 func CreateDownloadHandler(handler HandlerWithStream) gin.HandlerFunc {
-	return handleWithStream(handler, binding.Query, defaultErrorHandler)
+	return describeHandler(handleWithStream(handler, binding.Query, defaultErrorHandler), handler, binding.Query.Name())
 }
 
 func handleWithBindingInput(handler HandlerWithInput, binding binding.Binding, errHandler ErrorHandler) gin.HandlerFunc {
@@ -43,10 +43,7 @@ func writeRetryAfterHeader(c *gin.Context, retryAfter time.Duration) {
 		return
 	}
 
-	seconds := int64(retryAfter / time.Second)
-	if retryAfter%time.Second != 0 {
-		seconds++
-	}
+	seconds := ceilSeconds(retryAfter)
 	if seconds <= 0 {
 		seconds = 1
 	}
@@ -54,6 +51,15 @@ func writeRetryAfterHeader(c *gin.Context, retryAfter time.Duration) {
 	c.Header("Retry-After", strconv.FormatInt(seconds, 10))
 }
 
+func ceilSeconds(d time.Duration) int64 {
+	seconds := int64(d / time.Second)
+	if d%time.Second > 0 {
+		seconds++
+	}
+
+	return seconds
+}
+
 type rejectedRequestKey struct{}
 
 func markRequestRejected(request *http.Request) *http.Request {
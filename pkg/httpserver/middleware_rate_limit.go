@@ -0,0 +1,110 @@
+package httpserver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/justtrackio/gosoline/pkg/httpserver/auth"
+	"github.com/justtrackio/gosoline/pkg/limit"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+)
+
+// RateLimitKeyFunc returns the key a request is limited by. Requests with an empty key are not limited.
+type RateLimitKeyFunc func(ginCtx *gin.Context) string
+
+// RateLimitMiddleware limits incoming requests per key with the given limiter. Instead of waiting for the limit,
+// requests exceeding it are rejected with 429 Too Many Requests and a Retry-After header. The quota is reported with
+// the RateLimit-* headers. Requests are let through if the limiter fails, e.g. because its backend is not available.
+// Throttled requests are reported by the middleware of the limiter, like limit.NewMetricMiddleware. The limiters of
+// the limit package implementing limit.TryLimiter have to be asserted to it.
+func RateLimitMiddleware(logger log.Logger, limiter limit.TryLimiter, keyFunc RateLimitKeyFunc) gin.HandlerFunc {
+	logger = logger.WithChannel("rate_limit")
+
+	return func(ginCtx *gin.Context) {
+		key := keyFunc(ginCtx)
+		if key == "" {
+			ginCtx.Next()
+
+			return
+		}
+
+		ctx := ginCtx.Request.Context()
+
+		quota, err := limiter.TryTake(ctx, key)
+		if err != nil {
+			logger.Warn(ctx, "can not check the rate limit of %s, letting the request pass: %s", key, err.Error())
+			ginCtx.Next()
+
+			return
+		}
+
+		ginCtx.Header(HeaderRateLimitLimit, strconv.Itoa(quota.Limit))
+		ginCtx.Header(HeaderRateLimitRemaining, strconv.Itoa(quota.Remaining))
+		ginCtx.Header(HeaderRateLimitReset, strconv.FormatInt(ceilSeconds(quota.Reset), 10))
+
+		if !quota.Allowed {
+			writeRetryAfterHeader(ginCtx, quota.RetryAfter)
+			ginCtx.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"error": "rate limit exceeded",
+			})
+
+			return
+		}
+
+		ginCtx.Next()
+	}
+}
+
+// RateLimitByClientIp limits requests by the ip of the client, see gin.Context.ClientIP for the trusted proxies.
+func RateLimitByClientIp() RateLimitKeyFunc {
+	return func(ginCtx *gin.Context) string {
+		return fmt.Sprintf("ip:%s", ginCtx.ClientIP())
+	}
+}
+
+// RateLimitBySubject limits requests by the authenticated auth.Subject. Requests of anonymous subjects or without a
+// subject are limited by the ip of the client. The middleware has to run after the authentication.
+func RateLimitBySubject() RateLimitKeyFunc {
+	byClientIp := RateLimitByClientIp()
+
+	return func(ginCtx *gin.Context) string {
+		subject, ok := auth.LookupSubject(ginCtx.Request.Context())
+		if !ok || subject.Anonymous {
+			return byClientIp(ginCtx)
+		}
+
+		return fmt.Sprintf("subject:%s:%s", subject.AuthenticatedBy, subject.Name)
+	}
+}
+
+// RateLimitByApiKey limits requests by the api key given by the provider. The key is hashed, so it doesn't end up in
+// the backend or metrics of the limiter. Requests without an api key are not limited.
+func RateLimitByApiKey(provider auth.ApiKeyProvider) RateLimitKeyFunc {
+	return func(ginCtx *gin.Context) string {
+		apiKey := provider(ginCtx)
+		if apiKey == "" {
+			return ""
+		}
+
+		hash := sha256.Sum256([]byte(apiKey))
+
+		return fmt.Sprintf("api_key:%s", hex.EncodeToString(hash[:8]))
+	}
+}
+
+// RateLimitByRoute limits the requests of all clients by the matched route.
+func RateLimitByRoute() RateLimitKeyFunc {
+	return func(ginCtx *gin.Context) string {
+		return fmt.Sprintf("route:%s:%s", ginCtx.Request.Method, ginCtx.FullPath())
+	}
+}
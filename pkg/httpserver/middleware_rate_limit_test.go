@@ -0,0 +1,155 @@
+package httpserver_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/justtrackio/gosoline/pkg/httpserver/auth"
+	"github.com/justtrackio/gosoline/pkg/limit"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type rateLimitTestLimiter struct {
+	keys  []string
+	quota *limit.Quota
+	err   error
+}
+
+func (l *rateLimitTestLimiter) Wait(_ context.Context, _ string) error {
+	return nil
+}
+
+func (l *rateLimitTestLimiter) TryTake(_ context.Context, prefix string) (*limit.Quota, error) {
+	l.keys = append(l.keys, prefix)
+
+	return l.quota, l.err
+}
+
+func newRateLimitRouter(t *testing.T, limiter limit.TryLimiter, keyFunc httpserver.RateLimitKeyFunc, middlewares ...gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+
+	router := gin.New()
+	router.Use(middlewares...)
+	router.Use(httpserver.RateLimitMiddleware(logger, limiter, keyFunc))
+	router.GET("/todo/:id", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	return router
+}
+
+func serveRateLimitRequest(t *testing.T, router *gin.Engine, header http.Header) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, "/todo/1", http.NoBody)
+	require.NoError(t, err)
+
+	req.RemoteAddr = "10.0.0.1:1234"
+	for k, values := range header {
+		for _, v := range values {
+			req.Header.Add(k, v)
+		}
+	}
+
+	router.ServeHTTP(recorder, req)
+
+	return recorder
+}
+
+func TestRateLimitMiddleware_Allowed(t *testing.T) {
+	limiter := &rateLimitTestLimiter{
+		quota: &limit.Quota{Allowed: true, Limit: 10, Remaining: 9, Reset: 1500 * time.Millisecond},
+	}
+	router := newRateLimitRouter(t, limiter, httpserver.RateLimitByClientIp())
+
+	recorder := serveRateLimitRequest(t, router, nil)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Equal(t, "10", recorder.Header().Get(httpserver.HeaderRateLimitLimit))
+	assert.Equal(t, "9", recorder.Header().Get(httpserver.HeaderRateLimitRemaining))
+	assert.Equal(t, "2", recorder.Header().Get(httpserver.HeaderRateLimitReset))
+	assert.Empty(t, recorder.Header().Get("Retry-After"))
+	assert.Equal(t, []string{"ip:10.0.0.1"}, limiter.keys)
+}
+
+func TestRateLimitMiddleware_Throttled(t *testing.T) {
+	limiter := &rateLimitTestLimiter{
+		quota: &limit.Quota{Allowed: false, Limit: 10, Remaining: 0, Reset: 30 * time.Second, RetryAfter: 30 * time.Second},
+	}
+	router := newRateLimitRouter(t, limiter, httpserver.RateLimitByRoute())
+
+	recorder := serveRateLimitRequest(t, router, nil)
+
+	assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	assert.JSONEq(t, `{"error":"rate limit exceeded"}`, recorder.Body.String())
+	assert.Equal(t, "0", recorder.Header().Get(httpserver.HeaderRateLimitRemaining))
+	assert.Equal(t, "30", recorder.Header().Get(httpserver.HeaderRateLimitReset))
+	assert.Equal(t, "30", recorder.Header().Get("Retry-After"))
+	assert.Equal(t, []string{"route:GET:/todo/:id"}, limiter.keys)
+}
+
+func TestRateLimitMiddleware_LimiterError(t *testing.T) {
+	limiter := &rateLimitTestLimiter{
+		err: fmt.Errorf("redis is down"),
+	}
+	router := newRateLimitRouter(t, limiter, httpserver.RateLimitByClientIp())
+
+	recorder := serveRateLimitRequest(t, router, nil)
+
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+	assert.Empty(t, recorder.Header().Get(httpserver.HeaderRateLimitLimit))
+}
+
+func TestRateLimitMiddleware_ByApiKey(t *testing.T) {
+	limiter := &rateLimitTestLimiter{
+		quota: &limit.Quota{Allowed: true, Limit: 10, Remaining: 9},
+	}
+	router := newRateLimitRouter(t, limiter, httpserver.RateLimitByApiKey(auth.ProvideValueFromHeader(auth.HeaderApiKey)))
+
+	serveRateLimitRequest(t, router, nil)
+	serveRateLimitRequest(t, router, http.Header{auth.HeaderApiKey: []string{"secret"}})
+
+	assert.Equal(t, []string{"api_key:2bb80d537b1da3e3"}, limiter.keys)
+}
+
+func TestRateLimitMiddleware_BySubject(t *testing.T) {
+	limiter := &rateLimitTestLimiter{
+		quota: &limit.Quota{Allowed: true, Limit: 10, Remaining: 9},
+	}
+	authenticate := func(ginCtx *gin.Context) {
+		if ginCtx.GetHeader("X-User") != "" {
+			auth.RequestWithSubject(ginCtx, &auth.Subject{Name: ginCtx.GetHeader("X-User"), AuthenticatedBy: auth.ByBasicAuth})
+		}
+	}
+	router := newRateLimitRouter(t, limiter, httpserver.RateLimitBySubject(), authenticate)
+
+	serveRateLimitRequest(t, router, http.Header{"X-User": []string{"alice"}})
+	serveRateLimitRequest(t, router, nil)
+
+	assert.Equal(t, []string{"subject:basicAuth:alice", "ip:10.0.0.1"}, limiter.keys)
+}
+
+func TestRateLimitMiddleware_LeakyBucket(t *testing.T) {
+	limiter, err := limit.NewLeakyBucketLimiter("test", 2)
+	require.NoError(t, err)
+
+	tryLimiter, ok := limiter.(limit.TryLimiter)
+	require.True(t, ok)
+
+	router := newRateLimitRouter(t, tryLimiter, httpserver.RateLimitByClientIp())
+
+	assert.Equal(t, http.StatusNoContent, serveRateLimitRequest(t, router, nil).Code)
+	assert.Equal(t, http.StatusNoContent, serveRateLimitRequest(t, router, nil).Code)
+
+	recorder := serveRateLimitRequest(t, router, nil)
+	assert.Equal(t, http.StatusTooManyRequests, recorder.Code)
+	assert.Equal(t, "1", recorder.Header().Get("Retry-After"))
+}
@@ -0,0 +1,455 @@
+package httpserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const (
+	openApiVersion = "3.0.3"
+
+	openApiInPath   = "path"
+	openApiInQuery  = "query"
+	openApiInHeader = "header"
+
+	// OpenApiBindingRaw documents the body of a handler created by CreateRawHandler.
+	OpenApiBindingRaw = "raw"
+	// OpenApiBindingReader documents the body of a handler created by CreateReaderHandler.
+	OpenApiBindingReader = "reader"
+)
+
+const routeDescriptionKey = "gosoline.httpserver.routeDescription"
+
+var (
+	openApiPathParam = regexp.MustCompile(`/[:*]([^/]+)`)
+	// describedHandlerFunc is the code of the handler funcs returned by describeHandler. Only these handler funcs are
+	// asked for their description, as other handler funcs would handle the empty context as a request.
+	describedHandlerFunc = reflect.ValueOf(describeHandler(nil, nil)).Pointer()
+)
+
+// OpenApiSettings configure the openapi document describing the routes of the server.
+type OpenApiSettings struct {
+	// Enabled serves the document at Path.
+	Enabled bool   `cfg:"enabled" default:"false"`
+	Path    string `cfg:"path"    default:"/openapi.json"`
+	// Title of the api, it defaults to the name of the application.
+	Title   string `cfg:"title"`
+	Version string `cfg:"version" default:"1.0.0"`
+}
+
+// HandlerWithOutput can be implemented by handlers to document the body of successful responses in the openapi
+// document. GetOutput returns a value of the type of the response body.
+type HandlerWithOutput interface {
+	GetOutput() any
+}
+
+type OpenApiDocument struct {
+	OpenApi    string                     `json:"openapi"`
+	Info       OpenApiInfo                `json:"info"`
+	Paths      map[string]OpenApiPathItem `json:"paths"`
+	Components OpenApiComponents          `json:"components"`
+}
+
+type OpenApiInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type OpenApiComponents struct {
+	Schemas map[string]*OpenApiSchema `json:"schemas,omitempty"`
+}
+
+// OpenApiPathItem maps the lower case http methods of a path to their operations.
+type OpenApiPathItem map[string]*OpenApiOperation
+
+type OpenApiOperation struct {
+	OperationId string                      `json:"operationId"`
+	Parameters  []OpenApiParameter          `json:"parameters,omitempty"`
+	RequestBody *OpenApiRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*OpenApiResponse `json:"responses"`
+}
+
+type OpenApiParameter struct {
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required,omitempty"`
+	Schema   *OpenApiSchema `json:"schema"`
+}
+
+type OpenApiRequestBody struct {
+	Required bool                         `json:"required,omitempty"`
+	Content  map[string]*OpenApiMediaType `json:"content"`
+}
+
+type OpenApiResponse struct {
+	Description string                       `json:"description"`
+	Content     map[string]*OpenApiMediaType `json:"content,omitempty"`
+}
+
+type OpenApiMediaType struct {
+	Schema *OpenApiSchema `json:"schema"`
+}
+
+// RouteDescription documents the input and the output of a route in the openapi document.
+type RouteDescription struct {
+	// Input is a value of the type the request is bound to, it is nil for routes without input.
+	Input any
+	// Bindings are the names of the gin bindings the input is bound with, like binding.JSON.Name(), or one of
+	// OpenApiBindingRaw and OpenApiBindingReader.
+	Bindings []string
+	// Output is a value of the type of the body of successful responses, it is nil if the body isn't documented.
+	Output any
+}
+
+// DescribeHandler describes a route with the input of a handler implementing GetInput and the output of a handler
+// implementing HandlerWithOutput. Routes are described like this by the Create*Handler functions already, it is only
+// needed to describe a route differently:
+//
+//	d.POST("/todo", httpserver.CreateJsonHandler(handler)).Describe(httpserver.DescribeHandler(other, binding.JSON.Name()))
+func DescribeHandler(handler any, bindings ...string) RouteDescription {
+	description := RouteDescription{
+		Bindings: bindings,
+	}
+
+	if withInput, ok := handler.(interface{ GetInput() any }); ok {
+		description.Input = withInput.GetInput()
+	}
+
+	if withOutput, ok := handler.(HandlerWithOutput); ok {
+		description.Output = withOutput.GetOutput()
+	}
+
+	return description
+}
+
+// describeHandler wraps the handler func created for a handler, so describeHandlerFunc can recover the description of
+// the handler and the bindings the handler func uses. It must not be inlined, as every inlined copy of the returned
+// closure would have its own code.
+//
+//go:noinline
+func describeHandler(handlerFunc gin.HandlerFunc, handler any, bindings ...string) gin.HandlerFunc {
+	description := DescribeHandler(handler, bindings...)
+
+	return func(ginCtx *gin.Context) {
+		if ginCtx.Request == nil {
+			if target, ok := ginCtx.Keys[routeDescriptionKey].(*RouteDescription); ok {
+				*target = description
+
+				return
+			}
+		}
+
+		handlerFunc(ginCtx)
+	}
+}
+
+// describeHandlerFunc returns the description of a handler func created by one of the Create*Handler functions, or
+// nil for other handler funcs.
+func describeHandlerFunc(handlerFunc gin.HandlerFunc) *RouteDescription {
+	if handlerFunc == nil || reflect.ValueOf(handlerFunc).Pointer() != describedHandlerFunc {
+		return nil
+	}
+
+	description := &RouteDescription{}
+	ginCtx := &gin.Context{}
+	ginCtx.Set(routeDescriptionKey, description)
+
+	handlerFunc(ginCtx)
+
+	return description
+}
+
+// BuildOpenApiDocument describes the routes of the definitions. Routes handled by a handler func of one of the
+// Create*Handler functions or described with Definition.Describe are documented with their input and output, other
+// routes are documented with their path parameters only.
+func BuildOpenApiDocument(definitions *Definitions, info OpenApiInfo) (*OpenApiDocument, error) {
+	if definitions == nil {
+		return nil, fmt.Errorf("route definitions should not be nil")
+	}
+
+	schemas := newOpenApiSchemaBuilder()
+	document := &OpenApiDocument{
+		OpenApi: openApiVersion,
+		Info:    info,
+		Paths:   make(map[string]OpenApiPathItem),
+	}
+
+	for _, definition := range definitions.allRoutes() {
+		path, pathParams := openApiPath(definition.getAbsolutePath())
+		operation := &OpenApiOperation{
+			OperationId: openApiOperationId(definition.httpMethod, path),
+			Responses:   make(map[string]*OpenApiResponse),
+		}
+
+		if definition.description != nil {
+			describeOperation(schemas, operation, definition.description)
+		} else {
+			operation.Responses["default"] = &OpenApiResponse{Description: "response"}
+		}
+
+		addOpenApiPathParameters(operation, pathParams)
+
+		if _, ok := document.Paths[path]; !ok {
+			document.Paths[path] = make(OpenApiPathItem)
+		}
+
+		document.Paths[path][strings.ToLower(definition.httpMethod)] = operation
+	}
+
+	document.Components.Schemas = schemas.components
+
+	return document, nil
+}
+
+// WriteOpenApiDocument writes the openapi document of the routes of the definer for the server with the given name.
+func WriteOpenApiDocument(ctx context.Context, config cfg.Config, logger log.Logger, name string, definer Definer, writer io.Writer) error {
+	settings := &Settings{}
+	if err := config.UnmarshalKey(HttpserverSettingsKey(name), settings); err != nil {
+		return fmt.Errorf("failed to unmarshal httpserver settings: %w", err)
+	}
+
+	definitions, err := definer(ctx, config, logger.WithChannel("handler"))
+	if err != nil {
+		return fmt.Errorf("could not define routes: %w", err)
+	}
+
+	document, err := buildOpenApiDocument(config, definitions, settings.OpenApi)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return fmt.Errorf("can not encode openapi document: %w", err)
+	}
+
+	if _, err = writer.Write(append(body, '\n')); err != nil {
+		return fmt.Errorf("can not write openapi document: %w", err)
+	}
+
+	return nil
+}
+
+// NewOpenApiDocumentWriter returns a function writing the openapi document of the routes of the definer for the
+// server with the given name, to be used with cli.WithOpenApi.
+func NewOpenApiDocumentWriter(name string, definer Definer) func(ctx context.Context, config cfg.Config, logger log.Logger, writer io.Writer) error {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger, writer io.Writer) error {
+		return WriteOpenApiDocument(ctx, config, logger, name, definer, writer)
+	}
+}
+
+func buildOpenApiDocument(config cfg.Config, definitions *Definitions, settings OpenApiSettings) (*OpenApiDocument, error) {
+	title := settings.Title
+
+	if title == "" {
+		identity, err := cfg.GetAppIdentity(config)
+		if err != nil {
+			return nil, fmt.Errorf("can not get app identity from config: %w", err)
+		}
+
+		title = identity.Name
+	}
+
+	document, err := BuildOpenApiDocument(definitions, OpenApiInfo{
+		Title:   title,
+		Version: settings.Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can not build openapi document: %w", err)
+	}
+
+	return document, nil
+}
+
+func buildOpenApiHandler(document *OpenApiDocument) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		ginCtx.JSON(http.StatusOK, document)
+	}
+}
+
+func (d *Definitions) allRoutes() []Definition {
+	routes := make([]Definition, 0, len(d.routes))
+	for _, route := range d.routes {
+		routes = append(routes, *route)
+	}
+
+	for _, child := range d.children {
+		routes = append(routes, child.allRoutes()...)
+	}
+
+	return routes
+}
+
+func describeOperation(schemas *openApiSchemaBuilder, operation *OpenApiOperation, description *RouteDescription) {
+	input := description.Input
+
+	for _, bindingName := range description.Bindings {
+		switch bindingName {
+		case binding.JSON.Name():
+			operation.RequestBody = openApiRequestBody(ContentTypeJson, schemas.schema(reflect.TypeOf(input)))
+		case binding.ProtoBuf.Name():
+			operation.RequestBody = openApiRequestBody(ContentTypeProtobuf, &OpenApiSchema{Type: "string", Format: "binary"})
+		case binding.FormMultipart.Name():
+			operation.RequestBody = openApiRequestBody(binding.MIMEMultipartPOSTForm, openApiFormSchema(schemas, input))
+		case binding.FormPost.Name():
+			operation.RequestBody = openApiRequestBody(binding.MIMEPOSTForm, openApiFormSchema(schemas, input))
+		case OpenApiBindingRaw:
+			operation.RequestBody = openApiRequestBody(ContentTypeText, &OpenApiSchema{Type: "string"})
+		case OpenApiBindingReader:
+			operation.RequestBody = openApiRequestBody("application/octet-stream", &OpenApiSchema{Type: "string", Format: "binary"})
+		case binding.Query.Name(), binding.Form.Name():
+			operation.Parameters = append(operation.Parameters, openApiParameters(schemas, input, "form", openApiInQuery)...)
+		case binding.Uri.Name():
+			operation.Parameters = append(operation.Parameters, openApiParameters(schemas, input, "uri", openApiInPath)...)
+		case binding.Header.Name():
+			operation.Parameters = append(operation.Parameters, openApiParameters(schemas, input, "header", openApiInHeader)...)
+		}
+	}
+
+	response := &OpenApiResponse{Description: "successful response"}
+
+	if description.Output != nil {
+		response.Content = map[string]*OpenApiMediaType{
+			ContentTypeJson: {Schema: schemas.schema(reflect.TypeOf(description.Output))},
+		}
+	}
+
+	operation.Responses[fmt.Sprint(http.StatusOK)] = response
+
+	if input != nil {
+		operation.Responses[fmt.Sprint(http.StatusBadRequest)] = &OpenApiResponse{Description: "invalid input"}
+	}
+
+	operation.Responses[fmt.Sprint(http.StatusInternalServerError)] = &OpenApiResponse{Description: "internal error"}
+}
+
+func openApiRequestBody(contentType string, schema *OpenApiSchema) *OpenApiRequestBody {
+	return &OpenApiRequestBody{
+		Required: true,
+		Content: map[string]*OpenApiMediaType{
+			contentType: {Schema: schema},
+		},
+	}
+}
+
+func openApiFormSchema(schemas *openApiSchemaBuilder, input any) *OpenApiSchema {
+	schema := &OpenApiSchema{
+		Type:       "object",
+		Properties: make(map[string]*OpenApiSchema),
+	}
+
+	typ := openApiStructType(input)
+	if typ == nil {
+		return schema
+	}
+
+	for _, field := range openApiFields(typ, "form") {
+		fieldSchema := schemas.schema(field.typ)
+		schema.Properties[field.name] = fieldSchema
+
+		if applyOpenApiValidation(fieldSchema, field.validation) {
+			schema.Required = append(schema.Required, field.name)
+		}
+	}
+
+	return schema
+}
+
+func openApiParameters(schemas *openApiSchemaBuilder, input any, tag string, in string) []OpenApiParameter {
+	typ := openApiStructType(input)
+	if typ == nil {
+		return nil
+	}
+
+	fields := openApiFields(typ, tag)
+	parameters := make([]OpenApiParameter, 0, len(fields))
+
+	for _, field := range fields {
+		schema := schemas.schema(field.typ)
+		// path parameters are always required
+		required := applyOpenApiValidation(schema, field.validation) || in == openApiInPath
+
+		parameters = append(parameters, OpenApiParameter{
+			Name:     field.name,
+			In:       in,
+			Required: required,
+			Schema:   schema,
+		})
+	}
+
+	return parameters
+}
+
+// addOpenApiPathParameters documents the path parameters of the route the input doesn't describe already.
+func addOpenApiPathParameters(operation *OpenApiOperation, pathParams []string) {
+	for _, name := range pathParams {
+		documented := slices.ContainsFunc(operation.Parameters, func(parameter OpenApiParameter) bool {
+			return parameter.In == openApiInPath && parameter.Name == name
+		})
+
+		if documented {
+			continue
+		}
+
+		operation.Parameters = append(operation.Parameters, OpenApiParameter{
+			Name:     name,
+			In:       openApiInPath,
+			Required: true,
+			Schema:   &OpenApiSchema{Type: "string"},
+		})
+	}
+}
+
+func openApiStructType(input any) reflect.Type {
+	typ := reflect.TypeOf(input)
+
+	for typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil
+	}
+
+	return typ
+}
+
+// openApiPath converts the gin path parameters :name and *name into the openapi notation {name}.
+func openApiPath(path string) (string, []string) {
+	params := make([]string, 0)
+
+	path = openApiPathParam.ReplaceAllStringFunc(path, func(match string) string {
+		name := match[2:]
+		params = append(params, name)
+
+		return fmt.Sprintf("/{%s}", name)
+	})
+
+	return path, params
+}
+
+func openApiOperationId(method string, path string) string {
+	var builder strings.Builder
+	builder.WriteString(strings.ToLower(method))
+
+	for _, part := range strings.FieldsFunc(path, func(r rune) bool {
+		return !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9')
+	}) {
+		builder.WriteString(strings.ToUpper(part[:1]))
+		builder.WriteString(part[1:])
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,330 @@
+package httpserver
+
+import (
+	"encoding"
+	"fmt"
+	"mime/multipart"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+)
+
+var (
+	openApiComponentNameReplacer = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+	typeTime                     = reflect.TypeOf(time.Time{})
+	typeFileHeader               = reflect.TypeOf(multipart.FileHeader{})
+	typeJsonMarshaler            = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	typeTextMarshaler            = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// OpenApiSchema is the subset of the json schema dialect of openapi 3.0 which can be derived from go types.
+type OpenApiSchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Nullable             bool                      `json:"nullable,omitempty"`
+	Enum                 []any                     `json:"enum,omitempty"`
+	Minimum              *float64                  `json:"minimum,omitempty"`
+	Maximum              *float64                  `json:"maximum,omitempty"`
+	ExclusiveMinimum     bool                      `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     bool                      `json:"exclusiveMaximum,omitempty"`
+	MinLength            *int                      `json:"minLength,omitempty"`
+	MaxLength            *int                      `json:"maxLength,omitempty"`
+	MinItems             *int                      `json:"minItems,omitempty"`
+	MaxItems             *int                      `json:"maxItems,omitempty"`
+	Items                *OpenApiSchema            `json:"items,omitempty"`
+	Properties           map[string]*OpenApiSchema `json:"properties,omitempty"`
+	AdditionalProperties *OpenApiSchema            `json:"additionalProperties,omitempty"`
+	Required             []string                  `json:"required,omitempty"`
+}
+
+// openApiSchemaBuilder derives schemas from go types. Named structs are added to the components of the document and
+// referenced, which also takes care of recursive types.
+type openApiSchemaBuilder struct {
+	components map[string]*OpenApiSchema
+	names      map[reflect.Type]string
+}
+
+func newOpenApiSchemaBuilder() *openApiSchemaBuilder {
+	return &openApiSchemaBuilder{
+		components: make(map[string]*OpenApiSchema),
+		names:      make(map[reflect.Type]string),
+	}
+}
+
+func (b *openApiSchemaBuilder) schema(typ reflect.Type) *OpenApiSchema {
+	if typ == nil {
+		return &OpenApiSchema{}
+	}
+
+	if typ.Kind() == reflect.Pointer {
+		schema := b.schema(typ.Elem())
+
+		// siblings of $ref are ignored in openapi 3.0, so references can't be marked as nullable
+		if schema.Ref == "" {
+			schema.Nullable = true
+		}
+
+		return schema
+	}
+
+	switch {
+	case typ == typeTime:
+		return &OpenApiSchema{Type: "string", Format: "date-time"}
+	case typ == typeFileHeader:
+		return &OpenApiSchema{Type: "string", Format: "binary"}
+	case typ.Implements(typeJsonMarshaler) || reflect.PointerTo(typ).Implements(typeJsonMarshaler):
+		// the encoding is up to the type, we can't tell more than that there is a value
+		return &OpenApiSchema{}
+	case typ.Kind() != reflect.Struct && (typ.Implements(typeTextMarshaler) || reflect.PointerTo(typ).Implements(typeTextMarshaler)):
+		return &OpenApiSchema{Type: "string"}
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return &OpenApiSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int64:
+		return &OpenApiSchema{Type: "integer", Format: "int64"}
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &OpenApiSchema{Type: "integer", Format: "int32"}
+	case reflect.Uint, reflect.Uint64:
+		return &OpenApiSchema{Type: "integer", Format: "int64", Minimum: new(float64)}
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &OpenApiSchema{Type: "integer", Format: "int32", Minimum: new(float64)}
+	case reflect.Float32:
+		return &OpenApiSchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &OpenApiSchema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &OpenApiSchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return &OpenApiSchema{Type: "string", Format: "byte"}
+		}
+
+		return &OpenApiSchema{Type: "array", Items: b.schema(typ.Elem())}
+	case reflect.Map:
+		return &OpenApiSchema{Type: "object", AdditionalProperties: b.schema(typ.Elem())}
+	case reflect.Struct:
+		return b.structSchema(typ)
+	default:
+		return &OpenApiSchema{}
+	}
+}
+
+func (b *openApiSchemaBuilder) structSchema(typ reflect.Type) *OpenApiSchema {
+	if typ.Name() == "" {
+		return b.objectSchema(typ)
+	}
+
+	name, ok := b.names[typ]
+	if !ok {
+		name = b.componentName(typ)
+		b.names[typ] = name
+
+		// register the name first, so recursive types reference the component instead of looping
+		b.components[name] = &OpenApiSchema{}
+		*b.components[name] = *b.objectSchema(typ)
+	}
+
+	return &OpenApiSchema{Ref: fmt.Sprintf("#/components/schemas/%s", name)}
+}
+
+func (b *openApiSchemaBuilder) componentName(typ reflect.Type) string {
+	name := openApiComponentNameReplacer.ReplaceAllString(typ.Name(), "_")
+	name = strings.Trim(name, "_")
+
+	if _, taken := b.components[name]; !taken {
+		return name
+	}
+
+	// the same name in another package, prefix it with the package name
+	pkgPath := strings.Split(typ.PkgPath(), "/")
+	prefixed := fmt.Sprintf("%s.%s", pkgPath[len(pkgPath)-1], name)
+	name = prefixed
+
+	for i := 2; ; i++ {
+		if _, taken := b.components[name]; !taken {
+			return name
+		}
+
+		name = fmt.Sprintf("%s%d", prefixed, i)
+	}
+}
+
+func (b *openApiSchemaBuilder) objectSchema(typ reflect.Type) *OpenApiSchema {
+	schema := &OpenApiSchema{
+		Type:       "object",
+		Properties: make(map[string]*OpenApiSchema),
+	}
+
+	for _, field := range openApiFields(typ, "json") {
+		fieldSchema := b.schema(field.typ)
+		required := applyOpenApiValidation(fieldSchema, field.validation)
+
+		schema.Properties[field.name] = fieldSchema
+
+		if required {
+			schema.Required = append(schema.Required, field.name)
+		}
+	}
+
+	return schema
+}
+
+type openApiField struct {
+	name       string
+	typ        reflect.Type
+	validation []string
+}
+
+// openApiFields returns the fields of the struct named by the given tag, following the rules of the json encoding:
+// unexported and ignored fields are skipped and the fields of embedded structs without a name are promoted.
+func openApiFields(typ reflect.Type, tag string) []openApiField {
+	fields := make([]openApiField, 0, typ.NumField())
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+
+		if name == "-" {
+			continue
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			fields = append(fields, openApiFields(fieldType, tag)...)
+
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			if tag != "json" {
+				// the field is not bound by this binding
+				continue
+			}
+
+			name = field.Name
+		}
+
+		fields = append(fields, openApiField{
+			name:       name,
+			typ:        field.Type,
+			validation: openApiValidation(field),
+		})
+	}
+
+	return fields
+}
+
+func openApiValidation(field reflect.StructField) []string {
+	rules := make([]string, 0)
+
+	for _, tag := range []string{"binding", "validate"} {
+		if value := field.Tag.Get(tag); value != "" {
+			rules = append(rules, strings.Split(value, ",")...)
+		}
+	}
+
+	return rules
+}
+
+// applyOpenApiValidation adds the constraints of the validation rules the schema can express and returns whether the
+// field is required.
+func applyOpenApiValidation(schema *OpenApiSchema, rules []string) (required bool) {
+	for _, rule := range rules {
+		name, param, _ := strings.Cut(rule, "=")
+
+		switch name {
+		case "dive":
+			// the remaining rules apply to the elements
+			return required
+		case "required":
+			required = true
+		case "email":
+			schema.Format = "email"
+		case "url", "uri":
+			schema.Format = "uri"
+		case "uuid", "uuid4":
+			schema.Format = "uuid"
+		case "ip":
+			schema.Format = "ip"
+		case "oneof":
+			for _, value := range strings.Fields(param) {
+				schema.Enum = append(schema.Enum, openApiEnumValue(schema, value))
+			}
+		case "min", "gte", "max", "lte", "gt", "lt", "len":
+			applyOpenApiBound(schema, name, param)
+		}
+	}
+
+	return required
+}
+
+func applyOpenApiBound(schema *OpenApiSchema, rule string, param string) {
+	value, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return
+	}
+
+	lower := rule == "min" || rule == "gte" || rule == "gt" || rule == "len"
+	upper := rule == "max" || rule == "lte" || rule == "lt" || rule == "len"
+	exclusive := rule == "gt" || rule == "lt"
+	length := int(value)
+
+	switch schema.Type {
+	case "integer", "number":
+		if lower {
+			schema.Minimum = &value
+			schema.ExclusiveMinimum = exclusive
+		}
+
+		if upper {
+			schema.Maximum = &value
+			schema.ExclusiveMaximum = exclusive
+		}
+	case "string":
+		if lower {
+			schema.MinLength = &length
+		}
+
+		if upper {
+			schema.MaxLength = &length
+		}
+	case "array":
+		if lower {
+			schema.MinItems = &length
+		}
+
+		if upper {
+			schema.MaxItems = &length
+		}
+	}
+}
+
+func openApiEnumValue(schema *OpenApiSchema, value string) any {
+	switch schema.Type {
+	case "integer":
+		if parsed, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return parsed
+		}
+	case "number":
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+			return parsed
+		}
+	}
+
+	return value
+}
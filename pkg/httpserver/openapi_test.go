@@ -0,0 +1,226 @@
+package httpserver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/justtrackio/gosoline/pkg/httpserver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type openApiTodo struct {
+	Id        uint         `json:"id"`
+	Text      string       `json:"text"`
+	Tags      []string     `json:"tags"`
+	DueDate   *time.Time   `json:"dueDate"`
+	Parent    *openApiTodo `json:"parent"`
+	Ignored   string       `json:"-"`
+	Untagged  bool
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type openApiCreateInput struct {
+	Text     string   `json:"text" binding:"required,min=3,max=100"`
+	Priority int      `json:"priority" validate:"oneof=1 2 3"`
+	Email    string   `json:"email" binding:"omitempty,email"`
+	Tags     []string `json:"tags" binding:"max=5,dive,min=1"`
+}
+
+type openApiListInput struct {
+	Limit  int    `form:"limit" binding:"required,gte=1,lte=100"`
+	Search string `form:"search"`
+}
+
+type openApiReadInput struct {
+	Id uint `uri:"id" binding:"required"`
+}
+
+type openApiCreateHandler struct{}
+
+func (h openApiCreateHandler) GetInput() any {
+	return &openApiCreateInput{}
+}
+
+func (h openApiCreateHandler) GetOutput() any {
+	return &openApiTodo{}
+}
+
+func (h openApiCreateHandler) Handle(_ context.Context, _ *httpserver.Request) (*httpserver.Response, error) {
+	return httpserver.NewStatusResponse(200), nil
+}
+
+type openApiListHandler struct{}
+
+func (h openApiListHandler) GetInput() any {
+	return &openApiListInput{}
+}
+
+func (h openApiListHandler) GetOutput() any {
+	return []openApiTodo{}
+}
+
+func (h openApiListHandler) Handle(_ context.Context, _ *httpserver.Request) (*httpserver.Response, error) {
+	return httpserver.NewStatusResponse(200), nil
+}
+
+type openApiReadHandler struct{}
+
+func (h openApiReadHandler) GetInput() any {
+	return &openApiReadInput{}
+}
+
+func (h openApiReadHandler) Handle(_ context.Context, _ *httpserver.Request) (*httpserver.Response, error) {
+	return httpserver.NewStatusResponse(200), nil
+}
+
+func TestBuildOpenApiDocument(t *testing.T) {
+	d := &httpserver.Definitions{}
+	d.POST("/v1/todo", httpserver.CreateJsonHandler(openApiCreateHandler{}))
+
+	group := d.Group("/v1")
+	group.GET("/todos", httpserver.CreateQueryHandler(openApiListHandler{}))
+	group.GET("/todo/:id", httpserver.CreateUriHandler(openApiReadHandler{}))
+	group.DELETE("/todo/:id", func(ginCtx *gin.Context) {})
+	group.PUT("/file/*path", httpserver.CreateRawHandler(openApiReadHandler{}))
+
+	document, err := httpserver.BuildOpenApiDocument(d, httpserver.OpenApiInfo{
+		Title:   "todos",
+		Version: "1.2.3",
+	})
+	require.NoError(t, err)
+
+	actual, err := json.Marshal(document)
+	require.NoError(t, err)
+
+	expected := `{
+		"openapi": "3.0.3",
+		"info": {"title": "todos", "version": "1.2.3"},
+		"paths": {
+			"/v1/todo": {
+				"post": {
+					"operationId": "postV1Todo",
+					"requestBody": {
+						"required": true,
+						"content": {"application/json; charset=utf-8": {"schema": {"$ref": "#/components/schemas/openApiCreateInput"}}}
+					},
+					"responses": {
+						"200": {
+							"description": "successful response",
+							"content": {"application/json; charset=utf-8": {"schema": {"$ref": "#/components/schemas/openApiTodo"}}}
+						},
+						"400": {"description": "invalid input"},
+						"500": {"description": "internal error"}
+					}
+				}
+			},
+			"/v1/todos": {
+				"get": {
+					"operationId": "getV1Todos",
+					"parameters": [
+						{"name": "limit", "in": "query", "required": true, "schema": {"type": "integer", "format": "int64", "minimum": 1, "maximum": 100}},
+						{"name": "search", "in": "query", "schema": {"type": "string"}}
+					],
+					"responses": {
+						"200": {
+							"description": "successful response",
+							"content": {"application/json; charset=utf-8": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/openApiTodo"}}}}
+						},
+						"400": {"description": "invalid input"},
+						"500": {"description": "internal error"}
+					}
+				}
+			},
+			"/v1/todo/{id}": {
+				"get": {
+					"operationId": "getV1TodoId",
+					"parameters": [
+						{"name": "id", "in": "path", "required": true, "schema": {"type": "integer", "format": "int64", "minimum": 0}}
+					],
+					"responses": {
+						"200": {"description": "successful response"},
+						"400": {"description": "invalid input"},
+						"500": {"description": "internal error"}
+					}
+				},
+				"delete": {
+					"operationId": "deleteV1TodoId",
+					"parameters": [
+						{"name": "id", "in": "path", "required": true, "schema": {"type": "string"}}
+					],
+					"responses": {
+						"default": {"description": "response"}
+					}
+				}
+			},
+			"/v1/file/{path}": {
+				"put": {
+					"operationId": "putV1FilePath",
+					"parameters": [
+						{"name": "path", "in": "path", "required": true, "schema": {"type": "string"}}
+					],
+					"requestBody": {
+						"required": true,
+						"content": {"text/plain; charset=utf-8": {"schema": {"type": "string"}}}
+					},
+					"responses": {
+						"200": {"description": "successful response"},
+						"400": {"description": "invalid input"},
+						"500": {"description": "internal error"}
+					}
+				}
+			}
+		},
+		"components": {
+			"schemas": {
+				"openApiCreateInput": {
+					"type": "object",
+					"properties": {
+						"text": {"type": "string", "minLength": 3, "maxLength": 100},
+						"priority": {"type": "integer", "format": "int64", "enum": [1, 2, 3]},
+						"email": {"type": "string", "format": "email"},
+						"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 5}
+					},
+					"required": ["text"]
+				},
+				"openApiTodo": {
+					"type": "object",
+					"properties": {
+						"id": {"type": "integer", "format": "int64", "minimum": 0},
+						"text": {"type": "string"},
+						"tags": {"type": "array", "items": {"type": "string"}},
+						"dueDate": {"type": "string", "format": "date-time", "nullable": true},
+						"parent": {"$ref": "#/components/schemas/openApiTodo"},
+						"Untagged": {"type": "boolean"},
+						"createdAt": {"type": "string", "format": "date-time"}
+					}
+				}
+			}
+		}
+	}`
+
+	assert.JSONEq(t, expected, string(actual))
+}
+
+func TestBuildOpenApiDocument_Describe(t *testing.T) {
+	d := &httpserver.Definitions{}
+	d.POST("/v1/todo", func(ginCtx *gin.Context) {}, httpserver.CreateHandler(openApiReadHandler{})).
+		Describe(httpserver.DescribeHandler(openApiCreateHandler{}, binding.JSON.Name()))
+
+	document, err := httpserver.BuildOpenApiDocument(d, httpserver.OpenApiInfo{})
+	require.NoError(t, err)
+
+	operation := document.Paths["/v1/todo"]["post"]
+	require.NotNil(t, operation.RequestBody)
+	assert.Equal(t, "#/components/schemas/openApiCreateInput", operation.RequestBody.Content[httpserver.ContentTypeJson].Schema.Ref)
+	assert.Equal(t, "#/components/schemas/openApiTodo", operation.Responses["200"].Content[httpserver.ContentTypeJson].Schema.Ref)
+}
+
+func TestBuildOpenApiDocument_NilDefinitions(t *testing.T) {
+	_, err := httpserver.BuildOpenApiDocument(nil, httpserver.OpenApiInfo{})
+	assert.EqualError(t, err, "route definitions should not be nil")
+}
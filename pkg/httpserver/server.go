@@ -19,6 +19,7 @@ import (
 	"github.com/justtrackio/gosoline/pkg/coffin"
 	"github.com/justtrackio/gosoline/pkg/kernel"
 	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/tlsx"
 	"github.com/justtrackio/gosoline/pkg/tracing"
 )
 
@@ -74,6 +75,7 @@ func NewWithSettings(ctx context.Context, name string, definer Definer, settings
 			compressionMiddlewares         []gin.HandlerFunc
 			healthChecker                  kernel.HealthChecker
 			connectionLifeCycleInterceptor gin.HandlerFunc
+			openApiDocument                *OpenApiDocument
 		)
 
 		if tracingInstrumentor, err = tracing.ProvideInstrumentor(ctx, config, logger); err != nil {
@@ -122,6 +124,14 @@ func NewWithSettings(ctx context.Context, name string, definer Definer, settings
 
 		setupMetricMiddleware(definitionList)
 
+		if settings.OpenApi.Enabled {
+			if openApiDocument, err = buildOpenApiDocument(config, definitions, settings.OpenApi); err != nil {
+				return nil, err
+			}
+
+			router.GET(settings.OpenApi.Path, buildOpenApiHandler(openApiDocument))
+		}
+
 		if err = appendMetadata(ctx, name, router); err != nil {
 			return nil, fmt.Errorf("can not append metadata: %w", err)
 		}
@@ -153,6 +163,12 @@ func NewWithInterfaces(
 	var listener net.Listener
 	address := server.Addr
 
+	if settings.Tls.Enabled {
+		if server.TLSConfig, err = tlsx.NewConfig(logger, settings.Tls); err != nil {
+			return nil, fmt.Errorf("can not create tls config: %w", err)
+		}
+	}
+
 	if address == "" {
 		address = ":http"
 	}
@@ -186,7 +202,14 @@ func (s *HttpServer) Run(ctx context.Context) error {
 	cfn.GoWithContext(ctx, s.waitForStop)
 	cfn.GoWithContext(ctx, s.metricRecorder.Run)
 	cfn.Go(func() error {
-		err := s.server.Serve(s.listener)
+		var err error
+
+		if s.server.TLSConfig != nil {
+			// the certificates are provided by the tls config, ServeTLS takes care of enabling http2
+			err = s.server.ServeTLS(s.listener, "", "")
+		} else {
+			err = s.server.Serve(s.listener)
+		}
 
 		if !errors.Is(err, http.ErrServerClosed) {
 			return fmt.Errorf("server closed unexpectedly: %w", err)
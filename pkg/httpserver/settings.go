@@ -1,6 +1,10 @@
 package httpserver
 
-import "time"
+import (
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/tlsx"
+)
 
 type (
 	// CompressionSettings control gzip support for requests and responses.
@@ -64,6 +68,10 @@ type (
 		MaxBodyBytes int64 `cfg:"max_body_bytes" default:"10485760"`
 		// Concurrency settings control request and connection pressure limits.
 		Concurrency ConcurrencySettings `cfg:"concurrency"`
+		// OpenApi settings control serving the openapi document of the routes.
+		OpenApi OpenApiSettings `cfg:"openapi"`
+		// Tls settings control the tls termination and the verification of client certificates.
+		Tls tlsx.Settings `cfg:"tls"`
 	}
 
 	// ConcurrencySettings configures pressure limits for a HTTP server.
@@ -0,0 +1,254 @@
+package sql
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+)
+
+const (
+	CursorNext = "next"
+	CursorPrev = "prev"
+)
+
+const (
+	cursorTypeBool   = "b"
+	cursorTypeFloat  = "f"
+	cursorTypeInt    = "i"
+	cursorTypeNull   = "n"
+	cursorTypeString = "s"
+	cursorTypeTime   = "t"
+	cursorTypeUint   = "u"
+)
+
+// CursorKey is one of the keys identifying the position of a row in a cursor paginated list. The keys are the
+// order fields of the input followed by the primary key, which makes the position unique.
+type CursorKey struct {
+	// Field is the name of the list mapping of the key
+	Field string
+	// Column is the column the key is read from
+	Column string
+	// Direction is the order direction of the key, either ASC or DESC
+	Direction string
+	// Nullable is whether the column can contain NULL. NULLs are ordered after all other values when ascending and
+	// before them when descending, independent of the database.
+	Nullable bool
+}
+
+// Cursor points at a row of a list. A next cursor continues the list after that row, a prev cursor continues it
+// backwards before that row.
+type Cursor struct {
+	Direction string
+	Values    []any
+}
+
+type encodedCursor struct {
+	Direction string         `json:"d"`
+	Order     string         `json:"o"`
+	Values    []encodedValue `json:"v"`
+}
+
+type encodedValue struct {
+	Type  string `json:"t"`
+	Value string `json:"v"`
+}
+
+// EncodeCursor creates an opaque cursor pointing at the row with the given values of the cursor keys.
+func EncodeCursor(direction string, keys []CursorKey, values []any) (string, error) {
+	if len(keys) != len(values) {
+		return "", fmt.Errorf("expected %d cursor values but got %d", len(keys), len(values))
+	}
+
+	encoded := encodedCursor{
+		Direction: direction,
+		Order:     cursorOrder(keys),
+		Values:    make([]encodedValue, len(values)),
+	}
+
+	for i, value := range values {
+		ev, err := encodeCursorValue(value)
+		if err != nil {
+			return "", fmt.Errorf("can not encode cursor value of field %s: %w", keys[i].Field, err)
+		}
+
+		encoded.Values[i] = ev
+	}
+
+	bytes, err := json.Marshal(encoded)
+	if err != nil {
+		return "", fmt.Errorf("can not marshal cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// DecodeCursor decodes a cursor created by EncodeCursor. It fails if the cursor was created for a different order.
+func DecodeCursor(cursor string, keys []CursorKey) (*Cursor, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	encoded := encodedCursor{}
+	if err = json.Unmarshal(bytes, &encoded); err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	if encoded.Direction != CursorNext && encoded.Direction != CursorPrev {
+		return nil, fmt.Errorf("invalid cursor: unknown direction %q", encoded.Direction)
+	}
+
+	if encoded.Order != cursorOrder(keys) || len(encoded.Values) != len(keys) {
+		return nil, fmt.Errorf("invalid cursor: it was created for a different order")
+	}
+
+	decoded := &Cursor{
+		Direction: encoded.Direction,
+		Values:    make([]any, len(encoded.Values)),
+	}
+
+	for i, ev := range encoded.Values {
+		if decoded.Values[i], err = decodeCursorValue(ev); err != nil {
+			return nil, fmt.Errorf("invalid cursor: %w", err)
+		}
+	}
+
+	return decoded, nil
+}
+
+func cursorOrder(keys []CursorKey) string {
+	order := make([]string, len(keys))
+
+	for i, key := range keys {
+		order[i] = fmt.Sprintf("%s:%s", key.Field, key.Direction)
+	}
+
+	return strings.Join(order, ",")
+}
+
+func encodeCursorValue(value any) (encodedValue, error) {
+	if t, ok := value.(time.Time); ok {
+		return encodedValue{Type: cursorTypeTime, Value: t.Format(time.RFC3339Nano)}, nil
+	}
+
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return encodedValue{Type: cursorTypeNull}, nil
+		}
+
+		rv = rv.Elem()
+	}
+
+	if !rv.IsValid() {
+		return encodedValue{Type: cursorTypeNull}, nil
+	}
+
+	if t, ok := rv.Interface().(time.Time); ok {
+		return encodeCursorValue(t)
+	}
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		return encodedValue{Type: cursorTypeBool, Value: strconv.FormatBool(rv.Bool())}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return encodedValue{Type: cursorTypeInt, Value: strconv.FormatInt(rv.Int(), 10)}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return encodedValue{Type: cursorTypeUint, Value: strconv.FormatUint(rv.Uint(), 10)}, nil
+	case reflect.Float32, reflect.Float64:
+		return encodedValue{Type: cursorTypeFloat, Value: strconv.FormatFloat(rv.Float(), 'g', -1, 64)}, nil
+	case reflect.String:
+		return encodedValue{Type: cursorTypeString, Value: rv.String()}, nil
+	default:
+		return encodedValue{}, fmt.Errorf("values of type %T are not supported", value)
+	}
+}
+
+func decodeCursorValue(ev encodedValue) (any, error) {
+	switch ev.Type {
+	case cursorTypeBool:
+		return strconv.ParseBool(ev.Value)
+	case cursorTypeInt:
+		return strconv.ParseInt(ev.Value, 10, 64)
+	case cursorTypeUint:
+		return strconv.ParseUint(ev.Value, 10, 64)
+	case cursorTypeFloat:
+		return strconv.ParseFloat(ev.Value, 64)
+	case cursorTypeString:
+		return ev.Value, nil
+	case cursorTypeNull:
+		return nil, nil
+	case cursorTypeTime:
+		return time.Parse(time.RFC3339Nano, ev.Value)
+	default:
+		return nil, fmt.Errorf("unknown value type %q", ev.Type)
+	}
+}
+
+// buildCursorCondition builds the keyset condition selecting the rows after (or before for a prev cursor) the row the
+// cursor points at: (k1 > v1) OR (k1 = v1 AND k2 > v2) OR ... As NULLs are ordered after all other values, a NULL is
+// greater than every value.
+func buildCursorCondition(keys []CursorKey, cursor *Cursor) (where string, args []any) {
+	stmts := make([]string, 0, len(keys))
+	args = make([]any, 0)
+
+	for i, key := range keys {
+		ascending := !strings.EqualFold(key.Direction, DirectionDesc)
+		greater := ascending != (cursor.Direction == CursorPrev)
+
+		condition, conditionArgs, ok := buildCursorComparison(key, greater, cursor.Values[i])
+		if !ok {
+			continue
+		}
+
+		conditions := make([]string, 0, i+1)
+
+		for j := 0; j < i; j++ {
+			if cursor.Values[j] == nil {
+				conditions = append(conditions, fmt.Sprintf("%s IS NULL", keys[j].Column))
+
+				continue
+			}
+
+			conditions = append(conditions, fmt.Sprintf("%s = ?", keys[j].Column))
+			args = append(args, cursor.Values[j])
+		}
+
+		conditions = append(conditions, condition)
+		args = append(args, conditionArgs...)
+
+		stmts = append(stmts, fmt.Sprintf("(%s)", strings.Join(conditions, " AND ")))
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(stmts, " OR ")), args
+}
+
+// buildCursorComparison compares the column of the key with the value of the cursor. It returns false if no value can
+// be greater than the value, which is the case for NULL.
+func buildCursorComparison(key CursorKey, greater bool, value any) (condition string, args []any, ok bool) {
+	switch {
+	case greater && value == nil:
+		return "", nil, false
+	case greater && key.Nullable:
+		return fmt.Sprintf("(%s %s ? OR %s IS NULL)", key.Column, OpGt, key.Column), []any{value}, true
+	case greater:
+		return fmt.Sprintf("%s %s ?", key.Column, OpGt), []any{value}, true
+	case value == nil:
+		return fmt.Sprintf("%s IS NOT NULL", key.Column), nil, true
+	default:
+		return fmt.Sprintf("%s %s ?", key.Column, OpLt), []any{value}, true
+	}
+}
+
+func reverseDirection(direction string) string {
+	if strings.EqualFold(direction, DirectionDesc) {
+		return DirectionAsc
+	}
+
+	return DirectionDesc
+}
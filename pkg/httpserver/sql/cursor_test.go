@@ -0,0 +1,48 @@
+package sql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/httpserver/sql"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	keys := []sql.CursorKey{
+		{Field: "name", Column: "name", Direction: sql.DirectionAsc},
+		{Field: "score", Column: "score", Direction: sql.DirectionDesc},
+		{Field: "active", Column: "active", Direction: sql.DirectionAsc},
+		{Field: "createdAt", Column: "created_at", Direction: sql.DirectionDesc},
+		{Field: "id", Column: "id", Direction: sql.DirectionAsc},
+	}
+	createdAt := time.Date(2026, 10, 17, 12, 0, 30, 123, time.UTC)
+
+	cursor, err := sql.EncodeCursor(sql.CursorPrev, keys, []any{mdl.Box("foo"), 1.5, true, &createdAt, uint(7)})
+	assert.NoError(t, err)
+
+	decoded, err := sql.DecodeCursor(cursor, keys)
+	assert.NoError(t, err)
+	assert.Equal(t, &sql.Cursor{
+		Direction: sql.CursorPrev,
+		Values:    []any{"foo", 1.5, true, createdAt, uint64(7)},
+	}, decoded)
+}
+
+func TestCursor_EncodeNull(t *testing.T) {
+	keys := []sql.CursorKey{
+		{Field: "name", Column: "name", Direction: sql.DirectionAsc, Nullable: true},
+		{Field: "id", Column: "id", Direction: sql.DirectionAsc},
+	}
+
+	cursor, err := sql.EncodeCursor(sql.CursorNext, keys, []any{(*string)(nil), uint(7)})
+	assert.NoError(t, err)
+
+	decoded, err := sql.DecodeCursor(cursor, keys)
+	assert.NoError(t, err)
+	assert.Equal(t, &sql.Cursor{
+		Direction: sql.CursorNext,
+		Values:    []any{nil, uint64(7)},
+	}, decoded)
+}
@@ -2,10 +2,14 @@ package sql
 
 import (
 	"fmt"
+	"reflect"
+	"slices"
 	"strings"
 
+	"github.com/jinzhu/gorm"
 	"github.com/justtrackio/gosoline/pkg/db"
 	"github.com/justtrackio/gosoline/pkg/db-repo"
+	"github.com/justtrackio/gosoline/pkg/funk"
 )
 
 const (
@@ -34,9 +38,17 @@ type Order struct {
 	Field     string `json:"field"`
 }
 
+// Page selects a part of the list either by an offset or by a cursor. An empty cursor requests the first page of a
+// cursor paginated list, the following pages are requested with the cursors returned for the previous page.
 type Page struct {
-	Limit  int `json:"limit"`
-	Offset int `json:"offset"`
+	Limit  int     `json:"limit"`
+	Offset int     `json:"offset"`
+	Cursor *string `json:"cursor,omitempty"`
+}
+
+// IsCursor reports whether the page is selected by a cursor instead of an offset.
+func (p *Page) IsCursor() bool {
+	return p != nil && p.Cursor != nil
 }
 
 type Filter struct {
@@ -68,9 +80,9 @@ type OrmQueryBuilder struct {
 	*baseQueryBuilder
 }
 
-func NewOrmQueryBuilder(metadata db_repo.Metadata) *OrmQueryBuilder {
+func NewOrmQueryBuilder(metadata db_repo.Metadata, options ...QueryBuilderOption) *OrmQueryBuilder {
 	return &OrmQueryBuilder{
-		baseQueryBuilder: newBaseQueryBuilder(metadata),
+		baseQueryBuilder: newBaseQueryBuilder(metadata, options...),
 	}
 }
 
@@ -85,9 +97,9 @@ type RawQueryBuilder struct {
 	*baseQueryBuilder
 }
 
-func NewRawQueryBuilder(metadata db_repo.Metadata) *RawQueryBuilder {
+func NewRawQueryBuilder(metadata db_repo.Metadata, options ...QueryBuilderOption) *RawQueryBuilder {
 	return &RawQueryBuilder{
-		baseQueryBuilder: newBaseQueryBuilder(metadata),
+		baseQueryBuilder: newBaseQueryBuilder(metadata, options...),
 	}
 }
 
@@ -98,16 +110,34 @@ func (qb RawQueryBuilder) Build(inp *Input) (*db.RawQueryBuilder, error) {
 	return rawQb, err
 }
 
+type QueryBuilderOption func(qb *baseQueryBuilder)
+
+// WithModel provides the model of the table to the query builder. The cursor keys of columns which can't contain NULL
+// according to the model are ordered and compared without checks for NULL, so the database can use an index on them.
+// Without a model, all cursor keys except the primary key are treated as nullable.
+func WithModel(model any) QueryBuilderOption {
+	return func(qb *baseQueryBuilder) {
+		qb.model = reflect.TypeOf(model)
+	}
+}
+
 type baseQueryBuilder struct {
 	mapping  db_repo.FieldMappings
 	metadata db_repo.Metadata
+	model    reflect.Type
 }
 
-func newBaseQueryBuilder(metadata db_repo.Metadata) *baseQueryBuilder {
-	return &baseQueryBuilder{
+func newBaseQueryBuilder(metadata db_repo.Metadata, options ...QueryBuilderOption) *baseQueryBuilder {
+	qb := &baseQueryBuilder{
 		metadata: metadata,
 		mapping:  metadata.Mappings,
 	}
+
+	for _, opt := range options {
+		opt(qb)
+	}
+
+	return qb
 }
 
 func (qb baseQueryBuilder) build(inp *Input, dbQb db.QueryBuilder) error {
@@ -146,6 +176,10 @@ func (qb baseQueryBuilder) build(inp *Input, dbQb db.QueryBuilder) error {
 	dbQb.Where(query, args...)
 	dbQb.GroupBy(groupBy...)
 
+	if inp.Page.IsCursor() {
+		return qb.buildCursorPage(inp, dbQb)
+	}
+
 	for _, o := range inp.Order {
 		if _, ok := qb.mapping[o.Field]; !ok {
 			return fmt.Errorf("no list mapping found for order field %s", o.Field)
@@ -166,6 +200,230 @@ func (qb baseQueryBuilder) build(inp *Input, dbQb db.QueryBuilder) error {
 	return nil
 }
 
+// buildCursorPage orders the rows by the cursor keys and selects the rows following the row the cursor points at. The
+// rows of a prev cursor are selected in reverse order, so the caller has to reverse them again.
+func (qb baseQueryBuilder) buildCursorPage(inp *Input, dbQb db.QueryBuilder) error {
+	if inp.Page.Offset != 0 {
+		return fmt.Errorf("a page can not have an offset and a cursor at the same time")
+	}
+
+	if inp.Page.Limit <= 0 {
+		return fmt.Errorf("a cursor page requires a positive limit")
+	}
+
+	keys, err := qb.CursorKeys(inp)
+	if err != nil {
+		return err
+	}
+
+	cursor := &Cursor{
+		Direction: CursorNext,
+	}
+
+	if *inp.Page.Cursor != "" {
+		if cursor, err = DecodeCursor(*inp.Page.Cursor, keys); err != nil {
+			return err
+		}
+
+		query, args := buildCursorCondition(keys, cursor)
+		dbQb.Where(query, args...)
+	}
+
+	for _, key := range keys {
+		direction := key.Direction
+		if cursor.Direction == CursorPrev {
+			direction = reverseDirection(direction)
+		}
+
+		// databases differ in the position of NULLs, ordering by the check for NULL first puts them after all other
+		// values for ascending order everywhere
+		if key.Nullable {
+			dbQb.OrderBy(fmt.Sprintf("%s IS NULL", key.Column), direction)
+		}
+
+		dbQb.OrderBy(key.Column, direction)
+	}
+
+	dbQb.Page(0, inp.Page.Limit)
+
+	return nil
+}
+
+// CursorKeys returns the keys identifying the position of a row for the order of the input. These are the order
+// fields followed by the primary key as tie-breaker, if the order doesn't contain it already. The primary key can't be
+// NULL, the other keys can be NULL unless the model says otherwise.
+func (qb baseQueryBuilder) CursorKeys(inp *Input) ([]CursorKey, error) {
+	keys := make([]CursorKey, 0, len(inp.Order)+1)
+	orderedByPrimaryKey := false
+
+	for _, o := range inp.Order {
+		mapping, ok := qb.mapping[o.Field]
+		if !ok {
+			return nil, fmt.Errorf("no list mapping found for order field %s", o.Field)
+		}
+
+		if o.Direction != "" && !strings.EqualFold(o.Direction, DirectionAsc) && !strings.EqualFold(o.Direction, DirectionDesc) {
+			return nil, fmt.Errorf("invalid order direction %q", o.Direction)
+		}
+
+		columns := mapping.ColumnNames()
+		if len(columns) != 1 {
+			return nil, fmt.Errorf("can not paginate by cursor on order field %s with %d columns", o.Field, len(columns))
+		}
+
+		direction := DirectionAsc
+		if strings.EqualFold(o.Direction, DirectionDesc) {
+			direction = DirectionDesc
+		}
+
+		isPrimaryKey := columns[0] == qb.metadata.PrimaryKey
+
+		keys = append(keys, CursorKey{
+			Field:     o.Field,
+			Column:    columns[0],
+			Direction: direction,
+			Nullable:  !isPrimaryKey && qb.isNullable(columns[0]),
+		})
+
+		orderedByPrimaryKey = orderedByPrimaryKey || isPrimaryKey
+	}
+
+	if orderedByPrimaryKey {
+		return keys, nil
+	}
+
+	keys = append(keys, CursorKey{
+		Field:     qb.primaryKeyField(),
+		Column:    qb.metadata.PrimaryKey,
+		Direction: DirectionAsc,
+	})
+
+	return keys, nil
+}
+
+// isNullable reports whether the column can contain NULL according to the field of the model it is mapped to. Columns
+// without a model, of other tables or without a field are treated as nullable.
+func (qb baseQueryBuilder) isNullable(column string) bool {
+	if qb.model == nil {
+		return true
+	}
+
+	if i := strings.LastIndex(column, "."); i >= 0 {
+		if strings.Trim(column[:i], "`\"") != strings.Trim(qb.metadata.TableName, "`\"") {
+			return true
+		}
+
+		column = column[i+1:]
+	}
+
+	field, ok := findColumnField(qb.model, strings.Trim(column, "`\""))
+	if !ok {
+		return true
+	}
+
+	return isNullableField(field)
+}
+
+// findColumnField looks up the field of a model which is stored in the column, using the gorm column tag or the
+// column name derived from the field name.
+func findColumnField(model reflect.Type, column string) (reflect.StructField, bool) {
+	for model.Kind() == reflect.Pointer {
+		model = model.Elem()
+	}
+
+	if model.Kind() != reflect.Struct {
+		return reflect.StructField{}, false
+	}
+
+	for i := 0; i < model.NumField(); i++ {
+		field := model.Field(i)
+		settings := gormTagSettings(field)
+
+		if !field.IsExported() || settings["-"] {
+			continue
+		}
+
+		if field.Anonymous || settings["embedded"] {
+			if embedded, ok := findColumnField(field.Type, column); ok {
+				return embedded, true
+			}
+
+			continue
+		}
+
+		name := gorm.ToColumnName(field.Name)
+		for setting := range settings {
+			if gormColumn, ok := strings.CutPrefix(setting, "column:"); ok {
+				name = gormColumn
+			}
+		}
+
+		if name == column {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// isNullableField reports whether a field can hold NULL, which is the case for pointers, interfaces and the Null
+// types of database/sql, unless the gorm tag declares the column as not null or as primary key.
+func isNullableField(field reflect.StructField) bool {
+	settings := gormTagSettings(field)
+	if settings["not null"] || settings["primary_key"] {
+		return false
+	}
+
+	switch field.Type.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return true
+	default:
+		return field.Type.PkgPath() == "database/sql" && strings.HasPrefix(field.Type.Name(), "Null")
+	}
+}
+
+// gormTagSettings returns the settings of the gorm tag of a field. The keys are lowercase, the values are kept as
+// part of the key, e.g. column:name.
+func gormTagSettings(field reflect.StructField) map[string]bool {
+	settings := map[string]bool{}
+
+	for _, setting := range strings.Split(field.Tag.Get("gorm"), ";") {
+		if setting = strings.TrimSpace(setting); setting == "" {
+			continue
+		}
+
+		name, value, found := strings.Cut(setting, ":")
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		if found {
+			name = fmt.Sprintf("%s:%s", name, strings.TrimSpace(value))
+		}
+
+		settings[name] = true
+	}
+
+	return settings
+}
+
+// primaryKeyField returns the name of the list mapping of the primary key or the plain column name if there is none.
+func (qb baseQueryBuilder) primaryKeyField() string {
+	fields := funk.Keys(qb.mapping)
+	slices.Sort(fields)
+
+	for _, field := range fields {
+		if columns := qb.mapping[field].ColumnNames(); len(columns) == 1 && columns[0] == qb.metadata.PrimaryKey {
+			return field
+		}
+	}
+
+	column := qb.metadata.PrimaryKey
+	if i := strings.LastIndex(column, "."); i >= 0 {
+		column = column[i+1:]
+	}
+
+	return strings.Trim(column, "`")
+}
+
 func (qb baseQueryBuilder) getJoins(inp *Input) ([]string, error) {
 	joins := make([]string, 0)
 
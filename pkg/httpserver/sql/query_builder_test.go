@@ -1,10 +1,12 @@
 package sql_test
 
 import (
+	gosql "database/sql"
 	"testing"
 
 	"github.com/justtrackio/gosoline/pkg/db-repo"
 	"github.com/justtrackio/gosoline/pkg/httpserver/sql"
+	"github.com/justtrackio/gosoline/pkg/mdl"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -728,3 +730,244 @@ func TestListQueryBuilder_IsNotOperator_NilValueProducesIsNotNull(t *testing.T)
 	expected.GroupBy("id")
 	assert.Equal(t, expected, qb)
 }
+
+func TestListQueryBuilder_BuildCursor(t *testing.T) {
+	metadata := db_repo.Metadata{
+		TableName:  "tablename",
+		PrimaryKey: "tablename.id",
+		Mappings: db_repo.FieldMappings{
+			"id":     db_repo.NewFieldMapping("tablename.id"),
+			"fieldA": db_repo.NewFieldMapping("fieldA"),
+			"fieldB": db_repo.NewFieldMapping("fieldB"),
+		},
+	}
+
+	order := []sql.Order{
+		{
+			Field:     "fieldA",
+			Direction: "ASC",
+		},
+		{
+			Field:     "fieldB",
+			Direction: "DESC",
+		},
+	}
+	keys := []sql.CursorKey{
+		{Field: "fieldA", Column: "fieldA", Direction: sql.DirectionAsc, Nullable: true},
+		{Field: "fieldB", Column: "fieldB", Direction: sql.DirectionDesc, Nullable: true},
+		{Field: "id", Column: "tablename.id", Direction: sql.DirectionAsc},
+	}
+
+	next, err := sql.EncodeCursor(sql.CursorNext, keys, []any{"a", 5, uint(3)})
+	assert.NoError(t, err)
+
+	nextNull, err := sql.EncodeCursor(sql.CursorNext, keys, []any{nil, 5, uint(3)})
+	assert.NoError(t, err)
+
+	prev, err := sql.EncodeCursor(sql.CursorPrev, keys, []any{"a", 5, uint(3)})
+	assert.NoError(t, err)
+
+	lqb := sql.NewOrmQueryBuilder(metadata)
+
+	tests := map[string]struct {
+		cursor   string
+		expected func(qb *db_repo.QueryBuilder)
+	}{
+		"first": {
+			cursor: "",
+			expected: func(qb *db_repo.QueryBuilder) {
+				qb.OrderBy("fieldA IS NULL", "ASC")
+				qb.OrderBy("fieldA", "ASC")
+				qb.OrderBy("fieldB IS NULL", "DESC")
+				qb.OrderBy("fieldB", "DESC")
+				qb.OrderBy("tablename.id", "ASC")
+			},
+		},
+		"next": {
+			cursor: next,
+			expected: func(qb *db_repo.QueryBuilder) {
+				qb.Where("(((fieldA > ? OR fieldA IS NULL)) OR (fieldA = ? AND fieldB < ?) OR (fieldA = ? AND fieldB = ? AND tablename.id > ?))", "a", "a", int64(5), "a", int64(5), uint64(3))
+				qb.OrderBy("fieldA IS NULL", "ASC")
+				qb.OrderBy("fieldA", "ASC")
+				qb.OrderBy("fieldB IS NULL", "DESC")
+				qb.OrderBy("fieldB", "DESC")
+				qb.OrderBy("tablename.id", "ASC")
+			},
+		},
+		"next after null": {
+			cursor: nextNull,
+			expected: func(qb *db_repo.QueryBuilder) {
+				qb.Where("((fieldA IS NULL AND fieldB < ?) OR (fieldA IS NULL AND fieldB = ? AND tablename.id > ?))", int64(5), int64(5), uint64(3))
+				qb.OrderBy("fieldA IS NULL", "ASC")
+				qb.OrderBy("fieldA", "ASC")
+				qb.OrderBy("fieldB IS NULL", "DESC")
+				qb.OrderBy("fieldB", "DESC")
+				qb.OrderBy("tablename.id", "ASC")
+			},
+		},
+		"prev": {
+			cursor: prev,
+			expected: func(qb *db_repo.QueryBuilder) {
+				qb.Where("((fieldA < ?) OR (fieldA = ? AND (fieldB > ? OR fieldB IS NULL)) OR (fieldA = ? AND fieldB = ? AND tablename.id < ?))", "a", "a", int64(5), "a", int64(5), uint64(3))
+				qb.OrderBy("fieldA IS NULL", "DESC")
+				qb.OrderBy("fieldA", "DESC")
+				qb.OrderBy("fieldB IS NULL", "ASC")
+				qb.OrderBy("fieldB", "ASC")
+				qb.OrderBy("tablename.id", "DESC")
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			inp := &sql.Input{
+				Order: order,
+				Page: &sql.Page{
+					Limit:  10,
+					Cursor: &test.cursor,
+				},
+			}
+
+			qb, err := lqb.Build(inp)
+			assert.NoError(t, err)
+
+			expected := db_repo.NewQueryBuilder()
+			expected.Table("tablename")
+			expected.Where("", []any{}...)
+			expected.GroupBy("tablename.id")
+			test.expected(expected)
+			expected.Page(0, 10)
+
+			assert.Equal(t, expected, qb)
+		})
+	}
+}
+
+type cursorModel struct {
+	db_repo.Model
+	FieldA string
+	FieldB *int    `gorm:"column:field_b"`
+	FieldC *string `gorm:"not null"`
+	FieldD gosql.NullInt64
+}
+
+func TestListQueryBuilder_BuildCursorWithModel(t *testing.T) {
+	metadata := db_repo.Metadata{
+		TableName:  "tablename",
+		PrimaryKey: "tablename.id",
+		Mappings: db_repo.FieldMappings{
+			"id":     db_repo.NewFieldMapping("tablename.id"),
+			"fieldA": db_repo.NewFieldMapping("tablename.field_a"),
+			"fieldB": db_repo.NewFieldMapping("field_b"),
+			"fieldC": db_repo.NewFieldMapping("field_c"),
+			"fieldD": db_repo.NewFieldMapping("field_d"),
+			"other":  db_repo.NewFieldMapping("othertable.field_a"),
+		},
+	}
+
+	inp := &sql.Input{
+		Order: []sql.Order{
+			{Field: "fieldA", Direction: "ASC"},
+			{Field: "fieldB", Direction: "ASC"},
+			{Field: "fieldC", Direction: "ASC"},
+			{Field: "fieldD", Direction: "ASC"},
+			{Field: "other", Direction: "ASC"},
+		},
+		Page: &sql.Page{
+			Limit:  10,
+			Cursor: mdl.Box(""),
+		},
+	}
+
+	keys, err := sql.NewOrmQueryBuilder(metadata, sql.WithModel(&cursorModel{})).CursorKeys(inp)
+	assert.NoError(t, err)
+	assert.Equal(t, []sql.CursorKey{
+		{Field: "fieldA", Column: "tablename.field_a", Direction: sql.DirectionAsc},
+		{Field: "fieldB", Column: "field_b", Direction: sql.DirectionAsc, Nullable: true},
+		{Field: "fieldC", Column: "field_c", Direction: sql.DirectionAsc},
+		{Field: "fieldD", Column: "field_d", Direction: sql.DirectionAsc, Nullable: true},
+		{Field: "other", Column: "othertable.field_a", Direction: sql.DirectionAsc, Nullable: true},
+		{Field: "id", Column: "tablename.id", Direction: sql.DirectionAsc},
+	}, keys)
+
+	inp = &sql.Input{
+		Order: []sql.Order{
+			{Field: "fieldA", Direction: "ASC"},
+			{Field: "id", Direction: "ASC"},
+		},
+		Page: &sql.Page{
+			Limit:  10,
+			Cursor: mdl.Box(""),
+		},
+	}
+
+	keys, err = sql.NewOrmQueryBuilder(metadata, sql.WithModel(&cursorModel{})).CursorKeys(inp)
+	assert.NoError(t, err)
+
+	next, err := sql.EncodeCursor(sql.CursorNext, keys, []any{"a", uint(3)})
+	assert.NoError(t, err)
+	inp.Page.Cursor = &next
+
+	qb, err := sql.NewOrmQueryBuilder(metadata, sql.WithModel(&cursorModel{})).Build(inp)
+	assert.NoError(t, err)
+
+	// the column of a not null field is compared and ordered without checks for NULL, so an index can be used
+	expected := db_repo.NewQueryBuilder()
+	expected.Table("tablename")
+	expected.Where("", []any{}...)
+	expected.GroupBy("tablename.id")
+	expected.Where("((tablename.field_a > ?) OR (tablename.field_a = ? AND tablename.id > ?))", "a", "a", uint64(3))
+	expected.OrderBy("tablename.field_a", "ASC")
+	expected.OrderBy("tablename.id", "ASC")
+	expected.Page(0, 10)
+
+	assert.Equal(t, expected, qb)
+}
+
+func TestListQueryBuilder_BuildCursorInvalid(t *testing.T) {
+	metadata := db_repo.Metadata{
+		TableName:  "tablename",
+		PrimaryKey: "id",
+		Mappings: db_repo.FieldMappings{
+			"id":     db_repo.NewFieldMapping("id"),
+			"fieldA": db_repo.NewFieldMapping("fieldA"),
+		},
+	}
+
+	otherOrder, err := sql.EncodeCursor(sql.CursorNext, []sql.CursorKey{{Field: "id", Column: "id", Direction: sql.DirectionDesc}}, []any{1})
+	assert.NoError(t, err)
+
+	tests := map[string]struct {
+		page     sql.Page
+		expected string
+	}{
+		"offset": {
+			page:     sql.Page{Limit: 10, Offset: 10, Cursor: mdl.Box("")},
+			expected: "a page can not have an offset and a cursor at the same time",
+		},
+		"limit": {
+			page:     sql.Page{Cursor: mdl.Box("")},
+			expected: "a cursor page requires a positive limit",
+		},
+		"garbage": {
+			page:     sql.Page{Limit: 10, Cursor: mdl.Box("not a cursor")},
+			expected: "invalid cursor: illegal base64 data at input byte 3",
+		},
+		"other order": {
+			page:     sql.Page{Limit: 10, Cursor: &otherOrder},
+			expected: "invalid cursor: it was created for a different order",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			inp := &sql.Input{
+				Order: []sql.Order{{Field: "fieldA", Direction: "ASC"}},
+				Page:  &test.page,
+			}
+
+			_, err := sql.NewOrmQueryBuilder(metadata).Build(inp)
+			assert.EqualError(t, err, test.expected)
+		})
+	}
+}
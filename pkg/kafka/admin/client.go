@@ -11,8 +11,12 @@ import (
 
 //go:generate go run github.com/vektra/mockery/v2 --name Client
 type Client interface {
+	CommitAllOffsets(ctx context.Context, group string, os kadm.Offsets) error
 	CreateTopic(ctx context.Context, partitions int32, replicationFactor int16, configs map[string]*string, topic string) (kadm.CreateTopicResponse, error)
+	DescribeGroups(ctx context.Context, groups ...string) (kadm.DescribedGroups, error)
 	ListEndOffsets(ctx context.Context, topics ...string) (kadm.ListedOffsets, error)
+	ListOffsetsAfterMilli(ctx context.Context, millisecond int64, topics ...string) (kadm.ListedOffsets, error)
+	ListStartOffsets(ctx context.Context, topics ...string) (kadm.ListedOffsets, error)
 	ListTopics(ctx context.Context, topics ...string) (kadm.TopicDetails, error)
 }
 
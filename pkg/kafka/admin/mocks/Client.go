@@ -22,6 +22,54 @@ func (_m *Client) EXPECT() *Client_Expecter {
 	return &Client_Expecter{mock: &_m.Mock}
 }
 
+// CommitAllOffsets provides a mock function with given fields: ctx, group, os
+func (_m *Client) CommitAllOffsets(ctx context.Context, group string, os kadm.Offsets) error {
+	ret := _m.Called(ctx, group, os)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitAllOffsets")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, kadm.Offsets) error); ok {
+		r0 = rf(ctx, group, os)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Client_CommitAllOffsets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitAllOffsets'
+type Client_CommitAllOffsets_Call struct {
+	*mock.Call
+}
+
+// CommitAllOffsets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - group string
+//   - os kadm.Offsets
+func (_e *Client_Expecter) CommitAllOffsets(ctx interface{}, group interface{}, os interface{}) *Client_CommitAllOffsets_Call {
+	return &Client_CommitAllOffsets_Call{Call: _e.mock.On("CommitAllOffsets", ctx, group, os)}
+}
+
+func (_c *Client_CommitAllOffsets_Call) Run(run func(ctx context.Context, group string, os kadm.Offsets)) *Client_CommitAllOffsets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(kadm.Offsets))
+	})
+	return _c
+}
+
+func (_c *Client_CommitAllOffsets_Call) Return(_a0 error) *Client_CommitAllOffsets_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_CommitAllOffsets_Call) RunAndReturn(run func(context.Context, string, kadm.Offsets) error) *Client_CommitAllOffsets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTopic provides a mock function with given fields: ctx, partitions, replicationFactor, configs, topic
 func (_m *Client) CreateTopic(ctx context.Context, partitions int32, replicationFactor int16, configs map[string]*string, topic string) (kadm.CreateTopicResponse, error) {
 	ret := _m.Called(ctx, partitions, replicationFactor, configs, topic)
@@ -82,6 +130,79 @@ func (_c *Client_CreateTopic_Call) RunAndReturn(run func(context.Context, int32,
 	return _c
 }
 
+// DescribeGroups provides a mock function with given fields: ctx, groups
+func (_m *Client) DescribeGroups(ctx context.Context, groups ...string) (kadm.DescribedGroups, error) {
+	_va := make([]interface{}, len(groups))
+	for _i := range groups {
+		_va[_i] = groups[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DescribeGroups")
+	}
+
+	var r0 kadm.DescribedGroups
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) (kadm.DescribedGroups, error)); ok {
+		return rf(ctx, groups...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) kadm.DescribedGroups); ok {
+		r0 = rf(ctx, groups...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(kadm.DescribedGroups)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = rf(ctx, groups...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_DescribeGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeGroups'
+type Client_DescribeGroups_Call struct {
+	*mock.Call
+}
+
+// DescribeGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groups ...string
+func (_e *Client_Expecter) DescribeGroups(ctx interface{}, groups ...interface{}) *Client_DescribeGroups_Call {
+	return &Client_DescribeGroups_Call{Call: _e.mock.On("DescribeGroups",
+		append([]interface{}{ctx}, groups...)...)}
+}
+
+func (_c *Client_DescribeGroups_Call) Run(run func(ctx context.Context, groups ...string)) *Client_DescribeGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Client_DescribeGroups_Call) Return(_a0 kadm.DescribedGroups, _a1 error) *Client_DescribeGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_DescribeGroups_Call) RunAndReturn(run func(context.Context, ...string) (kadm.DescribedGroups, error)) *Client_DescribeGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListEndOffsets provides a mock function with given fields: ctx, topics
 func (_m *Client) ListEndOffsets(ctx context.Context, topics ...string) (kadm.ListedOffsets, error) {
 	_va := make([]interface{}, len(topics))
@@ -155,6 +276,153 @@ func (_c *Client_ListEndOffsets_Call) RunAndReturn(run func(context.Context, ...
 	return _c
 }
 
+// ListOffsetsAfterMilli provides a mock function with given fields: ctx, millisecond, topics
+func (_m *Client) ListOffsetsAfterMilli(ctx context.Context, millisecond int64, topics ...string) (kadm.ListedOffsets, error) {
+	_va := make([]interface{}, len(topics))
+	for _i := range topics {
+		_va[_i] = topics[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, millisecond)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOffsetsAfterMilli")
+	}
+
+	var r0 kadm.ListedOffsets
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, ...string) (kadm.ListedOffsets, error)); ok {
+		return rf(ctx, millisecond, topics...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, ...string) kadm.ListedOffsets); ok {
+		r0 = rf(ctx, millisecond, topics...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(kadm.ListedOffsets)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, ...string) error); ok {
+		r1 = rf(ctx, millisecond, topics...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_ListOffsetsAfterMilli_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOffsetsAfterMilli'
+type Client_ListOffsetsAfterMilli_Call struct {
+	*mock.Call
+}
+
+// ListOffsetsAfterMilli is a helper method to define mock.On call
+//   - ctx context.Context
+//   - millisecond int64
+//   - topics ...string
+func (_e *Client_Expecter) ListOffsetsAfterMilli(ctx interface{}, millisecond interface{}, topics ...interface{}) *Client_ListOffsetsAfterMilli_Call {
+	return &Client_ListOffsetsAfterMilli_Call{Call: _e.mock.On("ListOffsetsAfterMilli",
+		append([]interface{}{ctx, millisecond}, topics...)...)}
+}
+
+func (_c *Client_ListOffsetsAfterMilli_Call) Run(run func(ctx context.Context, millisecond int64, topics ...string)) *Client_ListOffsetsAfterMilli_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), args[1].(int64), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Client_ListOffsetsAfterMilli_Call) Return(_a0 kadm.ListedOffsets, _a1 error) *Client_ListOffsetsAfterMilli_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_ListOffsetsAfterMilli_Call) RunAndReturn(run func(context.Context, int64, ...string) (kadm.ListedOffsets, error)) *Client_ListOffsetsAfterMilli_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStartOffsets provides a mock function with given fields: ctx, topics
+func (_m *Client) ListStartOffsets(ctx context.Context, topics ...string) (kadm.ListedOffsets, error) {
+	_va := make([]interface{}, len(topics))
+	for _i := range topics {
+		_va[_i] = topics[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStartOffsets")
+	}
+
+	var r0 kadm.ListedOffsets
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) (kadm.ListedOffsets, error)); ok {
+		return rf(ctx, topics...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) kadm.ListedOffsets); ok {
+		r0 = rf(ctx, topics...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(kadm.ListedOffsets)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = rf(ctx, topics...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_ListStartOffsets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStartOffsets'
+type Client_ListStartOffsets_Call struct {
+	*mock.Call
+}
+
+// ListStartOffsets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - topics ...string
+func (_e *Client_Expecter) ListStartOffsets(ctx interface{}, topics ...interface{}) *Client_ListStartOffsets_Call {
+	return &Client_ListStartOffsets_Call{Call: _e.mock.On("ListStartOffsets",
+		append([]interface{}{ctx}, topics...)...)}
+}
+
+func (_c *Client_ListStartOffsets_Call) Run(run func(ctx context.Context, topics ...string)) *Client_ListStartOffsets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Client_ListStartOffsets_Call) Return(_a0 kadm.ListedOffsets, _a1 error) *Client_ListStartOffsets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_ListStartOffsets_Call) RunAndReturn(run func(context.Context, ...string) (kadm.ListedOffsets, error)) *Client_ListStartOffsets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListTopics provides a mock function with given fields: ctx, topics
 func (_m *Client) ListTopics(ctx context.Context, topics ...string) (kadm.TopicDetails, error) {
 	_va := make([]interface{}, len(topics))
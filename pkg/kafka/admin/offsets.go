@@ -0,0 +1,119 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+)
+
+const (
+	groupStateDead  = "Dead"
+	groupStateEmpty = "Empty"
+)
+
+// ResetOffsetsToEarliest makes the consumer group start again at the first retained record of every partition of the
+// topic. Like all offset resets, it requires the consumer group to have no active members.
+func (s *Service) ResetOffsetsToEarliest(ctx context.Context, group string, topic string) (map[int32]int64, error) {
+	return s.resetOffsets(ctx, group, topic, "earliest", func(ctx context.Context) (kadm.ListedOffsets, error) {
+		return s.client.ListStartOffsets(ctx, topic)
+	})
+}
+
+// ResetOffsetsToLatest makes the consumer group skip all records currently in the topic.
+func (s *Service) ResetOffsetsToLatest(ctx context.Context, group string, topic string) (map[int32]int64, error) {
+	return s.resetOffsets(ctx, group, topic, "latest", func(ctx context.Context) (kadm.ListedOffsets, error) {
+		return s.client.ListEndOffsets(ctx, topic)
+	})
+}
+
+// ResetOffsetsToTime makes the consumer group continue at the first record of every partition which was written at or
+// after the given time. Partitions without such a record are reset to their end.
+func (s *Service) ResetOffsetsToTime(ctx context.Context, group string, topic string, t time.Time) (map[int32]int64, error) {
+	return s.resetOffsets(ctx, group, topic, fmt.Sprintf("time %s", t.Format(time.RFC3339)), func(ctx context.Context) (kadm.ListedOffsets, error) {
+		return s.client.ListOffsetsAfterMilli(ctx, t.UnixMilli(), topic)
+	})
+}
+
+// ResetOffsets sets the offsets of the given partitions of the topic for the consumer group. Partitions which are not
+// part of offsets keep their committed offset.
+func (s *Service) ResetOffsets(ctx context.Context, group string, topic string, offsets map[int32]int64) (map[int32]int64, error) {
+	if err := s.checkGroupInactive(ctx, group); err != nil {
+		return nil, err
+	}
+
+	commit := make(kadm.Offsets)
+	for partition, offset := range offsets {
+		commit.Add(kadm.Offset{
+			Topic:       topic,
+			Partition:   partition,
+			At:          offset,
+			LeaderEpoch: -1,
+		})
+	}
+
+	return s.commitOffsets(ctx, group, topic, "explicit offsets", commit)
+}
+
+func (s *Service) resetOffsets(
+	ctx context.Context,
+	group string,
+	topic string,
+	target string,
+	list func(ctx context.Context) (kadm.ListedOffsets, error),
+) (map[int32]int64, error) {
+	if err := s.checkGroupInactive(ctx, group); err != nil {
+		return nil, err
+	}
+
+	listed, err := list(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list offsets of topic %s: %w", topic, err)
+	}
+
+	if err = listed.Error(); err != nil {
+		return nil, fmt.Errorf("failed to list offsets of topic %s: %w", topic, err)
+	}
+
+	return s.commitOffsets(ctx, group, topic, target, listed.Offsets())
+}
+
+func (s *Service) commitOffsets(ctx context.Context, group string, topic string, target string, offsets kadm.Offsets) (map[int32]int64, error) {
+	if err := s.client.CommitAllOffsets(ctx, group, offsets); err != nil {
+		return nil, fmt.Errorf("failed to commit offsets of topic %s for consumer group %s: %w", topic, group, err)
+	}
+
+	committed := make(map[int32]int64)
+	offsets.Each(func(offset kadm.Offset) {
+		committed[offset.Partition] = offset.At
+	})
+
+	s.logger.Info(ctx, "reset offsets of topic %s for consumer group %s to %s: %v", topic, group, target, committed)
+
+	return committed, nil
+}
+
+// checkGroupInactive makes sure no member of the group is consuming, as kafka rejects offset commits for an active
+// group and a running consumer would overwrite the offsets with its next commit anyway.
+func (s *Service) checkGroupInactive(ctx context.Context, group string) error {
+	groups, err := s.client.DescribeGroups(ctx, group)
+	if err != nil {
+		return fmt.Errorf("failed to describe consumer group %s: %w", group, err)
+	}
+
+	described, ok := groups[group]
+	if !ok {
+		return nil
+	}
+
+	if described.Err != nil {
+		return fmt.Errorf("failed to describe consumer group %s: %w", group, described.Err)
+	}
+
+	if described.State != groupStateEmpty && described.State != groupStateDead {
+		return fmt.Errorf("consumer group %s is in state %s with %d member(s), stop all consumers before resetting its offsets", group, described.State, len(described.Members))
+	}
+
+	return nil
+}
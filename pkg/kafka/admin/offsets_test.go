@@ -0,0 +1,90 @@
+package admin_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/kafka/admin"
+	"github.com/justtrackio/gosoline/pkg/kafka/admin/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/twmb/franz-go/pkg/kadm"
+)
+
+type OffsetsTestSuite struct {
+	suite.Suite
+
+	client  *mocks.Client
+	service *admin.Service
+}
+
+func TestOffsetsTestSuite(t *testing.T) {
+	suite.Run(t, new(OffsetsTestSuite))
+}
+
+func (s *OffsetsTestSuite) SetupTest() {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+
+	s.client = mocks.NewClient(s.T())
+	s.service = admin.NewServiceWithInterfaces(logger, s.client)
+}
+
+func (s *OffsetsTestSuite) TestResetOffsetsToEarliest() {
+	s.expectGroupState("Empty")
+
+	listed := kadm.ListedOffsets{
+		"topic": {
+			0: {Topic: "topic", Partition: 0, Offset: 3, LeaderEpoch: -1},
+			1: {Topic: "topic", Partition: 1, Offset: 5, LeaderEpoch: -1},
+		},
+	}
+	s.client.EXPECT().ListStartOffsets(mock.Anything, "topic").Return(listed, nil).Once()
+	s.client.EXPECT().CommitAllOffsets(mock.Anything, "group", listed.Offsets()).Return(nil).Once()
+
+	committed, err := s.service.ResetOffsetsToEarliest(s.T().Context(), "group", "topic")
+	s.NoError(err)
+	s.Equal(map[int32]int64{0: 3, 1: 5}, committed)
+}
+
+func (s *OffsetsTestSuite) TestResetOffsetsToTime() {
+	at := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.expectGroupState("Dead")
+
+	listed := kadm.ListedOffsets{
+		"topic": {
+			0: {Topic: "topic", Partition: 0, Offset: 10, LeaderEpoch: -1},
+		},
+	}
+	s.client.EXPECT().ListOffsetsAfterMilli(mock.Anything, at.UnixMilli(), "topic").Return(listed, nil).Once()
+	s.client.EXPECT().CommitAllOffsets(mock.Anything, "group", listed.Offsets()).Return(nil).Once()
+
+	committed, err := s.service.ResetOffsetsToTime(s.T().Context(), "group", "topic", at)
+	s.NoError(err)
+	s.Equal(map[int32]int64{0: 10}, committed)
+}
+
+func (s *OffsetsTestSuite) TestResetOffsets() {
+	s.expectGroupState("Empty")
+
+	expected := make(kadm.Offsets)
+	expected.Add(kadm.Offset{Topic: "topic", Partition: 2, At: 42, LeaderEpoch: -1})
+	s.client.EXPECT().CommitAllOffsets(mock.Anything, "group", expected).Return(nil).Once()
+
+	committed, err := s.service.ResetOffsets(s.T().Context(), "group", "topic", map[int32]int64{2: 42})
+	s.NoError(err)
+	s.Equal(map[int32]int64{2: 42}, committed)
+}
+
+func (s *OffsetsTestSuite) TestResetOffsetsOfActiveGroup() {
+	s.expectGroupState("Stable")
+
+	_, err := s.service.ResetOffsetsToLatest(s.T().Context(), "group", "topic")
+	s.EqualError(err, "consumer group group is in state Stable with 0 member(s), stop all consumers before resetting its offsets")
+}
+
+func (s *OffsetsTestSuite) expectGroupState(state string) {
+	s.client.EXPECT().DescribeGroups(mock.Anything, "group").Return(kadm.DescribedGroups{
+		"group": {Group: "group", State: state},
+	}, nil).Once()
+}
@@ -18,10 +18,14 @@ func NewService(ctx context.Context, logger log.Logger, brokers []string) (*Serv
 		return nil, fmt.Errorf("failed to create kafka admin client: %w", err)
 	}
 
+	return NewServiceWithInterfaces(logger, client), nil
+}
+
+func NewServiceWithInterfaces(logger log.Logger, client Client) *Service {
 	return &Service{
 		logger: logger,
 		client: client,
-	}, nil
+	}
 }
 
 func (s *Service) CreateTopic(ctx context.Context, topic string) error {
@@ -0,0 +1,283 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	kgo "github.com/twmb/franz-go/pkg/kgo"
+)
+
+// TransactSession is an autogenerated mock type for the TransactSession type
+type TransactSession struct {
+	mock.Mock
+}
+
+type TransactSession_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TransactSession) EXPECT() *TransactSession_Expecter {
+	return &TransactSession_Expecter{mock: &_m.Mock}
+}
+
+// Begin provides a mock function with no fields
+func (_m *TransactSession) Begin() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Begin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactSession_Begin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Begin'
+type TransactSession_Begin_Call struct {
+	*mock.Call
+}
+
+// Begin is a helper method to define mock.On call
+func (_e *TransactSession_Expecter) Begin() *TransactSession_Begin_Call {
+	return &TransactSession_Begin_Call{Call: _e.mock.On("Begin")}
+}
+
+func (_c *TransactSession_Begin_Call) Run(run func()) *TransactSession_Begin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TransactSession_Begin_Call) Return(_a0 error) *TransactSession_Begin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactSession_Begin_Call) RunAndReturn(run func() error) *TransactSession_Begin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Close provides a mock function with no fields
+func (_m *TransactSession) Close() {
+	_m.Called()
+}
+
+// TransactSession_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type TransactSession_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *TransactSession_Expecter) Close() *TransactSession_Close_Call {
+	return &TransactSession_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *TransactSession_Close_Call) Run(run func()) *TransactSession_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TransactSession_Close_Call) Return() *TransactSession_Close_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TransactSession_Close_Call) RunAndReturn(run func()) *TransactSession_Close_Call {
+	_c.Run(run)
+	return _c
+}
+
+// End provides a mock function with given fields: ctx, commit
+func (_m *TransactSession) End(ctx context.Context, commit kgo.TransactionEndTry) (bool, error) {
+	ret := _m.Called(ctx, commit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for End")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, kgo.TransactionEndTry) (bool, error)); ok {
+		return rf(ctx, commit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, kgo.TransactionEndTry) bool); ok {
+		r0 = rf(ctx, commit)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, kgo.TransactionEndTry) error); ok {
+		r1 = rf(ctx, commit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactSession_End_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'End'
+type TransactSession_End_Call struct {
+	*mock.Call
+}
+
+// End is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commit kgo.TransactionEndTry
+func (_e *TransactSession_Expecter) End(ctx interface{}, commit interface{}) *TransactSession_End_Call {
+	return &TransactSession_End_Call{Call: _e.mock.On("End", ctx, commit)}
+}
+
+func (_c *TransactSession_End_Call) Run(run func(ctx context.Context, commit kgo.TransactionEndTry)) *TransactSession_End_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(kgo.TransactionEndTry))
+	})
+	return _c
+}
+
+func (_c *TransactSession_End_Call) Return(committed bool, err error) *TransactSession_End_Call {
+	_c.Call.Return(committed, err)
+	return _c
+}
+
+func (_c *TransactSession_End_Call) RunAndReturn(run func(context.Context, kgo.TransactionEndTry) (bool, error)) *TransactSession_End_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PollRecords provides a mock function with given fields: ctx, maxPollRecords
+func (_m *TransactSession) PollRecords(ctx context.Context, maxPollRecords int) kgo.Fetches {
+	ret := _m.Called(ctx, maxPollRecords)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PollRecords")
+	}
+
+	var r0 kgo.Fetches
+	if rf, ok := ret.Get(0).(func(context.Context, int) kgo.Fetches); ok {
+		r0 = rf(ctx, maxPollRecords)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(kgo.Fetches)
+		}
+	}
+
+	return r0
+}
+
+// TransactSession_PollRecords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PollRecords'
+type TransactSession_PollRecords_Call struct {
+	*mock.Call
+}
+
+// PollRecords is a helper method to define mock.On call
+//   - ctx context.Context
+//   - maxPollRecords int
+func (_e *TransactSession_Expecter) PollRecords(ctx interface{}, maxPollRecords interface{}) *TransactSession_PollRecords_Call {
+	return &TransactSession_PollRecords_Call{Call: _e.mock.On("PollRecords", ctx, maxPollRecords)}
+}
+
+func (_c *TransactSession_PollRecords_Call) Run(run func(ctx context.Context, maxPollRecords int)) *TransactSession_PollRecords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *TransactSession_PollRecords_Call) Return(_a0 kgo.Fetches) *TransactSession_PollRecords_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactSession_PollRecords_Call) RunAndReturn(run func(context.Context, int) kgo.Fetches) *TransactSession_PollRecords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProduceSync provides a mock function with given fields: ctx, rs
+func (_m *TransactSession) ProduceSync(ctx context.Context, rs ...*kgo.Record) kgo.ProduceResults {
+	_va := make([]interface{}, len(rs))
+	for _i := range rs {
+		_va[_i] = rs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceSync")
+	}
+
+	var r0 kgo.ProduceResults
+	if rf, ok := ret.Get(0).(func(context.Context, ...*kgo.Record) kgo.ProduceResults); ok {
+		r0 = rf(ctx, rs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(kgo.ProduceResults)
+		}
+	}
+
+	return r0
+}
+
+// TransactSession_ProduceSync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProduceSync'
+type TransactSession_ProduceSync_Call struct {
+	*mock.Call
+}
+
+// ProduceSync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rs ...*kgo.Record
+func (_e *TransactSession_Expecter) ProduceSync(ctx interface{}, rs ...interface{}) *TransactSession_ProduceSync_Call {
+	return &TransactSession_ProduceSync_Call{Call: _e.mock.On("ProduceSync",
+		append([]interface{}{ctx}, rs...)...)}
+}
+
+func (_c *TransactSession_ProduceSync_Call) Run(run func(ctx context.Context, rs ...*kgo.Record)) *TransactSession_ProduceSync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]*kgo.Record, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(*kgo.Record)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TransactSession_ProduceSync_Call) Return(_a0 kgo.ProduceResults) *TransactSession_ProduceSync_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactSession_ProduceSync_Call) RunAndReturn(run func(context.Context, ...*kgo.Record) kgo.ProduceResults) *TransactSession_ProduceSync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTransactSession creates a new instance of TransactSession. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransactSession(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TransactSession {
+	mock := &TransactSession{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -75,6 +75,15 @@ func (p *PartitionManager) OnPartitionsAssigned(ctx context.Context, client *kgo
 				continue
 			}
 
+			// with cooperative rebalancing, partitions we keep are not revoked and assigned again. Should a partition be
+			// assigned which we are consuming already, we keep the running consumer instead of consuming it twice.
+			if _, ok := p.consumers[assignment{topic, partition}]; ok {
+				p.lck.Unlock()
+				p.logger.Debug(ctx, "already consuming records for partition %d of topic %s", partition, topic)
+
+				continue
+			}
+
 			partitionConsumer := NewPartitionConsumer(p.logger, p.clock, p.metricWriter, p.messageHandler, client, p.name, topic, partition)
 
 			p.consumers[assignment{topic, partition}] = partitionConsumer
@@ -33,3 +33,32 @@ func TestPartitionManagerIgnoresAssignmentsAfterStop(t *testing.T) {
 
 	assert.Empty(t, manager.consumers)
 }
+
+func TestPartitionManagerKeepsConsumerOfAlreadyAssignedPartition(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	messageHandler := kafkaConsumerMocks.NewKafkaMessageHandler(t)
+	metricWriter := metricMocks.NewWriter(t)
+
+	messageHandler.EXPECT().Stop().Once()
+
+	manager := NewPartitionManager(logger, clock.NewFakeClock(), metricWriter, messageHandler, "test-consumer")
+
+	manager.OnPartitionsAssigned(context.Background(), nil, map[string][]int32{
+		"topic": {1},
+	})
+
+	manager.lck.RLock()
+	partitionConsumer := manager.consumers[assignment{"topic", 1}]
+	manager.lck.RUnlock()
+
+	manager.OnPartitionsAssigned(context.Background(), nil, map[string][]int32{
+		"topic": {1, 2},
+	})
+
+	manager.lck.RLock()
+	assert.Len(t, manager.consumers, 2)
+	assert.Same(t, partitionConsumer, manager.consumers[assignment{"topic", 1}])
+	manager.lck.RUnlock()
+
+	manager.Stop(context.Background())
+}
@@ -69,6 +69,9 @@ func (s *Settings) GetFetchIsolationLevel() kgo.IsolationLevel {
 	return kgo.ReadUncommitted()
 }
 
+// GetBalancers returns the group balancers in order of preference. With only cooperative balancers (the default),
+// partitions are rebalanced incrementally and only the moved partitions are revoked, so a deployment doesn't pause the
+// consumption of all partitions. Adding an eager balancer makes the whole group fall back to eager rebalancing.
 func (s *Settings) GetBalancers() []kgo.GroupBalancer {
 	var balancers []kgo.GroupBalancer
 	for _, b := range s.Balancers {
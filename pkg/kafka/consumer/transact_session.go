@@ -0,0 +1,95 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/kafka"
+	"github.com/justtrackio/gosoline/pkg/kafka/connection"
+	"github.com/justtrackio/gosoline/pkg/kafka/logging"
+	kafkaProducer "github.com/justtrackio/gosoline/pkg/kafka/producer"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// TransactSession consumes records in a consumer group and produces records with the same client. Ending a
+// transaction commits the produced records and the offsets of the polled records atomically. If the group rebalanced
+// while the transaction was running, End aborts it and the session rewinds to the last committed offsets.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name TransactSession
+type TransactSession interface {
+	PollRecords(ctx context.Context, maxPollRecords int) kgo.Fetches
+	Begin() error
+	ProduceSync(ctx context.Context, rs ...*kgo.Record) kgo.ProduceResults
+	End(ctx context.Context, commit kgo.TransactionEndTry) (committed bool, err error)
+	Close()
+}
+
+// NewTransactSession creates a session consuming the topic of the consumer settings and producing to the topic of the
+// producer settings. The producer settings have to contain a transactional id.
+func NewTransactSession(ctx context.Context, config cfg.Config, logger log.Logger, settings Settings, producerSettings *kafkaProducer.Settings, name string) (TransactSession, error) {
+	if !producerSettings.IsTransactional() {
+		return nil, fmt.Errorf("the transact session %s requires a transactional id", name)
+	}
+
+	inputTopic, err := kafka.BuildFullTopicName(config, settings.ToIdentity(), settings.TopicId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build full kafka topic name: %w", err)
+	}
+
+	outputTopic, err := kafka.BuildFullTopicName(config, producerSettings.ToIdentity(), producerSettings.TopicId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build full topic name for topic id %q: %w", producerSettings.TopicId, err)
+	}
+
+	consumerGroupId, err := kafka.BuildFullConsumerGroupId(config, settings.GroupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build full kafka consumer group id: %w", err)
+	}
+
+	metricsHook := kafka.NewMetricsHook(metric.NewWriter(), kafka.DimensionConsumer, name)
+
+	opts := []kgo.Opt{
+		kgo.ConsumeResetOffset(settings.GetStartOffset()),
+		kgo.ConsumeStartOffset(settings.GetStartOffset()),
+		kgo.ConsumeTopics(inputTopic),
+		kgo.Balancers(settings.GetBalancers()...),
+		kgo.ConsumerGroup(consumerGroupId),
+		// records of aborted transactions must not be transformed again
+		kgo.FetchIsolationLevel(kgo.ReadCommitted()),
+		// don't start consuming a partition while a transaction committing its offsets is still pending
+		kgo.RequireStableFetchOffsets(),
+		kgo.HeartbeatInterval(settings.HeartbeatInterval),
+		kgo.RebalanceTimeout(settings.RebalanceTimeout),
+		kgo.SessionTimeout(settings.SessionTimeout),
+		kgo.DefaultProduceTopic(outputTopic),
+		kgo.ProducerBatchMaxBytes(producerSettings.MaxBatchBytes),
+		kgo.MaxBufferedRecords(producerSettings.MaxBatchSize),
+		kgo.ProducerLinger(producerSettings.LingerTimeout),
+		kgo.ProduceRequestTimeout(producerSettings.RequestTimeout),
+		kgo.ProducerBatchCompression(producerSettings.GetKafkaCompressor()),
+		kgo.TransactionalID(producerSettings.TransactionalId),
+		kgo.WithContext(ctx),
+		kgo.WithHooks(metricsHook),
+		kgo.WithLogger(logging.NewKafkaLogger(ctx, logger)),
+	}
+
+	if producerSettings.TransactionTimeout > 0 {
+		opts = append(opts, kgo.TransactionTimeout(producerSettings.TransactionTimeout))
+	}
+
+	connOpts, err := connection.BuildConnectionOptions(config, settings.Connection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build connection options: %w", err)
+	}
+	opts = append(opts, connOpts...)
+
+	session, err := kgo.NewGroupTransactSession(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("can not create franz-go transact session: %w", err)
+	}
+
+	return session, nil
+}
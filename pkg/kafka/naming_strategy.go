@@ -12,6 +12,15 @@ type KafkaNamingSettings struct {
 	TopicDelimiter string `cfg:"topic_delimiter" default:"-"`
 	GroupPattern   string `cfg:"group_pattern,nodecode" default:"{app.namespace}-{app.name}-{groupId}"`
 	GroupDelimiter string `cfg:"group_delimiter" default:"-"`
+	// TransactionalIdPattern has to result in an id which is unique per running instance of a producer but stays the same
+	// across restarts, otherwise kafka can't fence a zombie instance and a new instance has to wait for the transactions
+	// of its predecessor to time out.
+	TransactionalIdPattern   string `cfg:"transactional_id_pattern,nodecode" default:"{app.namespace}-{app.name}-{transactionalId}-{instance}"`
+	TransactionalIdDelimiter string `cfg:"transactional_id_delimiter" default:"-"`
+	// Instance identifies the running instance of the application for transactional ids. It has to be configured
+	// explicitly and has to survive restarts, like the ordinal of a stateful set pod. A random id like the hostname of a
+	// deployment pod would keep zombie instances from being fenced.
+	Instance string `cfg:"instance"`
 }
 
 func NormalizeKafkaName(name string) string {
@@ -58,3 +67,29 @@ func BuildFullConsumerGroupId(config cfg.Config, groupId string) (string, error)
 
 	return NormalizeKafkaName(name), nil
 }
+
+func BuildFullTransactionalId(config cfg.Config, transactionalId string) (string, error) {
+	identity, err := cfg.GetAppIdentity(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to get app identity from config: %w", err)
+	}
+
+	namingSettings := &KafkaNamingSettings{}
+	if err := config.UnmarshalKey("kafka.naming", namingSettings); err != nil {
+		return "", fmt.Errorf("failed to unmarshal kafka naming settings for key 'kafka.naming' to build kafka transactional id: %w", err)
+	}
+
+	if namingSettings.Instance == "" {
+		return "", fmt.Errorf("the setting kafka.naming.instance is required to build a stable kafka transactional id")
+	}
+
+	name, err := identity.Format(namingSettings.TransactionalIdPattern, namingSettings.TransactionalIdDelimiter, map[string]string{
+		"transactionalId": transactionalId,
+		"instance":        namingSettings.Instance,
+	})
+	if err != nil {
+		return "", fmt.Errorf("kafka transactional id naming failed: %w", err)
+	}
+
+	return NormalizeKafkaName(name), nil
+}
@@ -84,6 +84,23 @@ func (s *KafkaNamingTestSuite) TestGroupIdWithPattern() {
 	s.Equal("appname-c-group-1", group)
 }
 
+func (s *KafkaNamingTestSuite) TestTransactionalIdWithInstance() {
+	s.setupConfig(map[string]any{
+		"kafka.naming.instance": "pod-0",
+	})
+
+	transactionalId, err := kafka.BuildFullTransactionalId(s.config, "my_output")
+	s.NoError(err, "there should be no error")
+	s.Equal("project-env-family-group-appname-my-output-pod-0", transactionalId)
+}
+
+func (s *KafkaNamingTestSuite) TestTransactionalIdWithoutInstanceReturnsError() {
+	s.setupConfig(map[string]any{})
+
+	_, err := kafka.BuildFullTransactionalId(s.config, "my_output")
+	s.EqualError(err, "the setting kafka.naming.instance is required to build a stable kafka transactional id")
+}
+
 func (s *KafkaNamingTestSuite) TestUnknownPlaceholderReturnsError() {
 	s.setupConfig(map[string]any{
 		"kafka.naming.topic_pattern": "{project}-{topicId}",
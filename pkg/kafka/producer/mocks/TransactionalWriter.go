@@ -0,0 +1,192 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	kgo "github.com/twmb/franz-go/pkg/kgo"
+)
+
+// TransactionalWriter is an autogenerated mock type for the TransactionalWriter type
+type TransactionalWriter struct {
+	mock.Mock
+}
+
+type TransactionalWriter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TransactionalWriter) EXPECT() *TransactionalWriter_Expecter {
+	return &TransactionalWriter_Expecter{mock: &_m.Mock}
+}
+
+// BeginTransaction provides a mock function with no fields
+func (_m *TransactionalWriter) BeginTransaction() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactionalWriter_BeginTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginTransaction'
+type TransactionalWriter_BeginTransaction_Call struct {
+	*mock.Call
+}
+
+// BeginTransaction is a helper method to define mock.On call
+func (_e *TransactionalWriter_Expecter) BeginTransaction() *TransactionalWriter_BeginTransaction_Call {
+	return &TransactionalWriter_BeginTransaction_Call{Call: _e.mock.On("BeginTransaction")}
+}
+
+func (_c *TransactionalWriter_BeginTransaction_Call) Run(run func()) *TransactionalWriter_BeginTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TransactionalWriter_BeginTransaction_Call) Return(_a0 error) *TransactionalWriter_BeginTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionalWriter_BeginTransaction_Call) RunAndReturn(run func() error) *TransactionalWriter_BeginTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EndTransaction provides a mock function with given fields: ctx, commit
+func (_m *TransactionalWriter) EndTransaction(ctx context.Context, commit kgo.TransactionEndTry) error {
+	ret := _m.Called(ctx, commit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EndTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, kgo.TransactionEndTry) error); ok {
+		r0 = rf(ctx, commit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactionalWriter_EndTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EndTransaction'
+type TransactionalWriter_EndTransaction_Call struct {
+	*mock.Call
+}
+
+// EndTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - commit kgo.TransactionEndTry
+func (_e *TransactionalWriter_Expecter) EndTransaction(ctx interface{}, commit interface{}) *TransactionalWriter_EndTransaction_Call {
+	return &TransactionalWriter_EndTransaction_Call{Call: _e.mock.On("EndTransaction", ctx, commit)}
+}
+
+func (_c *TransactionalWriter_EndTransaction_Call) Run(run func(ctx context.Context, commit kgo.TransactionEndTry)) *TransactionalWriter_EndTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(kgo.TransactionEndTry))
+	})
+	return _c
+}
+
+func (_c *TransactionalWriter_EndTransaction_Call) Return(_a0 error) *TransactionalWriter_EndTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionalWriter_EndTransaction_Call) RunAndReturn(run func(context.Context, kgo.TransactionEndTry) error) *TransactionalWriter_EndTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProduceSync provides a mock function with given fields: ctx, rs
+func (_m *TransactionalWriter) ProduceSync(ctx context.Context, rs ...*kgo.Record) kgo.ProduceResults {
+	_va := make([]interface{}, len(rs))
+	for _i := range rs {
+		_va[_i] = rs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProduceSync")
+	}
+
+	var r0 kgo.ProduceResults
+	if rf, ok := ret.Get(0).(func(context.Context, ...*kgo.Record) kgo.ProduceResults); ok {
+		r0 = rf(ctx, rs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(kgo.ProduceResults)
+		}
+	}
+
+	return r0
+}
+
+// TransactionalWriter_ProduceSync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProduceSync'
+type TransactionalWriter_ProduceSync_Call struct {
+	*mock.Call
+}
+
+// ProduceSync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rs ...*kgo.Record
+func (_e *TransactionalWriter_Expecter) ProduceSync(ctx interface{}, rs ...interface{}) *TransactionalWriter_ProduceSync_Call {
+	return &TransactionalWriter_ProduceSync_Call{Call: _e.mock.On("ProduceSync",
+		append([]interface{}{ctx}, rs...)...)}
+}
+
+func (_c *TransactionalWriter_ProduceSync_Call) Run(run func(ctx context.Context, rs ...*kgo.Record)) *TransactionalWriter_ProduceSync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]*kgo.Record, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(*kgo.Record)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *TransactionalWriter_ProduceSync_Call) Return(_a0 kgo.ProduceResults) *TransactionalWriter_ProduceSync_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionalWriter_ProduceSync_Call) RunAndReturn(run func(context.Context, ...*kgo.Record) kgo.ProduceResults) *TransactionalWriter_ProduceSync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTransactionalWriter creates a new instance of TransactionalWriter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransactionalWriter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TransactionalWriter {
+	mock := &TransactionalWriter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
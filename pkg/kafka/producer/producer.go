@@ -57,7 +57,18 @@ func NewProducer(ctx context.Context, config cfg.Config, logger log.Logger, sett
 	defaults := getProducerDefaultMetrics(name, fullTopicName)
 	metricWriter := metric.NewWriter(defaults...)
 
-	return NewProducerWithInterfaces(writer, metricWriter, name, fullTopicName), nil
+	producer := NewProducerWithInterfaces(writer, metricWriter, name, fullTopicName)
+
+	if !settings.IsTransactional() {
+		return producer, nil
+	}
+
+	transactionalWriter, ok := writer.(TransactionalWriter)
+	if !ok {
+		return nil, fmt.Errorf("the kafka writer of type %T does not support transactions", writer)
+	}
+
+	return NewTransactionalProducerWithInterfaces(producer, transactionalWriter, settings.TransactionalId), nil
 }
 
 func NewProducerWithInterfaces(writer Writer, metricWriter metric.Writer, name, topicName string) Producer {
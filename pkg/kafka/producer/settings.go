@@ -26,6 +26,13 @@ type Settings struct {
 	MaxBatchSize   int
 	LingerTimeout  time.Duration
 	RequestTimeout time.Duration
+	// TransactionalId enables transactions if set, every call to ProduceSync is then atomic.
+	TransactionalId    string
+	TransactionTimeout time.Duration
+}
+
+func (s Settings) IsTransactional() bool {
+	return s.TransactionalId != ""
 }
 
 func (s Settings) GetKafkaCompressor() kgo.CompressionCodec {
@@ -0,0 +1,63 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// transactionalProducer produces every batch of records in its own transaction, so either all records of a call to
+// ProduceSync are visible to read committed consumers or none of them.
+type transactionalProducer struct {
+	Producer
+
+	lck             sync.Mutex
+	writer          TransactionalWriter
+	transactionalId string
+}
+
+func NewTransactionalProducerWithInterfaces(producer Producer, writer TransactionalWriter, transactionalId string) Producer {
+	return &transactionalProducer{
+		Producer:        producer,
+		writer:          writer,
+		transactionalId: transactionalId,
+	}
+}
+
+func (p *transactionalProducer) ProduceSync(ctx context.Context, records ...*kgo.Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	// a client can only run one transaction at a time
+	p.lck.Lock()
+	defer p.lck.Unlock()
+
+	if err := p.writer.BeginTransaction(); err != nil {
+		return fmt.Errorf("failed to begin transaction %s: %w", p.transactionalId, err)
+	}
+
+	if err := p.Producer.ProduceSync(ctx, records...); err != nil {
+		return p.abort(ctx, fmt.Errorf("failed to produce records in transaction %s: %w", p.transactionalId, err))
+	}
+
+	if err := p.writer.EndTransaction(ctx, kgo.TryCommit); err != nil {
+		return fmt.Errorf("failed to commit transaction %s: %w", p.transactionalId, err)
+	}
+
+	return nil
+}
+
+func (p *transactionalProducer) abort(ctx context.Context, reason error) error {
+	// the context might be canceled already, but we still want to abort the transaction instead of waiting for the timeout
+	ctx = context.WithoutCancel(ctx)
+
+	if err := p.writer.EndTransaction(ctx, kgo.TryAbort); err != nil {
+		return errors.Join(reason, fmt.Errorf("failed to abort transaction %s: %w", p.transactionalId, err))
+	}
+
+	return reason
+}
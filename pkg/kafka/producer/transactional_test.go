@@ -0,0 +1,68 @@
+package producer_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/kafka/producer"
+	kafkaProducerMocks "github.com/justtrackio/gosoline/pkg/kafka/producer/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+type TransactionalProducerTestSuite struct {
+	suite.Suite
+
+	base     *kafkaProducerMocks.Producer
+	writer   *kafkaProducerMocks.TransactionalWriter
+	producer producer.Producer
+}
+
+func TestTransactionalProducerTestSuite(t *testing.T) {
+	suite.Run(t, new(TransactionalProducerTestSuite))
+}
+
+func (s *TransactionalProducerTestSuite) SetupTest() {
+	s.base = kafkaProducerMocks.NewProducer(s.T())
+	s.writer = kafkaProducerMocks.NewTransactionalWriter(s.T())
+	s.producer = producer.NewTransactionalProducerWithInterfaces(s.base, s.writer, "app-output-pod-0")
+}
+
+func (s *TransactionalProducerTestSuite) TestProduceSync() {
+	record := &kgo.Record{Value: []byte("a")}
+
+	s.writer.EXPECT().BeginTransaction().Return(nil).Once()
+	s.base.EXPECT().ProduceSync(mock.Anything, record).Return(nil).Once()
+	s.writer.EXPECT().EndTransaction(mock.Anything, kgo.TryCommit).Return(nil).Once()
+
+	err := s.producer.ProduceSync(s.T().Context(), record)
+	s.NoError(err)
+}
+
+func (s *TransactionalProducerTestSuite) TestProduceSyncWithoutRecords() {
+	err := s.producer.ProduceSync(s.T().Context())
+	s.NoError(err)
+}
+
+func (s *TransactionalProducerTestSuite) TestProduceSyncAbortsOnProduceFailure() {
+	record := &kgo.Record{Value: []byte("a")}
+
+	s.writer.EXPECT().BeginTransaction().Return(nil).Once()
+	s.base.EXPECT().ProduceSync(mock.Anything, record).Return(assert.AnError).Once()
+	s.writer.EXPECT().EndTransaction(mock.Anything, kgo.TryAbort).Return(nil).Once()
+
+	err := s.producer.ProduceSync(s.T().Context(), record)
+	s.ErrorIs(err, assert.AnError)
+}
+
+func (s *TransactionalProducerTestSuite) TestProduceSyncFailsOnCommitFailure() {
+	record := &kgo.Record{Value: []byte("a")}
+
+	s.writer.EXPECT().BeginTransaction().Return(nil).Once()
+	s.base.EXPECT().ProduceSync(mock.Anything, record).Return(nil).Once()
+	s.writer.EXPECT().EndTransaction(mock.Anything, kgo.TryCommit).Return(assert.AnError).Once()
+
+	err := s.producer.ProduceSync(s.T().Context(), record)
+	s.ErrorIs(err, assert.AnError)
+}
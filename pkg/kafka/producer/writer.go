@@ -18,6 +18,13 @@ type Writer interface {
 	ProduceSync(ctx context.Context, rs ...*kgo.Record) kgo.ProduceResults
 }
 
+//go:generate go run github.com/vektra/mockery/v2 --name TransactionalWriter
+type TransactionalWriter interface {
+	Writer
+	BeginTransaction() error
+	EndTransaction(ctx context.Context, commit kgo.TransactionEndTry) error
+}
+
 func NewWriter(ctx context.Context, config cfg.Config, logger log.Logger, settings *Settings, name string) (Writer, error) {
 	topic, err := kafka.BuildFullTopicName(config, settings.ToIdentity(), settings.TopicId)
 	if err != nil {
@@ -38,6 +45,14 @@ func NewWriter(ctx context.Context, config cfg.Config, logger log.Logger, settin
 		kgo.WithLogger(logging.NewKafkaLogger(ctx, logger)),
 	}
 
+	if settings.IsTransactional() {
+		opts = append(opts, kgo.TransactionalID(settings.TransactionalId))
+
+		if settings.TransactionTimeout > 0 {
+			opts = append(opts, kgo.TransactionTimeout(settings.TransactionTimeout))
+		}
+	}
+
 	connOpts, err := connection.BuildConnectionOptions(config, settings.Connection)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build connection options: %w", err)
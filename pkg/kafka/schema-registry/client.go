@@ -8,6 +8,7 @@ import (
 
 //go:generate go run github.com/vektra/mockery/v2 --name Client
 type Client interface {
+	CheckCompatibility(ctx context.Context, subject string, version int, s sr.Schema) (sr.CheckCompatibilityResult, error)
 	CreateSchema(ctx context.Context, subject string, s sr.Schema) (sr.SubjectSchema, error)
 	LookupSchema(ctx context.Context, subject string, s sr.Schema) (sr.SubjectSchema, error)
 }
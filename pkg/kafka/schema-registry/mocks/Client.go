@@ -23,6 +23,65 @@ func (_m *Client) EXPECT() *Client_Expecter {
 	return &Client_Expecter{mock: &_m.Mock}
 }
 
+// CheckCompatibility provides a mock function with given fields: ctx, subject, version, s
+func (_m *Client) CheckCompatibility(ctx context.Context, subject string, version int, s sr.Schema) (sr.CheckCompatibilityResult, error) {
+	ret := _m.Called(ctx, subject, version, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckCompatibility")
+	}
+
+	var r0 sr.CheckCompatibilityResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, sr.Schema) (sr.CheckCompatibilityResult, error)); ok {
+		return rf(ctx, subject, version, s)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, sr.Schema) sr.CheckCompatibilityResult); ok {
+		r0 = rf(ctx, subject, version, s)
+	} else {
+		r0 = ret.Get(0).(sr.CheckCompatibilityResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, sr.Schema) error); ok {
+		r1 = rf(ctx, subject, version, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_CheckCompatibility_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckCompatibility'
+type Client_CheckCompatibility_Call struct {
+	*mock.Call
+}
+
+// CheckCompatibility is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject string
+//   - version int
+//   - s sr.Schema
+func (_e *Client_Expecter) CheckCompatibility(ctx interface{}, subject interface{}, version interface{}, s interface{}) *Client_CheckCompatibility_Call {
+	return &Client_CheckCompatibility_Call{Call: _e.mock.On("CheckCompatibility", ctx, subject, version, s)}
+}
+
+func (_c *Client_CheckCompatibility_Call) Run(run func(ctx context.Context, subject string, version int, s sr.Schema)) *Client_CheckCompatibility_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(sr.Schema))
+	})
+	return _c
+}
+
+func (_c *Client_CheckCompatibility_Call) Return(_a0 sr.CheckCompatibilityResult, _a1 error) *Client_CheckCompatibility_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_CheckCompatibility_Call) RunAndReturn(run func(context.Context, string, int, sr.Schema) (sr.CheckCompatibilityResult, error)) *Client_CheckCompatibility_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateSchema provides a mock function with given fields: ctx, subject, s
 func (_m *Client) CreateSchema(ctx context.Context, subject string, s sr.Schema) (sr.SubjectSchema, error) {
 	ret := _m.Called(ctx, subject, s)
@@ -22,6 +22,55 @@ func (_m *Service) EXPECT() *Service_Expecter {
 	return &Service_Expecter{mock: &_m.Mock}
 }
 
+// CheckCompatibility provides a mock function with given fields: ctx, subject, schema, schemaType
+func (_m *Service) CheckCompatibility(ctx context.Context, subject string, schema string, schemaType schema_registry.SchemaType) error {
+	ret := _m.Called(ctx, subject, schema, schemaType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckCompatibility")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, schema_registry.SchemaType) error); ok {
+		r0 = rf(ctx, subject, schema, schemaType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Service_CheckCompatibility_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckCompatibility'
+type Service_CheckCompatibility_Call struct {
+	*mock.Call
+}
+
+// CheckCompatibility is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subject string
+//   - schema string
+//   - schemaType schema_registry.SchemaType
+func (_e *Service_Expecter) CheckCompatibility(ctx interface{}, subject interface{}, schema interface{}, schemaType interface{}) *Service_CheckCompatibility_Call {
+	return &Service_CheckCompatibility_Call{Call: _e.mock.On("CheckCompatibility", ctx, subject, schema, schemaType)}
+}
+
+func (_c *Service_CheckCompatibility_Call) Run(run func(ctx context.Context, subject string, schema string, schemaType schema_registry.SchemaType)) *Service_CheckCompatibility_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(schema_registry.SchemaType))
+	})
+	return _c
+}
+
+func (_c *Service_CheckCompatibility_Call) Return(_a0 error) *Service_CheckCompatibility_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Service_CheckCompatibility_Call) RunAndReturn(run func(context.Context, string, string, schema_registry.SchemaType) error) *Service_CheckCompatibility_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOrCreateSubjectSchemaId provides a mock function with given fields: ctx, subject, schema, schemaType
 func (_m *Service) GetOrCreateSubjectSchemaId(ctx context.Context, subject string, schema string, schemaType schema_registry.SchemaType) (int, error) {
 	ret := _m.Called(ctx, subject, schema, schemaType)
@@ -0,0 +1,42 @@
+package schema_registry
+
+import (
+	"fmt"
+	"sync"
+)
+
+// schemaIdCache keeps the ids of schemas once they got looked up or registered. The id of a schema never changes
+// for a subject, so there is no need to expire them.
+type schemaIdCache struct {
+	lck sync.RWMutex
+	ids map[string]int
+}
+
+func newSchemaIdCache() *schemaIdCache {
+	return &schemaIdCache{
+		ids: make(map[string]int),
+	}
+}
+
+func (c *schemaIdCache) getOrLoad(subject string, schema string, schemaType SchemaType, load func() (int, error)) (int, error) {
+	key := fmt.Sprintf("%s/%s/%s", subject, schemaType, schema)
+
+	c.lck.RLock()
+	id, ok := c.ids[key]
+	c.lck.RUnlock()
+
+	if ok {
+		return id, nil
+	}
+
+	id, err := load()
+	if err != nil {
+		return 0, err
+	}
+
+	c.lck.Lock()
+	c.ids[key] = id
+	c.lck.Unlock()
+
+	return id, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/justtrackio/gosoline/pkg/appctx"
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/exec"
 	"github.com/justtrackio/gosoline/pkg/kafka/connection"
@@ -15,13 +16,27 @@ import (
 
 //go:generate go run github.com/vektra/mockery/v2 --name Service
 type Service interface {
+	// CheckCompatibility fails if the schema is not compatible with the latest schema of the subject according to the
+	// compatibility level configured in the registry. A subject without any schema is compatible with every schema.
+	CheckCompatibility(ctx context.Context, subject string, schema string, schemaType SchemaType) error
 	GetSubjectSchemaId(ctx context.Context, subject string, schema string, schemaType SchemaType) (int, error)
 	GetOrCreateSubjectSchemaId(ctx context.Context, subject string, schema string, schemaType SchemaType) (int, error)
 }
 
+type serviceCtxKey string
+
 type service struct {
 	client   Client
 	executor exec.Executor
+	cache    *schemaIdCache
+}
+
+// ProvideService returns the service of the connection shared by all inputs and outputs of the application, so each
+// schema id is only requested once from the registry.
+func ProvideService(ctx context.Context, config cfg.Config, logger log.Logger, connectionName string, settings connection.Settings) (Service, error) {
+	return appctx.Provide(ctx, serviceCtxKey(connectionName), func() (Service, error) {
+		return NewService(config, logger, connectionName, settings)
+	})
 }
 
 func NewService(config cfg.Config, logger log.Logger, connectionName string, settings connection.Settings) (Service, error) {
@@ -42,10 +57,44 @@ func NewServiceWithInterfaces(client Client, executor exec.Executor) Service {
 	return &service{
 		client:   client,
 		executor: executor,
+		cache:    newSchemaIdCache(),
+	}
+}
+
+func (s service) CheckCompatibility(ctx context.Context, subject string, schema string, schemaType SchemaType) error {
+	registrySchema, err := buildRegistrySchema(schema, schemaType)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.executor.Execute(ctx, func(ctx context.Context) (any, error) {
+		// -1 is the latest version of the subject
+		return s.client.CheckCompatibility(ctx, subject, -1, registrySchema)
+	})
+
+	if isSchemaLookupMiss(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to check compatibility of schema with subject %s: %w", subject, err)
 	}
+
+	compatibility := result.(sr.CheckCompatibilityResult)
+	if !compatibility.Is {
+		return fmt.Errorf("schema is not compatible with the latest schema of subject %s: %s", subject, strings.Join(compatibility.Messages, "; "))
+	}
+
+	return nil
 }
 
 func (s service) GetSubjectSchemaId(ctx context.Context, subject string, schema string, schemaType SchemaType) (int, error) {
+	return s.cache.getOrLoad(subject, schema, schemaType, func() (int, error) {
+		return s.getSubjectSchemaId(ctx, subject, schema, schemaType)
+	})
+}
+
+func (s service) getSubjectSchemaId(ctx context.Context, subject string, schema string, schemaType SchemaType) (int, error) {
 	registrySchema, err := buildRegistrySchema(schema, schemaType)
 	if err != nil {
 		return 0, err
@@ -60,6 +109,12 @@ func (s service) GetSubjectSchemaId(ctx context.Context, subject string, schema
 }
 
 func (s service) GetOrCreateSubjectSchemaId(ctx context.Context, subject string, schema string, schemaType SchemaType) (int, error) {
+	return s.cache.getOrLoad(subject, schema, schemaType, func() (int, error) {
+		return s.getOrCreateSubjectSchemaId(ctx, subject, schema, schemaType)
+	})
+}
+
+func (s service) getOrCreateSubjectSchemaId(ctx context.Context, subject string, schema string, schemaType SchemaType) (int, error) {
 	registrySchema, err := buildRegistrySchema(schema, schemaType)
 	if err != nil {
 		return 0, err
@@ -223,3 +223,53 @@ func (s *ServiceTestSuite) TestGetOrCreateSubjectSchemaId_ReturnsRetryableErrorW
 	s.True(errors.Is(err, io.EOF))
 	s.EqualError(err, "failed to create subject schema: EOF")
 }
+
+func (s *ServiceTestSuite) TestGetSubjectSchemaId_CachesSchemaId() {
+	registrySchema := sr.Schema{Schema: `{"type":"string"}`, Type: sr.TypeAvro}
+
+	s.client.EXPECT().LookupSchema(matcher.Context, "test-subject", registrySchema).Return(sr.SubjectSchema{ID: 41}, nil).Once()
+
+	for range 2 {
+		id, err := s.service.GetSubjectSchemaId(s.ctx, "test-subject", registrySchema.Schema, schemaRegistry.Avro)
+
+		s.NoError(err)
+		s.Equal(41, id)
+	}
+}
+
+func (s *ServiceTestSuite) TestCheckCompatibility_Compatible() {
+	registrySchema := sr.Schema{Schema: `{"type":"object"}`, Type: sr.TypeJSON}
+
+	s.client.EXPECT().CheckCompatibility(matcher.Context, "test-subject", -1, registrySchema).Return(sr.CheckCompatibilityResult{Is: true}, nil).Once()
+
+	err := s.service.CheckCompatibility(s.ctx, "test-subject", registrySchema.Schema, schemaRegistry.Json)
+	s.NoError(err)
+}
+
+func (s *ServiceTestSuite) TestCheckCompatibility_SubjectNotFound() {
+	registrySchema := sr.Schema{Schema: `{"type":"object"}`, Type: sr.TypeJSON}
+
+	s.client.EXPECT().CheckCompatibility(matcher.Context, "test-subject", -1, registrySchema).Return(
+		sr.CheckCompatibilityResult{},
+		&sr.ResponseError{
+			StatusCode: http.StatusNotFound,
+			ErrorCode:  sr.ErrSubjectNotFound.Code,
+			Message:    "subject not found",
+		},
+	).Once()
+
+	err := s.service.CheckCompatibility(s.ctx, "test-subject", registrySchema.Schema, schemaRegistry.Json)
+	s.NoError(err)
+}
+
+func (s *ServiceTestSuite) TestCheckCompatibility_Incompatible() {
+	registrySchema := sr.Schema{Schema: `{"type":"object"}`, Type: sr.TypeJSON}
+
+	s.client.EXPECT().CheckCompatibility(matcher.Context, "test-subject", -1, registrySchema).Return(sr.CheckCompatibilityResult{
+		Is:       false,
+		Messages: []string{"property id removed", "type changed"},
+	}, nil).Once()
+
+	err := s.service.CheckCompatibility(s.ctx, "test-subject", registrySchema.Schema, schemaRegistry.Json)
+	s.EqualError(err, "schema is not compatible with the latest schema of subject test-subject: property id removed; type changed")
+}
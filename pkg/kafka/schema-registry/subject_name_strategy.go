@@ -0,0 +1,105 @@
+package schema_registry
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"google.golang.org/protobuf/proto"
+)
+
+// SubjectNameStrategy defines how the subject of a schema is derived, following the strategies of the confluent
+// serializers so applications written in other languages find the same subjects.
+type SubjectNameStrategy string
+
+const (
+	// TopicNameStrategy uses <topic>-value as subject, so all messages of a topic have to share the same schema.
+	TopicNameStrategy SubjectNameStrategy = "topic"
+	// RecordNameStrategy uses the fully qualified record name as subject, so a record has the same schema in all topics.
+	RecordNameStrategy SubjectNameStrategy = "record"
+	// TopicRecordNameStrategy uses <topic>-<fully qualified record name> as subject, so a topic can contain several records.
+	TopicRecordNameStrategy SubjectNameStrategy = "topic_record"
+)
+
+// BuildSubject returns the subject for the value schema of the messages in the topic.
+func BuildSubject(strategy SubjectNameStrategy, topic string, schema string, schemaType SchemaType, model any) (string, error) {
+	if strategy == "" || strategy == TopicNameStrategy {
+		return fmt.Sprintf("%s-value", topic), nil
+	}
+
+	recordName, err := RecordName(schema, schemaType, model)
+	if err != nil {
+		return "", fmt.Errorf("failed to get record name for subject name strategy %s: %w", strategy, err)
+	}
+
+	switch strategy {
+	case RecordNameStrategy:
+		return recordName, nil
+	case TopicRecordNameStrategy:
+		return fmt.Sprintf("%s-%s", topic, recordName), nil
+	default:
+		return "", fmt.Errorf("unknown subject name strategy: %s", strategy)
+	}
+}
+
+// RecordName returns the fully qualified name of the record described by the schema: namespace and name of an avro
+// record, the title of a json schema or the full name of the protobuf message of the model.
+func RecordName(schema string, schemaType SchemaType, model any) (string, error) {
+	switch schemaType {
+	case Avro:
+		return avroRecordName(schema)
+	case Json:
+		return jsonSchemaRecordName(schema)
+	case Protobuf:
+		return protobufRecordName(model)
+	default:
+		return "", fmt.Errorf("unknown schema type: %s", schemaType)
+	}
+}
+
+func avroRecordName(schema string) (string, error) {
+	record := struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	}{}
+
+	if err := json.Unmarshal([]byte(schema), &record); err != nil {
+		return "", fmt.Errorf("failed to parse avro schema: %w", err)
+	}
+
+	if record.Name == "" {
+		return "", fmt.Errorf("the avro schema has no name")
+	}
+
+	// a name containing a dot is a full name already and the namespace is ignored
+	if record.Namespace == "" || strings.Contains(record.Name, ".") {
+		return record.Name, nil
+	}
+
+	return fmt.Sprintf("%s.%s", record.Namespace, record.Name), nil
+}
+
+func jsonSchemaRecordName(schema string) (string, error) {
+	record := struct {
+		Title string `json:"title"`
+	}{}
+
+	if err := json.Unmarshal([]byte(schema), &record); err != nil {
+		return "", fmt.Errorf("failed to parse json schema: %w", err)
+	}
+
+	if record.Title == "" {
+		return "", fmt.Errorf("the json schema has no title")
+	}
+
+	return record.Title, nil
+}
+
+func protobufRecordName(model any) (string, error) {
+	message, ok := model.(proto.Message)
+	if !ok {
+		return "", fmt.Errorf("the model of type %T is not a protobuf message", model)
+	}
+
+	return string(proto.MessageName(message)), nil
+}
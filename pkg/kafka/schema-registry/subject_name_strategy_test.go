@@ -0,0 +1,69 @@
+package schema_registry_test
+
+import (
+	"testing"
+
+	schemaRegistry "github.com/justtrackio/gosoline/pkg/kafka/schema-registry"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildSubject(t *testing.T) {
+	avroSchema := `{"type":"record","namespace":"com.example","name":"Event","fields":[]}`
+	jsonSchema := `{"title":"com.example.JsonEvent","type":"object"}`
+
+	for name, test := range map[string]struct {
+		strategy   schemaRegistry.SubjectNameStrategy
+		schema     string
+		schemaType schemaRegistry.SchemaType
+		expected   string
+		err        string
+	}{
+		"default": {
+			schema:     avroSchema,
+			schemaType: schemaRegistry.Avro,
+			expected:   "events-value",
+		},
+		"topic": {
+			strategy:   schemaRegistry.TopicNameStrategy,
+			schema:     jsonSchema,
+			schemaType: schemaRegistry.Json,
+			expected:   "events-value",
+		},
+		"record avro": {
+			strategy:   schemaRegistry.RecordNameStrategy,
+			schema:     avroSchema,
+			schemaType: schemaRegistry.Avro,
+			expected:   "com.example.Event",
+		},
+		"topic record json": {
+			strategy:   schemaRegistry.TopicRecordNameStrategy,
+			schema:     jsonSchema,
+			schemaType: schemaRegistry.Json,
+			expected:   "events-com.example.JsonEvent",
+		},
+		"json without title": {
+			strategy:   schemaRegistry.RecordNameStrategy,
+			schema:     `{"type":"object"}`,
+			schemaType: schemaRegistry.Json,
+			err:        "failed to get record name for subject name strategy record: the json schema has no title",
+		},
+		"protobuf without message": {
+			strategy:   schemaRegistry.RecordNameStrategy,
+			schemaType: schemaRegistry.Protobuf,
+			err:        "failed to get record name for subject name strategy record: the model of type <nil> is not a protobuf message",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			subject, err := schemaRegistry.BuildSubject(test.strategy, "events", test.schema, test.schemaType, nil)
+
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, subject)
+		})
+	}
+}
@@ -80,3 +80,35 @@ func (f fixedWindow) Wait(ctx context.Context, prefix string) (err error) {
 
 	return nil
 }
+
+func (f fixedWindow) TryTake(ctx context.Context, prefix string) (quota *Quota, err error) {
+	invocation := f.invocationBuilder.Build(prefix)
+
+	f.middleware.OnTake(ctx, invocation)
+	defer func() {
+		if err != nil {
+			f.middleware.OnError(ctx, invocation)
+		} else {
+			f.middleware.OnRelease(ctx, invocation)
+		}
+	}()
+
+	incr, ttl, err := f.backend.Increment(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	quota = &Quota{
+		Allowed:   *incr <= f.config.Cap,
+		Limit:     f.config.Cap,
+		Remaining: max(f.config.Cap-*incr, 0),
+		Reset:     *ttl,
+	}
+
+	if !quota.Allowed {
+		f.middleware.OnThrottle(ctx, invocation)
+		quota.RetryAfter = *ttl
+	}
+
+	return quota, nil
+}
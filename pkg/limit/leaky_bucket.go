@@ -2,7 +2,10 @@ package limit
 
 import (
 	"context"
+	"sync"
+	"time"
 
+	"github.com/justtrackio/gosoline/pkg/clock"
 	"go.uber.org/ratelimit"
 )
 
@@ -10,8 +13,11 @@ type leakyBucketLimiter struct {
 	*middlewareEmbeddable
 	invocationBuilder *invocationBuilder
 	lim               ratelimit.Limiter
+	meter             *leakyBucketMeter
 }
 
+// NewLeakyBucketLimiter creates an in-process limiter permitting rate operations per second. Wait shares one bucket
+// between all prefixes, while TryTake meters every prefix on its own. The limiter implements TryLimiter.
 func NewLeakyBucketLimiter(name string, rate int) (Limiter, error) {
 	builder, err := newInvocationBuilder(name)
 	if err != nil {
@@ -22,6 +28,7 @@ func NewLeakyBucketLimiter(name string, rate int) (Limiter, error) {
 		middlewareEmbeddable: newMiddlewareEmbeddable(),
 		invocationBuilder:    builder,
 		lim:                  ratelimit.New(rate),
+		meter:                newLeakyBucketMeter(clock.Provider, rate),
 	}, nil
 }
 
@@ -35,3 +42,89 @@ func (l leakyBucketLimiter) Wait(ctx context.Context, _ string) error {
 
 	return nil
 }
+
+func (l leakyBucketLimiter) TryTake(ctx context.Context, prefix string) (*Quota, error) {
+	invocation := l.invocationBuilder.Build(prefix)
+
+	l.middleware.OnTake(ctx, invocation)
+	defer l.middleware.OnRelease(ctx, invocation)
+
+	quota := l.meter.take(prefix)
+	if !quota.Allowed {
+		l.middleware.OnThrottle(ctx, invocation)
+	}
+
+	return quota, nil
+}
+
+// leakyBucketMeter implements the leaky bucket as a meter (also known as generic cell rate algorithm): every prefix
+// has a bucket holding up to rate operations, which drains with rate operations per second. Instead of the level of
+// the bucket, the time at which the bucket will be empty again is stored.
+type leakyBucketMeter struct {
+	clock     clock.Clock
+	rate      int
+	interval  time.Duration
+	lck       sync.Mutex
+	emptyAt   map[string]time.Time
+	lastSweep time.Time
+}
+
+func newLeakyBucketMeter(clock clock.Clock, rate int) *leakyBucketMeter {
+	return &leakyBucketMeter{
+		clock:    clock,
+		rate:     rate,
+		interval: time.Second / time.Duration(rate),
+		emptyAt:  make(map[string]time.Time),
+	}
+}
+
+func (m *leakyBucketMeter) take(prefix string) *Quota {
+	m.lck.Lock()
+	defer m.lck.Unlock()
+
+	now := m.clock.Now()
+	m.sweep(now)
+
+	emptyAt := m.emptyAt[prefix]
+	if emptyAt.Before(now) {
+		emptyAt = now
+	}
+
+	next := emptyAt.Add(m.interval)
+	// the operation fits into the bucket if it is empty again within a second
+	permittedAt := next.Add(-time.Second)
+
+	if now.Before(permittedAt) {
+		return &Quota{
+			Allowed:    false,
+			Limit:      m.rate,
+			Remaining:  0,
+			Reset:      emptyAt.Sub(now),
+			RetryAfter: permittedAt.Sub(now),
+		}
+	}
+
+	m.emptyAt[prefix] = next
+
+	return &Quota{
+		Allowed:   true,
+		Limit:     m.rate,
+		Remaining: int((time.Second - next.Sub(now)) / m.interval),
+		Reset:     next.Sub(now),
+	}
+}
+
+// sweep removes the buckets which are empty already, so the meter doesn't grow with the number of seen prefixes.
+func (m *leakyBucketMeter) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < time.Second {
+		return
+	}
+
+	m.lastSweep = now
+
+	for prefix, emptyAt := range m.emptyAt {
+		if !emptyAt.After(now) {
+			delete(m.emptyAt, prefix)
+		}
+	}
+}
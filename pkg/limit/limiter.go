@@ -1,12 +1,15 @@
-// Package limit implements various rate limiters. In the current stage (2023-03-13) limiters can only be used when
-// for waiting until request limits permit operations. In the future, we might add support for limiters that can be
-// used to limit incoming traffic for services.
+// Package limit implements various rate limiters. Limiters can be used for waiting until request limits permit
+// operations (Wait) or for checking the limits without waiting (TryTake), e.g. to limit incoming traffic of services
+// with httpserver.RateLimitMiddleware.
 //
 // Because of limitations in the testing process of the rate limiters be aware that the limiter package is currently
 // BETA and can change anytime.
 package limit
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type limitPkgCtxKey string
 
@@ -16,6 +19,27 @@ type Limiter interface {
 	Wait(ctx context.Context, prefix string) error
 }
 
+// Quota describes the state of a limit after trying to take from it.
+type Quota struct {
+	// Allowed is whether the operation is permitted by the limit.
+	Allowed bool
+	// Limit is the number of operations permitted per window.
+	Limit int
+	// Remaining is the number of operations still permitted in the current window.
+	Remaining int
+	// Reset is the time until the quota is fully restored.
+	Reset time.Duration
+	// RetryAfter is the time until the next operation is permitted again, if this one was not.
+	RetryAfter time.Duration
+}
+
+// TryLimiter is implemented by the limiters which can check the limit without waiting. As not every limiter supports
+// this, a Limiter has to be asserted to be a TryLimiter.
+type TryLimiter interface {
+	// TryTake takes from the limit without waiting. The returned quota tells whether the operation is permitted.
+	TryTake(ctx context.Context, prefix string) (*Quota, error)
+}
+
 type LimiterWithMiddleware interface {
 	Limiter
 	WithMiddleware(...MiddlewareFactory)
@@ -0,0 +1,184 @@
+package limit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+)
+
+// SlidingWindowBackend counts operations in fixed windows. The sliding window limiter estimates the number of
+// operations in the last window from the counts of the current and the previous fixed window.
+type SlidingWindowBackend interface {
+	// Take increments the counter of the window starting at windowStart if the count of the previous window weighted by
+	// previousWeight plus the count of the current window is below the capacity. It returns the counts of both windows
+	// after the operation.
+	Take(ctx context.Context, prefix string, windowStart time.Time, previousWeight float64) (previous int, current int, taken bool, err error)
+}
+
+type SlidingWindowConfig struct {
+	Name   string
+	Cap    int
+	Window time.Duration
+}
+
+func (c SlidingWindowConfig) validate() error {
+	if c.Cap <= 0 {
+		return fmt.Errorf("the cap of the sliding window %s has to be positive, got %d", c.Name, c.Cap)
+	}
+
+	if c.Window < time.Millisecond {
+		return fmt.Errorf("the window of the sliding window %s has to be at least a millisecond, got %s", c.Name, c.Window)
+	}
+
+	return nil
+}
+
+// slidingWindow implements the sliding window counter algorithm. Contrary to the fixed window, it doesn't permit
+// bursts of twice the capacity at the edges of the windows.
+type slidingWindow struct {
+	*middlewareEmbeddable
+	backend           SlidingWindowBackend
+	clock             clock.Clock
+	config            SlidingWindowConfig
+	invocationBuilder *invocationBuilder
+}
+
+func NewSlidingWindowLimiter(backend SlidingWindowBackend, clock clock.Clock, config SlidingWindowConfig, builder *invocationBuilder) *slidingWindow {
+	return &slidingWindow{
+		middlewareEmbeddable: newMiddlewareEmbeddable(),
+		backend:              backend,
+		clock:                clock,
+		config:               config,
+		invocationBuilder:    builder,
+	}
+}
+
+func (s slidingWindow) Wait(ctx context.Context, prefix string) (err error) {
+	invocation := s.invocationBuilder.Build(prefix)
+
+	s.middleware.OnTake(ctx, invocation)
+	defer func() {
+		if err != nil {
+			s.middleware.OnError(ctx, invocation)
+		} else {
+			s.middleware.OnRelease(ctx, invocation)
+		}
+	}()
+
+	return waitForQuota(ctx, s.clock, func() (*Quota, error) {
+		return s.take(ctx, prefix)
+	}, func() {
+		s.middleware.OnThrottle(ctx, invocation)
+	})
+}
+
+func (s slidingWindow) TryTake(ctx context.Context, prefix string) (quota *Quota, err error) {
+	invocation := s.invocationBuilder.Build(prefix)
+
+	s.middleware.OnTake(ctx, invocation)
+	defer func() {
+		if err != nil {
+			s.middleware.OnError(ctx, invocation)
+		} else {
+			s.middleware.OnRelease(ctx, invocation)
+		}
+	}()
+
+	if quota, err = s.take(ctx, prefix); err != nil {
+		return nil, err
+	}
+
+	if !quota.Allowed {
+		s.middleware.OnThrottle(ctx, invocation)
+	}
+
+	return quota, nil
+}
+
+func (s slidingWindow) take(ctx context.Context, prefix string) (*Quota, error) {
+	window := s.config.Window
+	now := s.clock.Now()
+	windowStart := now.Truncate(window)
+	elapsed := now.Sub(windowStart)
+	weight := 1 - float64(elapsed)/float64(window)
+
+	previous, current, taken, err := s.backend.Take(ctx, prefix, windowStart, weight)
+	if err != nil {
+		return nil, err
+	}
+
+	estimate := float64(previous)*weight + float64(current)
+	quota := &Quota{
+		Allowed:   taken,
+		Limit:     s.config.Cap,
+		Remaining: max(s.config.Cap-int(math.Ceil(estimate)), 0),
+		Reset:     window - elapsed,
+	}
+
+	if current > 0 {
+		// the operations of the current window count until the end of the next window
+		quota.Reset += window
+	}
+
+	if !taken {
+		quota.RetryAfter = s.retryAfter(previous, current, estimate, elapsed)
+	}
+
+	return quota, nil
+}
+
+// retryAfter calculates when the estimated count drops below the capacity again.
+func (s slidingWindow) retryAfter(previous int, current int, estimate float64, elapsed time.Duration) time.Duration {
+	window := s.config.Window
+	capacity := float64(s.config.Cap)
+
+	// the weight of the previous window decreases until the end of the current window
+	if previous > 0 {
+		wait := time.Duration((estimate - capacity) / float64(previous) * float64(window))
+		if wait < window-elapsed {
+			return wait + time.Millisecond
+		}
+	}
+
+	// the current window becomes the previous window, whose weight decreases until the end of the next window
+	wait := window - elapsed
+	if float64(current) >= capacity {
+		wait += time.Duration((float64(current) - capacity) / float64(current) * float64(window))
+	}
+
+	return wait + time.Millisecond
+}
+
+// waitForQuota takes from the quota until it permits the operation and waits in between for the quota to recover.
+func waitForQuota(ctx context.Context, clock clock.Clock, take func() (*Quota, error), onThrottle func()) error {
+	throttled := false
+
+	for {
+		quota, err := take()
+		if err != nil {
+			return err
+		}
+
+		if quota.Allowed {
+			return nil
+		}
+
+		if !throttled {
+			throttled = true
+			onThrottle()
+		}
+
+		timer := clock.NewTimer(quota.RetryAfter)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return ctx.Err()
+		case <-timer.Chan():
+		}
+	}
+}
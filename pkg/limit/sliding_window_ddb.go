@@ -0,0 +1,110 @@
+package limit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/ddb"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type slidingWindowDdb struct {
+	repo   ddb.Repository
+	config SlidingWindowConfig
+}
+
+func NewSlidingWindowDdb(ctx context.Context, config cfg.Config, logger log.Logger, settings *ddb.Settings, c SlidingWindowConfig) (LimiterWithMiddleware, error) {
+	settings.Main.Model = &window{}
+
+	repo, err := ddb.NewRepository(ctx, config, logger, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSlidingWindowDdbWithInterfaces(clock.NewRealClock(), repo, c)
+}
+
+func NewSlidingWindowDdbWithInterfaces(clock clock.Clock, repo ddb.Repository, config SlidingWindowConfig) (LimiterWithMiddleware, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	builder, err := newInvocationBuilder(config.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := &slidingWindowDdb{
+		repo:   repo,
+		config: config,
+	}
+
+	return NewSlidingWindowLimiter(backend, clock, config, builder), nil
+}
+
+func (s slidingWindowDdb) Take(ctx context.Context, prefix string, windowStart time.Time, previousWeight float64) (int, int, bool, error) {
+	previous := &window{}
+	qb := s.repo.GetItemBuilder().
+		WithHash(s.keyBuilder(prefix, windowStart.Add(-s.config.Window))).
+		WithConsistentRead(true)
+
+	if _, err := s.repo.GetItem(ctx, qb, previous); err != nil {
+		return 0, 0, false, fmt.Errorf("can not read count of previous window: %w", err)
+	}
+
+	// the count of the current window has to stay below this limit, ddb takes care that this holds for concurrent updates
+	limit := float64(s.config.Cap) - float64(previous.Val)*previousWeight
+	current := &window{Key: s.keyBuilder(prefix, windowStart)}
+
+	if limit <= 0 {
+		// the previous window exhausts the capacity on its own
+		if err := s.read(ctx, current); err != nil {
+			return 0, 0, false, err
+		}
+
+		return previous.Val, current.Val, false, nil
+	}
+
+	increment := s.repo.UpdateItemBuilder().
+		WithCondition(ddb.Or(
+			ddb.AttributeNotExists(attrVal),
+			ddb.Lt(attrVal, limit),
+		)).
+		Add(attrVal, 1).
+		SetIfNotExist(attrTtl, windowStart.Add(2*s.config.Window).Unix()).
+		ReturnAllNew()
+
+	res, err := s.repo.UpdateItem(ctx, increment, current)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("can not increment count of current window: %w", err)
+	}
+
+	if !res.ConditionalCheckFailed {
+		return previous.Val, current.Val, true, nil
+	}
+
+	if err = s.read(ctx, current); err != nil {
+		return 0, 0, false, err
+	}
+
+	return previous.Val, current.Val, false, nil
+}
+
+func (s slidingWindowDdb) read(ctx context.Context, current *window) error {
+	qb := s.repo.GetItemBuilder().
+		WithHash(current.Key).
+		WithConsistentRead(true)
+
+	if _, err := s.repo.GetItem(ctx, qb, current); err != nil {
+		return fmt.Errorf("can not read count of current window: %w", err)
+	}
+
+	return nil
+}
+
+func (s slidingWindowDdb) keyBuilder(prefix string, windowStart time.Time) string {
+	return fmt.Sprintf("%s/%s/%d", s.config.Name, prefix, windowStart.UnixMilli())
+}
@@ -0,0 +1,103 @@
+package limit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/redis"
+)
+
+// slidingWindowScript checks the weighted count of the previous (KEYS[1]) and current (KEYS[2]) window against the
+// capacity and increments the count of the current window in one step.
+var slidingWindowScript = redis.NewScript(`
+local previous = tonumber(redis.call("GET", KEYS[1]) or "0")
+local current = tonumber(redis.call("GET", KEYS[2]) or "0")
+
+if previous * tonumber(ARGV[2]) + current >= tonumber(ARGV[1]) then
+	return {previous, current, 0}
+end
+
+current = redis.call("INCR", KEYS[2])
+redis.call("PEXPIRE", KEYS[2], ARGV[3])
+
+return {previous, current, 1}
+`)
+
+func NewSlidingWindowRedis(ctx context.Context, config cfg.Config, logger log.Logger, c SlidingWindowConfig) (LimiterWithMiddleware, error) {
+	redisClient, err := provideRedisClient(ctx, config, logger)
+	if err != nil {
+		return nil, fmt.Errorf("can not create redis client: %w", err)
+	}
+
+	return NewSlidingWindowRedisWithInterfaces(clock.NewRealClock(), redisClient, c)
+}
+
+func NewSlidingWindowRedisWithInterfaces(clock clock.Clock, redis redis.Client, config SlidingWindowConfig) (LimiterWithMiddleware, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	builder, err := newInvocationBuilder(config.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := &slidingWindowRedis{
+		redis:  redis,
+		config: config,
+	}
+
+	return NewSlidingWindowLimiter(backend, clock, config, builder), nil
+}
+
+type slidingWindowRedis struct {
+	redis  redis.Client
+	config SlidingWindowConfig
+}
+
+func (s slidingWindowRedis) Take(ctx context.Context, prefix string, windowStart time.Time, previousWeight float64) (int, int, bool, error) {
+	keys := []string{
+		s.keyBuilder(prefix, windowStart.Add(-s.config.Window)),
+		s.keyBuilder(prefix, windowStart),
+	}
+	// the count of the current window is needed until the end of the next window
+	ttl := 2 * s.config.Window.Milliseconds()
+
+	result, err := slidingWindowScript.Run(ctx, s.redis, keys, s.config.Cap, strconv.FormatFloat(previousWeight, 'f', -1, 64), ttl)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("can not run sliding window script: %w", err)
+	}
+
+	values, err := scriptIntegers(result, 3)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("unexpected result of sliding window script: %w", err)
+	}
+
+	return int(values[0]), int(values[1]), values[2] == 1, nil
+}
+
+func (s slidingWindowRedis) keyBuilder(prefix string, windowStart time.Time) string {
+	// the hash tag keeps the windows of a prefix in the same slot of a redis cluster
+	return fmt.Sprintf("{%s/%s}/%d", s.config.Name, prefix, windowStart.UnixMilli())
+}
+
+func scriptIntegers(result any, length int) ([]int64, error) {
+	values, ok := result.([]any)
+	if !ok || len(values) != length {
+		return nil, fmt.Errorf("expected a list of %d values, got %v", length, result)
+	}
+
+	integers := make([]int64, length)
+	for i, value := range values {
+		if integers[i], ok = value.(int64); !ok {
+			return nil, fmt.Errorf("expected an integer at index %d, got %v", i, value)
+		}
+	}
+
+	return integers, nil
+}
@@ -0,0 +1,109 @@
+package limit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/limit"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/redis"
+	baseRedis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/suite"
+)
+
+type redisLimiterTestSuite struct {
+	suite.Suite
+	clock  clock.FakeClock
+	server *miniredis.Miniredis
+	client redis.Client
+}
+
+func (s *redisLimiterTestSuite) SetupTest() {
+	s.server = miniredis.RunT(s.T())
+	s.clock = clock.NewFakeClockAt(time.Date(2026, 10, 17, 12, 0, 30, 0, time.UTC))
+
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	baseClient := baseRedis.NewClient(&baseRedis.Options{
+		Addr: s.server.Addr(),
+	})
+
+	s.client = redis.NewClientWithInterfaces(logger, baseClient, exec.NewDefaultExecutor(), &redis.Settings{}, "")
+}
+
+func (s *redisLimiterTestSuite) assertQuota(limiter limit.Limiter, expected limit.Quota) {
+	tryLimiter, ok := limiter.(limit.TryLimiter)
+	s.Require().True(ok, "the limiter should implement limit.TryLimiter")
+
+	quota, err := tryLimiter.TryTake(s.T().Context(), "client")
+	s.Require().NoError(err)
+	s.Equal(expected, *quota)
+}
+
+type SlidingWindowRedisTestSuite struct {
+	redisLimiterTestSuite
+}
+
+func TestSlidingWindowRedisTestSuite(t *testing.T) {
+	suite.Run(t, new(SlidingWindowRedisTestSuite))
+}
+
+func (s *SlidingWindowRedisTestSuite) TestTryTake() {
+	limiter, err := limit.NewSlidingWindowRedisWithInterfaces(s.clock, s.client, limit.SlidingWindowConfig{
+		Name:   "test",
+		Cap:    3,
+		Window: time.Minute,
+	})
+	s.Require().NoError(err)
+
+	s.assertQuota(limiter, limit.Quota{Allowed: true, Limit: 3, Remaining: 2, Reset: 90 * time.Second})
+	s.assertQuota(limiter, limit.Quota{Allowed: true, Limit: 3, Remaining: 1, Reset: 90 * time.Second})
+	s.assertQuota(limiter, limit.Quota{Allowed: true, Limit: 3, Remaining: 0, Reset: 90 * time.Second})
+	s.assertQuota(limiter, limit.Quota{Allowed: false, Limit: 3, Remaining: 0, Reset: 90 * time.Second, RetryAfter: 30*time.Second + time.Millisecond})
+
+	// the operations of the previous window still count completely at the start of the next window
+	s.clock.Advance(30 * time.Second)
+	s.assertQuota(limiter, limit.Quota{Allowed: false, Limit: 3, Remaining: 0, Reset: time.Minute, RetryAfter: time.Millisecond})
+
+	// a third of the previous window passed, so a third of its operations are forgotten
+	s.clock.Advance(20 * time.Second)
+	s.assertQuota(limiter, limit.Quota{Allowed: true, Limit: 3, Remaining: 0, Reset: 100 * time.Second})
+	s.assertQuota(limiter, limit.Quota{Allowed: false, Limit: 3, Remaining: 0, Reset: 100 * time.Second, RetryAfter: time.Millisecond})
+}
+
+func (s *SlidingWindowRedisTestSuite) TestWait() {
+	limiter, err := limit.NewSlidingWindowRedisWithInterfaces(s.clock, s.client, limit.SlidingWindowConfig{
+		Name:   "test",
+		Cap:    1,
+		Window: time.Minute,
+	})
+	s.Require().NoError(err)
+
+	s.NoError(limiter.Wait(s.T().Context(), "client"))
+
+	done := make(chan error)
+	go func() {
+		done <- limiter.Wait(s.T().Context(), "client")
+	}()
+
+	s.clock.BlockUntilTimers(1)
+	s.clock.Advance(90 * time.Second)
+	s.NoError(<-done)
+}
+
+func (s *SlidingWindowRedisTestSuite) TestInvalidConfig() {
+	_, err := limit.NewSlidingWindowRedisWithInterfaces(s.clock, s.client, limit.SlidingWindowConfig{
+		Name:   "test",
+		Cap:    -1,
+		Window: time.Minute,
+	})
+	s.EqualError(err, "the cap of the sliding window test has to be positive, got -1")
+
+	_, err = limit.NewSlidingWindowRedisWithInterfaces(s.clock, s.client, limit.SlidingWindowConfig{
+		Name: "test",
+		Cap:  3,
+	})
+	s.EqualError(err, "the window of the sliding window test has to be at least a millisecond, got 0s")
+}
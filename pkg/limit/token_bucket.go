@@ -0,0 +1,122 @@
+package limit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+)
+
+// TokenBucketBackend stores the token buckets of the prefixes.
+type TokenBucketBackend interface {
+	// Take refills the bucket of the prefix according to the time passed since the last operation and takes a token if
+	// there is one. It returns the tokens left in the bucket.
+	Take(ctx context.Context, prefix string, now time.Time) (tokens float64, taken bool, err error)
+}
+
+// TokenBucketConfig configures a bucket holding up to Cap tokens, which refills completely within Window.
+type TokenBucketConfig struct {
+	Name   string
+	Cap    int
+	Window time.Duration
+}
+
+func (c TokenBucketConfig) validate() error {
+	if c.Cap <= 0 {
+		return fmt.Errorf("the cap of the token bucket %s has to be positive, got %d", c.Name, c.Cap)
+	}
+
+	if c.Window < time.Millisecond {
+		return fmt.Errorf("the window of the token bucket %s has to be at least a millisecond, got %s", c.Name, c.Window)
+	}
+
+	return nil
+}
+
+// ratePerMilli returns the number of tokens added to the bucket per millisecond.
+func (c TokenBucketConfig) ratePerMilli() float64 {
+	return float64(c.Cap) / float64(c.Window.Milliseconds())
+}
+
+type tokenBucket struct {
+	*middlewareEmbeddable
+	backend           TokenBucketBackend
+	clock             clock.Clock
+	config            TokenBucketConfig
+	invocationBuilder *invocationBuilder
+}
+
+func NewTokenBucketLimiter(backend TokenBucketBackend, clock clock.Clock, config TokenBucketConfig, builder *invocationBuilder) *tokenBucket {
+	return &tokenBucket{
+		middlewareEmbeddable: newMiddlewareEmbeddable(),
+		backend:              backend,
+		clock:                clock,
+		config:               config,
+		invocationBuilder:    builder,
+	}
+}
+
+func (t tokenBucket) Wait(ctx context.Context, prefix string) (err error) {
+	invocation := t.invocationBuilder.Build(prefix)
+
+	t.middleware.OnTake(ctx, invocation)
+	defer func() {
+		if err != nil {
+			t.middleware.OnError(ctx, invocation)
+		} else {
+			t.middleware.OnRelease(ctx, invocation)
+		}
+	}()
+
+	return waitForQuota(ctx, t.clock, func() (*Quota, error) {
+		return t.take(ctx, prefix)
+	}, func() {
+		t.middleware.OnThrottle(ctx, invocation)
+	})
+}
+
+func (t tokenBucket) TryTake(ctx context.Context, prefix string) (quota *Quota, err error) {
+	invocation := t.invocationBuilder.Build(prefix)
+
+	t.middleware.OnTake(ctx, invocation)
+	defer func() {
+		if err != nil {
+			t.middleware.OnError(ctx, invocation)
+		} else {
+			t.middleware.OnRelease(ctx, invocation)
+		}
+	}()
+
+	if quota, err = t.take(ctx, prefix); err != nil {
+		return nil, err
+	}
+
+	if !quota.Allowed {
+		t.middleware.OnThrottle(ctx, invocation)
+	}
+
+	return quota, nil
+}
+
+func (t tokenBucket) take(ctx context.Context, prefix string) (*Quota, error) {
+	tokens, taken, err := t.backend.Take(ctx, prefix, t.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	rate := t.config.ratePerMilli()
+	quota := &Quota{
+		Allowed:   taken,
+		Limit:     t.config.Cap,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     time.Duration(math.Ceil((float64(t.config.Cap)-tokens)/rate)) * time.Millisecond,
+	}
+
+	if !taken {
+		quota.RetryAfter = time.Duration(math.Ceil((1-tokens)/rate)) * time.Millisecond
+	}
+
+	return quota, nil
+}
@@ -0,0 +1,128 @@
+package limit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/ddb"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const (
+	attrVersion                = "version"
+	tokenBucketDdbMaxConflicts = 5
+)
+
+type bucket struct {
+	Key       string  `json:"key" ddb:"key=hash"`
+	Tokens    float64 `json:"tokens"`
+	Timestamp int64   `json:"timestamp"`
+	Version   int     `json:"version"`
+	Ttl       int64   `json:"ttl" ddb:"ttl=enabled"`
+}
+
+type tokenBucketDdb struct {
+	repo   ddb.Repository
+	config TokenBucketConfig
+}
+
+func NewTokenBucketDdb(ctx context.Context, config cfg.Config, logger log.Logger, settings *ddb.Settings, c TokenBucketConfig) (LimiterWithMiddleware, error) {
+	settings.Main.Model = &bucket{}
+
+	repo, err := ddb.NewRepository(ctx, config, logger, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTokenBucketDdbWithInterfaces(clock.NewRealClock(), repo, c)
+}
+
+func NewTokenBucketDdbWithInterfaces(clock clock.Clock, repo ddb.Repository, config TokenBucketConfig) (LimiterWithMiddleware, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	builder, err := newInvocationBuilder(config.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := &tokenBucketDdb{
+		repo:   repo,
+		config: config,
+	}
+
+	return NewTokenBucketLimiter(backend, clock, config, builder), nil
+}
+
+// Take reads the bucket, refills it and writes it back on the condition that nobody else updated it in between. It
+// retries with the updated bucket if there was a concurrent update.
+func (t tokenBucketDdb) Take(ctx context.Context, prefix string, now time.Time) (float64, bool, error) {
+	key := fmt.Sprintf("%s/%s", t.config.Name, prefix)
+
+	for i := 0; i < tokenBucketDdbMaxConflicts; i++ {
+		current := &bucket{}
+		// an expired bucket is not necessarily deleted yet, so we have to see it to update it
+		qb := t.repo.GetItemBuilder().
+			WithHash(key).
+			WithConsistentRead(true).
+			DisableTtlFilter()
+
+		res, err := t.repo.GetItem(ctx, qb, current)
+		if err != nil {
+			return 0, false, fmt.Errorf("can not read token bucket %s: %w", key, err)
+		}
+
+		next := t.refill(current, res.IsFound, now)
+		next.Key = key
+
+		taken := next.Tokens >= 1
+		if taken {
+			next.Tokens--
+		}
+
+		condition := ddb.AttributeNotExists(attrVersion)
+		if res.IsFound {
+			condition = ddb.Eq(attrVersion, current.Version)
+		}
+
+		put, err := t.repo.PutItem(ctx, t.repo.PutItemBuilder().WithCondition(condition), next)
+		if err != nil {
+			return 0, false, fmt.Errorf("can not write token bucket %s: %w", key, err)
+		}
+
+		if !put.ConditionalCheckFailed {
+			return next.Tokens, taken, nil
+		}
+	}
+
+	return 0, false, fmt.Errorf("can not write token bucket %s: it was updated concurrently %d times", key, tokenBucketDdbMaxConflicts)
+}
+
+func (t tokenBucketDdb) refill(current *bucket, found bool, now time.Time) *bucket {
+	next := &bucket{
+		Tokens:    float64(t.config.Cap),
+		Timestamp: now.UnixMilli(),
+		Version:   current.Version + 1,
+		// an expired bucket would have been refilled completely anyway
+		Ttl: now.Add(t.config.Window).Unix() + 1,
+	}
+
+	if !found {
+		return next
+	}
+
+	next.Tokens = current.Tokens
+	next.Timestamp = current.Timestamp
+
+	if elapsed := now.UnixMilli() - current.Timestamp; elapsed > 0 {
+		next.Tokens = math.Min(float64(t.config.Cap), current.Tokens+float64(elapsed)*t.config.ratePerMilli())
+		next.Timestamp = now.UnixMilli()
+	}
+
+	return next
+}
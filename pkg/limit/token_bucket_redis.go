@@ -0,0 +1,107 @@
+package limit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/redis"
+)
+
+// tokenBucketScript refills the bucket (KEYS[1]) and takes a token in one step. The arguments are the capacity, the
+// refill rate per millisecond, the current time in milliseconds and the ttl of the bucket in milliseconds.
+var tokenBucketScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local state = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+
+if tokens == nil or ts == nil then
+	tokens = capacity
+	ts = now
+end
+
+if now > ts then
+	tokens = math.min(capacity, tokens + (now - ts) * rate)
+	ts = now
+end
+
+local taken = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	taken = 1
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", tostring(ts))
+redis.call("PEXPIRE", KEYS[1], ARGV[4])
+
+return {taken, tostring(tokens)}
+`)
+
+func NewTokenBucketRedis(ctx context.Context, config cfg.Config, logger log.Logger, c TokenBucketConfig) (LimiterWithMiddleware, error) {
+	redisClient, err := provideRedisClient(ctx, config, logger)
+	if err != nil {
+		return nil, fmt.Errorf("can not create redis client: %w", err)
+	}
+
+	return NewTokenBucketRedisWithInterfaces(clock.NewRealClock(), redisClient, c)
+}
+
+func NewTokenBucketRedisWithInterfaces(clock clock.Clock, redis redis.Client, config TokenBucketConfig) (LimiterWithMiddleware, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	builder, err := newInvocationBuilder(config.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	backend := &tokenBucketRedis{
+		redis:  redis,
+		config: config,
+	}
+
+	return NewTokenBucketLimiter(backend, clock, config, builder), nil
+}
+
+type tokenBucketRedis struct {
+	redis  redis.Client
+	config TokenBucketConfig
+}
+
+func (t tokenBucketRedis) Take(ctx context.Context, prefix string, now time.Time) (float64, bool, error) {
+	key := fmt.Sprintf("%s/%s", t.config.Name, prefix)
+	rate := strconv.FormatFloat(t.config.ratePerMilli(), 'f', -1, 64)
+	// an expired bucket would have been refilled completely anyway
+	ttl := t.config.Window.Milliseconds()
+
+	result, err := tokenBucketScript.Run(ctx, t.redis, []string{key}, t.config.Cap, rate, now.UnixMilli(), ttl)
+	if err != nil {
+		return 0, false, fmt.Errorf("can not run token bucket script: %w", err)
+	}
+
+	values, ok := result.([]any)
+	if !ok || len(values) != 2 {
+		return 0, false, fmt.Errorf("unexpected result of token bucket script: %v", result)
+	}
+
+	taken, ok := values[0].(int64)
+	if !ok {
+		return 0, false, fmt.Errorf("unexpected result of token bucket script: %v", result)
+	}
+
+	tokens, err := strconv.ParseFloat(fmt.Sprint(values[1]), 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("unexpected result of token bucket script: %w", err)
+	}
+
+	return tokens, taken == 1, nil
+}
@@ -0,0 +1,56 @@
+package limit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/limit"
+	"github.com/stretchr/testify/suite"
+)
+
+type TokenBucketRedisTestSuite struct {
+	redisLimiterTestSuite
+}
+
+func TestTokenBucketRedisTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenBucketRedisTestSuite))
+}
+
+func (s *TokenBucketRedisTestSuite) TestTryTake() {
+	limiter, err := limit.NewTokenBucketRedisWithInterfaces(s.clock, s.client, limit.TokenBucketConfig{
+		Name:   "test",
+		Cap:    2,
+		Window: 2 * time.Second,
+	})
+	s.Require().NoError(err)
+
+	s.assertQuota(limiter, limit.Quota{Allowed: true, Limit: 2, Remaining: 1, Reset: time.Second})
+	s.assertQuota(limiter, limit.Quota{Allowed: true, Limit: 2, Remaining: 0, Reset: 2 * time.Second})
+	s.assertQuota(limiter, limit.Quota{Allowed: false, Limit: 2, Remaining: 0, Reset: 2 * time.Second, RetryAfter: time.Second})
+
+	s.clock.Advance(500 * time.Millisecond)
+	s.assertQuota(limiter, limit.Quota{Allowed: false, Limit: 2, Remaining: 0, Reset: 1500 * time.Millisecond, RetryAfter: 500 * time.Millisecond})
+
+	s.clock.Advance(500 * time.Millisecond)
+	s.assertQuota(limiter, limit.Quota{Allowed: true, Limit: 2, Remaining: 0, Reset: 2 * time.Second})
+
+	// the bucket doesn't hold more tokens than its capacity
+	s.clock.Advance(time.Minute)
+	s.assertQuota(limiter, limit.Quota{Allowed: true, Limit: 2, Remaining: 1, Reset: time.Second})
+}
+
+func (s *TokenBucketRedisTestSuite) TestInvalidConfig() {
+	_, err := limit.NewTokenBucketRedisWithInterfaces(s.clock, s.client, limit.TokenBucketConfig{
+		Name:   "test",
+		Cap:    0,
+		Window: time.Second,
+	})
+	s.EqualError(err, "the cap of the token bucket test has to be positive, got 0")
+
+	_, err = limit.NewTokenBucketRedisWithInterfaces(s.clock, s.client, limit.TokenBucketConfig{
+		Name:   "test",
+		Cap:    2,
+		Window: time.Microsecond,
+	})
+	s.EqualError(err, "the window of the token bucket test has to be at least a millisecond, got 1µs")
+}
@@ -2,6 +2,7 @@ package redis
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/justtrackio/gosoline/pkg/exec"
 	"github.com/justtrackio/gosoline/pkg/log"
 	"github.com/justtrackio/gosoline/pkg/reslife"
+	"github.com/justtrackio/gosoline/pkg/tlsx"
 	baseRedis "github.com/redis/go-redis/v9"
 )
 
@@ -49,6 +51,46 @@ type ZRangeArgs struct {
 	Count   int64
 }
 
+// XMessage is an entry of a stream.
+type XMessage struct {
+	ID     string
+	Values map[string]any
+}
+
+type XAddArgs struct {
+	Stream string
+	// ID of the new entry. Redis generates the id if it is empty.
+	ID string
+	// MaxLen trims the stream to MaxLen entries after adding the entry. Approx trims the stream only when whole macro
+	// nodes can be removed, which is much more efficient. A MaxLen of 0 disables the trimming.
+	MaxLen int64
+	Approx bool
+	Values any
+}
+
+type XReadGroupArgs struct {
+	Group    string
+	Consumer string
+	Stream   string
+	// ID ">" reads the entries which have never been delivered to a consumer of the group, any other id reads the
+	// pending entries of the consumer after this id.
+	ID    string
+	Count int64
+	// Block waits up to Block for new entries. A negative duration doesn't block at all, 0 blocks indefinitely.
+	Block time.Duration
+	NoAck bool
+}
+
+type XAutoClaimArgs struct {
+	Stream   string
+	Group    string
+	Consumer string
+	// MinIdle is the minimum time since the last delivery of a pending entry to claim it.
+	MinIdle time.Duration
+	Start   string
+	Count   int64
+}
+
 type redisCacheKey string
 
 func ProvideClient(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Client, error) {
@@ -136,6 +178,22 @@ type Client interface {
 	ZRem(ctx context.Context, key string, members ...string) (int64, error)
 	ZRevRank(ctx context.Context, key string, member string) (int64, error)
 
+	XAck(ctx context.Context, stream string, group string, ids ...string) (int64, error)
+	XAdd(ctx context.Context, args XAddArgs) (string, error)
+	XAutoClaim(ctx context.Context, args XAutoClaimArgs) ([]XMessage, string, error)
+	XDel(ctx context.Context, stream string, ids ...string) (int64, error)
+	XGroupCreateMkStream(ctx context.Context, stream string, group string, start string) error
+	XLen(ctx context.Context, stream string) (int64, error)
+	XPending(ctx context.Context, stream string, group string) (int64, error)
+	XReadGroup(ctx context.Context, args XReadGroupArgs) ([]XMessage, error)
+
+	Publish(ctx context.Context, channel string, message any) (int64, error)
+	Subscribe(ctx context.Context, channels ...string) (Subscription, error)
+
+	Eval(ctx context.Context, script string, keys []string, args ...any) (any, error)
+	EvalSha(ctx context.Context, sha1 string, keys []string, args ...any) (any, error)
+	ScriptLoad(ctx context.Context, script string) (string, error)
+
 	IsAlive(ctx context.Context) bool
 
 	Pipeline() Pipeliner
@@ -152,7 +210,7 @@ type redisClient struct {
 type redisBaseClientKey string
 
 func buildRedisBaseClientKey(settings *Settings) redisBaseClientKey {
-	return redisBaseClientKey(fmt.Sprintf("%s:%d:%s", settings.Address, settings.DB, settings.Dialer))
+	return redisBaseClientKey(fmt.Sprintf("%s:%s:%d:%s", settings.Mode, settings.Address, settings.DB, settings.Dialer))
 }
 
 func NewClient(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Client, error) {
@@ -190,19 +248,20 @@ func NewClientWithSettings(ctx context.Context, config cfg.Config, logger log.Lo
 		}
 	}
 
+	// redis hashes only the part of a key between the first { and the following } if there is one. A prefix
+	// containing braces would override the hash tags of the keys and put all keys into the same slot of a cluster
+	if strings.ContainsAny(keyPrefix, "{}") {
+		return nil, fmt.Errorf("redis key prefix %q must not contain braces as they would override the hash tags of the keys", keyPrefix)
+	}
+
 	executor := NewExecutor(logger, settings.BackoffSettings, settings.Name)
 
 	if _, ok := dialers[settings.Dialer]; !ok {
 		return nil, fmt.Errorf("there is no redis dialer of type %s", settings.Dialer)
 	}
 
-	baseClient, err := appctx.Provide(ctx, buildRedisBaseClientKey(settings), func() (*baseRedis.Client, error) {
-		baseClient := baseRedis.NewClient(&baseRedis.Options{
-			DB:     settings.DB,
-			Dialer: dialers[settings.Dialer](logger, settings),
-		})
-
-		return baseClient, nil
+	baseClient, err := appctx.Provide(ctx, buildRedisBaseClientKey(settings), func() (baseRedis.UniversalClient, error) {
+		return newBaseClient(ctx, config, logger, settings)
 	})
 	if err != nil {
 		return nil, err
@@ -211,6 +270,68 @@ func NewClientWithSettings(ctx context.Context, config cfg.Config, logger log.Lo
 	return NewClientWithInterfaces(logger, baseClient, executor, settings, keyPrefix), nil
 }
 
+// newBaseClient creates the go-redis client for the mode of the settings: a client of a single instance, a cluster
+// client or a failover client which finds the master using the sentinels.
+func newBaseClient(ctx context.Context, config cfg.Config, logger log.Logger, settings *Settings) (baseRedis.UniversalClient, error) {
+	var err error
+	var tlsConfig *tls.Config
+	var credentials CredentialsProvider
+	var addresses []string
+
+	if tlsConfig, err = tlsx.NewClientConfig(logger, settings.Tls); err != nil {
+		return nil, fmt.Errorf("can not create tls config for redis %s: %w", settings.Name, err)
+	}
+
+	if credentials, err = NewCredentialsProvider(ctx, config, logger, settings.Auth); err != nil {
+		return nil, fmt.Errorf("can not create credentials provider for redis %s: %w", settings.Name, err)
+	}
+
+	dialer := dialers[settings.Dialer](logger, settings, tlsConfig)
+
+	switch settings.Mode {
+	case "", ModeSingle:
+		return baseRedis.NewClient(&baseRedis.Options{
+			DB:                         settings.DB,
+			Dialer:                     dialer,
+			CredentialsProviderContext: credentials,
+		}), nil
+	case ModeCluster:
+		if settings.DB != 0 {
+			return nil, fmt.Errorf("redis %s: a cluster only supports the db 0, got %d", settings.Name, settings.DB)
+		}
+
+		if addresses, err = resolveAddresses(settings); err != nil {
+			return nil, fmt.Errorf("can not resolve the addresses of the redis cluster %s: %w", settings.Name, err)
+		}
+
+		return baseRedis.NewClusterClient(&baseRedis.ClusterOptions{
+			Addrs:                      addresses,
+			Dialer:                     dialer,
+			CredentialsProviderContext: credentials,
+		}), nil
+	case ModeSentinel:
+		if settings.Sentinel.MasterName == "" {
+			return nil, fmt.Errorf("redis %s: the sentinel mode requires a master name", settings.Name)
+		}
+
+		if addresses, err = resolveAddresses(settings); err != nil {
+			return nil, fmt.Errorf("can not resolve the addresses of the redis sentinels %s: %w", settings.Name, err)
+		}
+
+		return baseRedis.NewFailoverClient(&baseRedis.FailoverOptions{
+			MasterName:                 settings.Sentinel.MasterName,
+			SentinelAddrs:              addresses,
+			SentinelUsername:           settings.Sentinel.Username,
+			SentinelPassword:           settings.Sentinel.Password,
+			DB:                         settings.DB,
+			Dialer:                     dialer,
+			CredentialsProviderContext: credentials,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown redis mode %s, expected one of %s, %s or %s", settings.Mode, ModeSingle, ModeCluster, ModeSentinel)
+	}
+}
+
 func NewClientWithInterfaces(logger log.Logger, baseRedis baseRedis.Cmdable, executor exec.Executor, settings *Settings, keyPrefix string) Client {
 	return &redisClient{
 		logger:    logger,
@@ -237,12 +358,34 @@ func (c *redisClient) Exists(ctx context.Context, keys ...string) (int64, error)
 
 func (c *redisClient) FlushDB(ctx context.Context) (string, error) {
 	cmd, err := c.execute(ctx, func() ErrCmder {
+		if cluster, ok := c.base.(*baseRedis.ClusterClient); ok {
+			return flushCluster(ctx, cluster)
+		}
+
 		return c.base.FlushDB(ctx)
 	})
 
 	return cmd.(*baseRedis.StatusCmd).Val(), err
 }
 
+// flushCluster flushes every master of the cluster, as FLUSHDB only flushes the node it is sent to.
+func flushCluster(ctx context.Context, cluster *baseRedis.ClusterClient) *baseRedis.StatusCmd {
+	cmd := baseRedis.NewStatusCmd(ctx, "flushdb")
+
+	err := cluster.ForEachMaster(ctx, func(ctx context.Context, client *baseRedis.Client) error {
+		return client.FlushDB(ctx).Err()
+	})
+	if err != nil {
+		cmd.SetErr(err)
+
+		return cmd
+	}
+
+	cmd.SetVal("OK")
+
+	return cmd
+}
+
 func (c *redisClient) DBSize(ctx context.Context) (int64, error) {
 	cmd, err := c.execute(ctx, func() ErrCmder {
 		return c.base.DBSize(ctx)
@@ -783,6 +926,132 @@ func (c *redisClient) ZRevRank(ctx context.Context, key string, member string) (
 	return cmd.(*baseRedis.IntCmd).Val(), err
 }
 
+func (c *redisClient) XAck(ctx context.Context, stream string, group string, ids ...string) (int64, error) {
+	cmd, err := c.executePrefixedKey(ctx, func(stream string) ErrCmder {
+		return c.base.XAck(ctx, stream, group, ids...)
+	}, stream)
+
+	return cmd.(*baseRedis.IntCmd).Val(), err
+}
+
+func (c *redisClient) XAdd(ctx context.Context, args XAddArgs) (string, error) {
+	cmd, err := c.executePrefixedKey(ctx, func(stream string) ErrCmder {
+		return c.base.XAdd(ctx, &baseRedis.XAddArgs{
+			Stream: stream,
+			MaxLen: args.MaxLen,
+			Approx: args.Approx,
+			ID:     args.ID,
+			Values: args.Values,
+		})
+	}, args.Stream)
+
+	return cmd.(*baseRedis.StringCmd).Val(), err
+}
+
+func (c *redisClient) XAutoClaim(ctx context.Context, args XAutoClaimArgs) ([]XMessage, string, error) {
+	cmd, err := c.executePrefixedKey(ctx, func(stream string) ErrCmder {
+		return c.base.XAutoClaim(ctx, &baseRedis.XAutoClaimArgs{
+			Stream:   stream,
+			Group:    args.Group,
+			Consumer: args.Consumer,
+			MinIdle:  args.MinIdle,
+			Start:    args.Start,
+			Count:    args.Count,
+		})
+	}, args.Stream)
+
+	messages, start := cmd.(*baseRedis.XAutoClaimCmd).Val()
+
+	return c.toGosolineXMessages(messages), start, err
+}
+
+func (c *redisClient) XDel(ctx context.Context, stream string, ids ...string) (int64, error) {
+	cmd, err := c.executePrefixedKey(ctx, func(stream string) ErrCmder {
+		return c.base.XDel(ctx, stream, ids...)
+	}, stream)
+
+	return cmd.(*baseRedis.IntCmd).Val(), err
+}
+
+// XGroupCreateMkStream creates the consumer group and the stream if it doesn't exist yet. It fails with an error for
+// which IsGroupExistsError returns true if the group exists already.
+func (c *redisClient) XGroupCreateMkStream(ctx context.Context, stream string, group string, start string) error {
+	_, err := c.executePrefixedKey(ctx, func(stream string) ErrCmder {
+		return c.base.XGroupCreateMkStream(ctx, stream, group, start)
+	}, stream)
+
+	return err
+}
+
+func (c *redisClient) XLen(ctx context.Context, stream string) (int64, error) {
+	cmd, err := c.executePrefixedKey(ctx, func(stream string) ErrCmder {
+		return c.base.XLen(ctx, stream)
+	}, stream)
+
+	return cmd.(*baseRedis.IntCmd).Val(), err
+}
+
+// XPending returns the number of entries which have been delivered to the consumers of the group but haven't been
+// acknowledged yet.
+func (c *redisClient) XPending(ctx context.Context, stream string, group string) (int64, error) {
+	cmd, err := c.executePrefixedKey(ctx, func(stream string) ErrCmder {
+		return c.base.XPending(ctx, stream, group)
+	}, stream)
+
+	pending := cmd.(*baseRedis.XPendingCmd).Val()
+	if pending == nil {
+		return 0, err
+	}
+
+	return pending.Count, err
+}
+
+// XReadGroup reads the entries of a single stream as a consumer of the group. It returns the error Nil if no entry
+// arrived within the block duration.
+func (c *redisClient) XReadGroup(ctx context.Context, args XReadGroupArgs) ([]XMessage, error) {
+	cmd, err := c.executePrefixedKey(ctx, func(stream string) ErrCmder {
+		return c.base.XReadGroup(ctx, &baseRedis.XReadGroupArgs{
+			Group:    args.Group,
+			Consumer: args.Consumer,
+			Streams:  []string{stream, args.ID},
+			Count:    args.Count,
+			Block:    args.Block,
+			NoAck:    args.NoAck,
+		})
+	}, args.Stream)
+
+	messages := make([]XMessage, 0)
+	for _, stream := range cmd.(*baseRedis.XStreamSliceCmd).Val() {
+		messages = append(messages, c.toGosolineXMessages(stream.Messages)...)
+	}
+
+	return messages, err
+}
+
+func (c *redisClient) Eval(ctx context.Context, script string, keys []string, args ...any) (any, error) {
+	cmd, err := c.executePrefixed(ctx, func(keys ...string) ErrCmder {
+		return c.base.Eval(ctx, script, keys, args...)
+	}, keys...)
+
+	return cmd.(*baseRedis.Cmd).Val(), err
+}
+
+func (c *redisClient) EvalSha(ctx context.Context, sha1 string, keys []string, args ...any) (any, error) {
+	cmd, err := c.executePrefixed(ctx, func(keys ...string) ErrCmder {
+		return c.base.EvalSha(ctx, sha1, keys, args...)
+	}, keys...)
+
+	return cmd.(*baseRedis.Cmd).Val(), err
+}
+
+func (c *redisClient) ScriptLoad(ctx context.Context, script string) (string, error) {
+	cmd, err := c.execute(ctx, func() ErrCmder {
+		return c.base.ScriptLoad(ctx, script)
+	})
+
+	return cmd.(*baseRedis.StringCmd).Val(), err
+}
+
 func (c *redisClient) IsAlive(ctx context.Context) bool {
 	cmd, err := c.execute(ctx, func() ErrCmder {
 		return c.base.Ping(ctx)
@@ -900,6 +1169,18 @@ func (c *redisClient) toGosolineZs(zs []baseRedis.Z) []Z {
 	return result
 }
 
+func (c *redisClient) toGosolineXMessages(messages []baseRedis.XMessage) []XMessage {
+	result := make([]XMessage, len(messages))
+	for i := range messages {
+		result[i] = XMessage{
+			ID:     messages[i].ID,
+			Values: messages[i].Values,
+		}
+	}
+
+	return result
+}
+
 func (c *redisClient) toGoRedisZs(zs []Z) []baseRedis.Z {
 	result := make([]baseRedis.Z, len(zs))
 	for i := range zs {
@@ -832,3 +832,70 @@ func (p *prefixedPipeliner) ZRevRank(ctx context.Context, key, member string) *b
 
 	return p.Pipeliner.ZRevRank(ctx, key, member)
 }
+
+// ---------- Stream operations ----------
+
+func (p *prefixedPipeliner) XAck(ctx context.Context, stream, group string, ids ...string) *baseRedis.IntCmd {
+	stream, err := p.client.prefixKey(stream)
+	if err != nil {
+		cmd := baseRedis.NewIntCmd(ctx, "xack")
+		cmd.SetErr(err)
+
+		return cmd
+	}
+
+	return p.Pipeliner.XAck(ctx, stream, group, ids...)
+}
+
+func (p *prefixedPipeliner) XAdd(ctx context.Context, a *baseRedis.XAddArgs) *baseRedis.StringCmd {
+	stream, err := p.client.prefixKey(a.Stream)
+	if err != nil {
+		cmd := baseRedis.NewStringCmd(ctx, "xadd")
+		cmd.SetErr(err)
+
+		return cmd
+	}
+
+	args := *a
+	args.Stream = stream
+
+	return p.Pipeliner.XAdd(ctx, &args)
+}
+
+func (p *prefixedPipeliner) XDel(ctx context.Context, stream string, ids ...string) *baseRedis.IntCmd {
+	stream, err := p.client.prefixKey(stream)
+	if err != nil {
+		cmd := baseRedis.NewIntCmd(ctx, "xdel")
+		cmd.SetErr(err)
+
+		return cmd
+	}
+
+	return p.Pipeliner.XDel(ctx, stream, ids...)
+}
+
+func (p *prefixedPipeliner) XLen(ctx context.Context, stream string) *baseRedis.IntCmd {
+	stream, err := p.client.prefixKey(stream)
+	if err != nil {
+		cmd := baseRedis.NewIntCmd(ctx, "xlen")
+		cmd.SetErr(err)
+
+		return cmd
+	}
+
+	return p.Pipeliner.XLen(ctx, stream)
+}
+
+// ---------- Pub/Sub operations ----------
+
+func (p *prefixedPipeliner) Publish(ctx context.Context, channel string, message any) *baseRedis.IntCmd {
+	channel, err := p.client.prefixKey(channel)
+	if err != nil {
+		cmd := baseRedis.NewIntCmd(ctx, "publish")
+		cmd.SetErr(err)
+
+		return cmd
+	}
+
+	return p.Pipeliner.Publish(ctx, channel, message)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/elliotchance/redismock/v9"
+	"github.com/justtrackio/gosoline/pkg/appctx"
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	cfgMocks "github.com/justtrackio/gosoline/pkg/cfg/mocks"
 	"github.com/justtrackio/gosoline/pkg/exec"
@@ -88,6 +89,105 @@ func (s *ClientWithMiniRedisTestSuite) TestNewClientWithSettings_FormatError() {
 	s.Contains(err.Error(), "redis key naming failed")
 }
 
+func (s *ClientWithMiniRedisTestSuite) TestNewClientWithSettings_HashTagPrefix() {
+	s.settings.Naming.KeyPattern = "{app.name}-{key}"
+	s.settings.Identity = cfg.Identity{
+		Name: "{app}",
+		Env:  "env",
+	}
+
+	client, err := redis.NewClientWithSettings(s.T().Context(), s.config, s.logger, s.settings)
+
+	s.Nil(client)
+	s.EqualError(err, `redis key prefix "{app}-" must not contain braces as they would override the hash tags of the keys`)
+}
+
+func (s *ClientWithMiniRedisTestSuite) newClientWithSettings() (redis.Client, error) {
+	s.settings.Name = "test"
+	s.settings.Dialer = redis.DialerTcp
+	s.settings.Address = s.server.Addr()
+	s.settings.BackoffSettings = exec.BackoffSettings{
+		InitialInterval: time.Millisecond,
+		MaxAttempts:     1,
+		MaxInterval:     time.Millisecond,
+		MaxElapsedTime:  time.Second,
+	}
+
+	ctx := appctx.WithContainer(s.T().Context())
+
+	return redis.NewClientWithSettings(ctx, s.config, s.logger, s.settings)
+}
+
+func (s *ClientWithMiniRedisTestSuite) TestNewClientWithSettings_Auth() {
+	s.server.RequireUserAuth("app", "secret")
+	s.settings.Auth = redis.AuthSettings{
+		Username: "app",
+		Password: "secret",
+	}
+
+	client, err := s.newClientWithSettings()
+	s.Require().NoError(err)
+
+	s.NoError(client.Set(s.T().Context(), "key", "value", 0))
+	s.Equal("value", mustGet(s.server, "key"))
+}
+
+func (s *ClientWithMiniRedisTestSuite) TestNewClientWithSettings_AuthFailed() {
+	s.server.RequireUserAuth("app", "secret")
+	s.settings.Auth = redis.AuthSettings{
+		Username: "app",
+		Password: "wrong",
+	}
+
+	client, err := s.newClientWithSettings()
+	s.Require().NoError(err)
+
+	s.ErrorContains(client.Set(s.T().Context(), "key", "value", 0), "WRONGPASS")
+}
+
+func (s *ClientWithMiniRedisTestSuite) TestNewClientWithSettings_Cluster() {
+	s.settings.Mode = redis.ModeCluster
+
+	client, err := s.newClientWithSettings()
+	s.Require().NoError(err)
+
+	ctx := s.T().Context()
+	s.NoError(client.Set(ctx, "{user}:name", "value", 0))
+	s.Equal("value", mustGet(s.server, "{user}:name"))
+
+	_, err = client.FlushDB(ctx)
+	s.NoError(err)
+	s.False(s.server.Exists("{user}:name"))
+}
+
+func (s *ClientWithMiniRedisTestSuite) TestNewClientWithSettings_ClusterDb() {
+	s.settings.Mode = redis.ModeCluster
+	s.settings.DB = 1
+
+	client, err := s.newClientWithSettings()
+
+	s.Nil(client)
+	s.EqualError(err, "redis test: a cluster only supports the db 0, got 1")
+}
+
+func (s *ClientWithMiniRedisTestSuite) TestNewClientWithSettings_SentinelWithoutMaster() {
+	s.settings.Mode = redis.ModeSentinel
+
+	client, err := s.newClientWithSettings()
+
+	s.Nil(client)
+	s.EqualError(err, "redis test: the sentinel mode requires a master name")
+}
+
+func mustGet(server *miniredis.Miniredis, key string) string {
+	value, err := server.Get(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
 func (s *ClientWithMiniRedisTestSuite) newPrefixedClient() redis.Client {
 	executor := exec.NewDefaultExecutor()
 
@@ -1055,6 +1155,25 @@ func (s *ClientWithMiniRedisTestSuite) TestPipeline() {
 	s.True(s.server.Exists("pipe-key"))
 }
 
+func (s *ClientWithMiniRedisTestSuite) TestScript() {
+	ctx := s.T().Context()
+	client := s.newPrefixedClient()
+	script := redis.NewScript(`return redis.call("INCRBY", KEYS[1], ARGV[1])`)
+
+	// the first run falls back to EVAL, as the script is not loaded yet
+	result, err := script.Run(ctx, client, []string{"counter"}, 2)
+	s.NoError(err)
+	s.Equal(int64(2), result)
+
+	result, err = script.Run(ctx, client, []string{"counter"}, 3)
+	s.NoError(err)
+	s.Equal(int64(5), result)
+
+	val, err := s.server.Get("my-prefix-counter")
+	s.NoError(err)
+	s.Equal("5", val)
+}
+
 func (s *ClientWithMiniRedisTestSuite) TestGetNotFound() {
 	// the logger should fail the test as soon as any logger.Warn or anything gets called
 	// because we want to test the executor not doing that
@@ -1718,6 +1837,135 @@ func (s *ClientWithMiniRedisTestSuite) TestExpire() {
 	s.NoError(err, "there should be no error on Exists")
 }
 
+func (s *ClientWithMiniRedisTestSuite) TestStreams() {
+	ctx := s.T().Context()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.server.SetTime(now)
+
+	err := s.client.XGroupCreateMkStream(ctx, "stream", "group", "0")
+	s.NoError(err, "there should be no error on XGroupCreateMkStream")
+
+	err = s.client.XGroupCreateMkStream(ctx, "stream", "group", "0")
+	s.True(redis.IsGroupExistsError(err), "creating an existing group should fail with a group exists error")
+
+	// miniredis doesn't support the exact trimming with "=", but it always trims exactly
+	for _, value := range []string{"a", "b", "c"} {
+		_, err = s.client.XAdd(ctx, redis.XAddArgs{
+			Stream: "stream",
+			MaxLen: 2,
+			Approx: true,
+			Values: map[string]any{"value": value},
+		})
+		s.NoError(err, "there should be no error on XAdd")
+	}
+
+	length, err := s.client.XLen(ctx, "stream")
+	s.NoError(err, "there should be no error on XLen")
+	s.Equal(int64(2), length, "the stream should have been trimmed")
+
+	messages, err := s.client.XReadGroup(ctx, redis.XReadGroupArgs{
+		Group:    "group",
+		Consumer: "consumer-a",
+		Stream:   "stream",
+		ID:       ">",
+		Count:    10,
+		Block:    -1,
+	})
+	s.NoError(err, "there should be no error on XReadGroup")
+	s.Len(messages, 2)
+	s.Equal(map[string]any{"value": "b"}, messages[0].Values)
+	s.Equal(map[string]any{"value": "c"}, messages[1].Values)
+
+	_, err = s.client.XReadGroup(ctx, redis.XReadGroupArgs{
+		Group:    "group",
+		Consumer: "consumer-a",
+		Stream:   "stream",
+		ID:       ">",
+		Block:    time.Millisecond,
+	})
+	s.ErrorIs(err, redis.Nil, "there should be no new entries")
+
+	acked, err := s.client.XAck(ctx, "stream", "group", messages[0].ID)
+	s.NoError(err, "there should be no error on XAck")
+	s.Equal(int64(1), acked)
+
+	pending, err := s.client.XPending(ctx, "stream", "group")
+	s.NoError(err, "there should be no error on XPending")
+	s.Equal(int64(1), pending)
+
+	claimArgs := redis.XAutoClaimArgs{
+		Stream:   "stream",
+		Group:    "group",
+		Consumer: "consumer-b",
+		MinIdle:  time.Minute,
+		Start:    "0-0",
+		Count:    10,
+	}
+
+	claimed, _, err := s.client.XAutoClaim(ctx, claimArgs)
+	s.NoError(err, "there should be no error on XAutoClaim")
+	s.Empty(claimed, "the pending entry isn't idle long enough to be claimed")
+
+	s.server.SetTime(now.Add(time.Minute))
+
+	claimed, _, err = s.client.XAutoClaim(ctx, claimArgs)
+	s.NoError(err, "there should be no error on XAutoClaim")
+	s.Equal([]redis.XMessage{messages[1]}, claimed)
+
+	deleted, err := s.client.XDel(ctx, "stream", messages[0].ID, messages[1].ID)
+	s.NoError(err, "there should be no error on XDel")
+	s.Equal(int64(2), deleted)
+}
+
+func (s *ClientWithMiniRedisTestSuite) TestStreams_Prefixed() {
+	ctx := s.T().Context()
+	client := redis.NewClientWithInterfaces(s.logger, s.baseClient, exec.NewDefaultExecutor(), s.settings, "pfx-")
+
+	_, err := client.XAdd(ctx, redis.XAddArgs{
+		Stream: "stream",
+		Values: map[string]any{"value": "a"},
+	})
+	s.NoError(err, "there should be no error on XAdd")
+
+	length, err := s.baseClient.XLen(ctx, "pfx-stream").Result()
+	s.NoError(err)
+	s.Equal(int64(1), length, "the entry should have been added to the prefixed stream")
+
+	pipe := client.Pipeline()
+	pipe.XAdd(ctx, &baseRedis.XAddArgs{
+		Stream: "stream",
+		Values: map[string]any{"value": "b"},
+	})
+	xlen := pipe.XLen(ctx, "stream")
+	_, err = pipe.Exec(ctx)
+	s.NoError(err, "there should be no error on Exec")
+	s.Equal(int64(2), xlen.Val())
+}
+
+func (s *ClientWithMiniRedisTestSuite) TestPublishSubscribe() {
+	ctx := s.T().Context()
+	client := redis.NewClientWithInterfaces(s.logger, s.baseClient, exec.NewDefaultExecutor(), s.settings, "pfx-")
+
+	sub, err := client.Subscribe(ctx, "channel")
+	s.NoError(err, "there should be no error on Subscribe")
+
+	receivers, err := client.Publish(ctx, "channel", "payload")
+	s.NoError(err, "there should be no error on Publish")
+	s.Equal(int64(1), receivers)
+
+	select {
+	case msg := <-sub.Channel():
+		s.Equal(&redis.PubSubMessage{Channel: "channel", Payload: "payload"}, msg)
+	case <-time.After(time.Second):
+		s.FailNow("the message should have been received")
+	}
+
+	s.NoError(sub.Close(), "there should be no error on Close")
+
+	_, ok := <-sub.Channel()
+	s.False(ok, "the channel should be closed")
+}
+
 func (s *ClientWithMiniRedisTestSuite) TestIsAlive() {
 	alive := s.client.IsAlive(s.T().Context())
 	s.True(alive)
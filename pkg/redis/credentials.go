@@ -0,0 +1,116 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	gosoSecretsManager "github.com/justtrackio/gosoline/pkg/cloud/aws/secretsmanager"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+// CredentialsProvider provides the username and password for new connections to redis.
+type CredentialsProvider func(ctx context.Context) (username string, password string, err error)
+
+type secretCredentials struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// secretCredentialsProvider reads the credentials from a secrets manager secret and caches them for the refresh
+// interval of the settings.
+type secretCredentialsProvider struct {
+	logger   log.Logger
+	client   gosoSecretsManager.Client
+	clock    clock.Clock
+	settings AuthSettings
+
+	lck         sync.Mutex
+	credentials *secretCredentials
+	readAt      time.Time
+}
+
+// NewCredentialsProvider returns the provider of the credentials configured by the auth settings. It returns nil if
+// no credentials are configured.
+func NewCredentialsProvider(ctx context.Context, config cfg.Config, logger log.Logger, settings AuthSettings) (CredentialsProvider, error) {
+	if settings.SecretName == "" {
+		if settings.Username == "" && settings.Password == "" {
+			return nil, nil
+		}
+
+		return func(context.Context) (string, string, error) {
+			return settings.Username, settings.Password, nil
+		}, nil
+	}
+
+	client, err := gosoSecretsManager.ProvideClient(ctx, config, logger, settings.SecretsManagerClient)
+	if err != nil {
+		return nil, fmt.Errorf("can not create secrets manager client: %w", err)
+	}
+
+	return NewSecretCredentialsProviderWithInterfaces(logger, client, clock.Provider, settings), nil
+}
+
+func NewSecretCredentialsProviderWithInterfaces(logger log.Logger, client gosoSecretsManager.Client, clock clock.Clock, settings AuthSettings) CredentialsProvider {
+	provider := &secretCredentialsProvider{
+		logger:   logger,
+		client:   client,
+		clock:    clock,
+		settings: settings,
+	}
+
+	return provider.Credentials
+}
+
+func (p *secretCredentialsProvider) Credentials(ctx context.Context) (string, string, error) {
+	p.lck.Lock()
+	defer p.lck.Unlock()
+
+	if p.credentials == nil || p.clock.Since(p.readAt) >= p.settings.SecretRefreshInterval {
+		credentials, err := p.read(ctx)
+
+		switch {
+		case err == nil:
+			p.credentials = credentials
+			p.readAt = p.clock.Now()
+		case p.credentials == nil:
+			return "", "", err
+		default:
+			// keep using the known credentials, they are most likely still valid
+			p.logger.Warn(ctx, "can not refresh redis credentials: %s", err)
+		}
+	}
+
+	username := p.credentials.Username
+	if username == "" {
+		username = p.settings.Username
+	}
+
+	return username, p.credentials.Password, nil
+}
+
+func (p *secretCredentialsProvider) read(ctx context.Context) (*secretCredentials, error) {
+	out, err := p.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(p.settings.SecretName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can not read redis credentials from secret %s: %w", p.settings.SecretName, err)
+	}
+
+	credentials := &secretCredentials{}
+	if err = json.Unmarshal([]byte(aws.ToString(out.SecretString)), credentials); err != nil {
+		return nil, fmt.Errorf("can not unmarshal redis credentials of secret %s: %w", p.settings.SecretName, err)
+	}
+
+	if credentials.Password == "" {
+		return nil, fmt.Errorf("the secret %s does not contain a password", p.settings.SecretName)
+	}
+
+	return credentials, nil
+}
@@ -0,0 +1,119 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	secretsManagerMocks "github.com/justtrackio/gosoline/pkg/cloud/aws/secretsmanager/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/redis"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CredentialsTestSuite struct {
+	suite.Suite
+
+	ctx      context.Context
+	clock    clock.FakeClock
+	client   *secretsManagerMocks.Client
+	provider redis.CredentialsProvider
+}
+
+func TestCredentialsTestSuite(t *testing.T) {
+	suite.Run(t, new(CredentialsTestSuite))
+}
+
+func (s *CredentialsTestSuite) SetupTest() {
+	s.ctx = s.T().Context()
+	s.clock = clock.NewFakeClock()
+	s.client = secretsManagerMocks.NewClient(s.T())
+
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	s.provider = redis.NewSecretCredentialsProviderWithInterfaces(logger, s.client, s.clock, redis.AuthSettings{
+		Username:              "default-user",
+		SecretName:            "redis-credentials",
+		SecretRefreshInterval: time.Minute,
+	})
+}
+
+func (s *CredentialsTestSuite) expectSecret(secret string, err error) {
+	var out *secretsmanager.GetSecretValueOutput
+	if err == nil {
+		out = &secretsmanager.GetSecretValueOutput{
+			SecretString: aws.String(secret),
+		}
+	}
+
+	s.client.EXPECT().GetSecretValue(matcher.Context, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String("redis-credentials"),
+	}).Return(out, err).Once()
+}
+
+func (s *CredentialsTestSuite) TestCachedAndRefreshed() {
+	s.expectSecret(`{"username":"app","password":"first"}`, nil)
+
+	for i := 0; i < 2; i++ {
+		username, password, err := s.provider(s.ctx)
+		s.NoError(err)
+		s.Equal("app", username)
+		s.Equal("first", password)
+	}
+
+	s.clock.Advance(time.Minute)
+	s.expectSecret(`{"password":"second"}`, nil)
+
+	username, password, err := s.provider(s.ctx)
+	s.NoError(err)
+	s.Equal("default-user", username)
+	s.Equal("second", password)
+}
+
+func (s *CredentialsTestSuite) TestKeepCredentialsIfRefreshFails() {
+	s.expectSecret(`{"username":"app","password":"first"}`, nil)
+
+	_, _, err := s.provider(s.ctx)
+	s.NoError(err)
+
+	s.clock.Advance(time.Minute)
+	s.expectSecret("", assert.AnError)
+
+	username, password, err := s.provider(s.ctx)
+	s.NoError(err)
+	s.Equal("app", username)
+	s.Equal("first", password)
+}
+
+func (s *CredentialsTestSuite) TestInvalidSecret() {
+	s.expectSecret(`{"username":"app"}`, nil)
+
+	_, _, err := s.provider(s.ctx)
+	s.EqualError(err, "the secret redis-credentials does not contain a password")
+
+	s.expectSecret("", assert.AnError)
+
+	_, _, err = s.provider(s.ctx)
+	s.ErrorIs(err, assert.AnError)
+}
+
+func (s *CredentialsTestSuite) TestStaticCredentials() {
+	provider, err := redis.NewCredentialsProvider(s.ctx, nil, nil, redis.AuthSettings{
+		Username: "app",
+		Password: "secret",
+	})
+	s.Require().NoError(err)
+
+	username, password, err := provider(s.ctx)
+	s.NoError(err)
+	s.Equal("app", username)
+	s.Equal("secret", password)
+
+	provider, err = redis.NewCredentialsProvider(s.ctx, nil, nil, redis.AuthSettings{})
+	s.NoError(err)
+	s.Nil(provider)
+}
@@ -2,6 +2,7 @@ package redis
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 
@@ -20,34 +21,93 @@ var dialers = map[string]Dialer{
 }
 
 type (
-	Dialer           func(logger log.Logger, settings *Settings) func(context.Context, string, string) (net.Conn, error)
+	Dialer           func(logger log.Logger, settings *Settings, tlsConfig *tls.Config) func(context.Context, string, string) (net.Conn, error)
 	SrvNamingFactory func(identity cfg.Identity, name string) string
 )
 
-func dialerSrv(logger log.Logger, settings *Settings) func(ctx context.Context, network, addr string) (net.Conn, error) {
-	return func(ctx context.Context, _ string, _ string) (net.Conn, error) {
-		_, srvs, err := net.LookupSRV("", "", settings.Address)
+func dialerSrv(logger log.Logger, settings *Settings, tlsConfig *tls.Config) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, _ string, addr string) (net.Conn, error) {
+		// the nodes of a cluster and the masters of sentinels are dialed with the addresses redis reports for them
+		if settings.Mode == ModeCluster || settings.Mode == ModeSentinel {
+			return dial(ctx, tlsConfig, addr)
+		}
+
+		addresses, err := lookupSrv(settings.Address)
 		if err != nil {
-			return nil, fmt.Errorf("can't lookup srv query for address %s: %w", settings.Address, err)
+			return nil, err
 		}
 
-		if len(srvs) != 1 {
-			return nil, fmt.Errorf("redis instance count mismatch. there should be exactly one redis instance, found: %v", len(srvs))
+		if len(addresses) != 1 {
+			return nil, fmt.Errorf("redis instance count mismatch. there should be exactly one redis instance, found: %v. use the mode %s or %s for several instances", len(addresses), ModeCluster, ModeSentinel)
 		}
 
-		address := fmt.Sprintf("%v:%v", srvs[0].Target, srvs[0].Port)
-		logger.Debug(ctx, "using address %s for redis %s", address, settings.Name)
+		logger.Debug(ctx, "using address %s for redis %s", addresses[0], settings.Name)
 
-		var d net.Dialer
-
-		return d.DialContext(ctx, "tcp", address)
+		return dial(ctx, tlsConfig, addresses[0])
 	}
 }
 
-func dialerTcp(logger log.Logger, settings *Settings) func(ctx context.Context, network, addr string) (net.Conn, error) {
-	return func(ctx context.Context, _ string, _ string) (net.Conn, error) {
+func dialerTcp(logger log.Logger, settings *Settings, tlsConfig *tls.Config) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, _ string, addr string) (net.Conn, error) {
+		if settings.Mode == ModeCluster || settings.Mode == ModeSentinel {
+			return dial(ctx, tlsConfig, addr)
+		}
+
 		logger.Debug(ctx, "using address %s for redis %s", settings.Address, settings.Name)
 
-		return net.Dial("tcp", settings.Address)
+		return dial(ctx, tlsConfig, settings.Address)
+	}
+}
+
+// resolveAddresses returns the addresses of the nodes of a cluster or of the sentinels, which the client initially
+// connects to. The srv dialer looks up the srv records of all configured addresses.
+func resolveAddresses(settings *Settings) ([]string, error) {
+	addresses := append([]string{settings.Address}, settings.Addresses...)
+
+	if settings.Dialer != DialerSrv {
+		return addresses, nil
+	}
+
+	resolved := make([]string, 0, len(addresses))
+
+	for _, address := range addresses {
+		targets, err := lookupSrv(address)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved = append(resolved, targets...)
 	}
+
+	return resolved, nil
+}
+
+func lookupSrv(address string) ([]string, error) {
+	_, srvs, err := net.LookupSRV("", "", address)
+	if err != nil {
+		return nil, fmt.Errorf("can't lookup srv query for address %s: %w", address, err)
+	}
+
+	addresses := make([]string, len(srvs))
+	for i, srv := range srvs {
+		addresses[i] = net.JoinHostPort(srv.Target, fmt.Sprint(srv.Port))
+	}
+
+	return addresses, nil
+}
+
+// dial connects to the address. With a tls config, the server certificate is verified for the host of the address
+// unless the config contains a server name.
+func dial(ctx context.Context, tlsConfig *tls.Config, address string) (net.Conn, error) {
+	if tlsConfig == nil {
+		var d net.Dialer
+
+		return d.DialContext(ctx, "tcp", address)
+	}
+
+	d := tls.Dialer{
+		Config: tlsConfig,
+	}
+
+	return d.DialContext(ctx, "tcp", address)
 }
@@ -25,6 +25,7 @@ func NewBackoffExecutor(logger log.Logger, settings exec.BackoffSettings, name s
 		RetryableErrorChecker,
 		OOMChecker,
 		NilChecker,
+		GroupExistsChecker,
 	}
 
 	return exec.NewBackoffExecutor(logger, executableResource, &settings, checks)
@@ -38,6 +39,16 @@ func NilChecker(_ any, err error) exec.ErrorType {
 	return exec.ErrorTypeUnknown
 }
 
+// GroupExistsChecker doesn't treat the creation of an existing consumer group as failure, as the group is usually
+// created by every consumer of the group on startup.
+func GroupExistsChecker(_ any, err error) exec.ErrorType {
+	if IsGroupExistsError(err) {
+		return exec.ErrorTypeOk
+	}
+
+	return exec.ErrorTypeUnknown
+}
+
 func OOMChecker(_ any, err error) exec.ErrorType {
 	if strings.HasPrefix(err.Error(), "OOM") {
 		return exec.ErrorTypeRetryable
@@ -81,3 +92,8 @@ func IsRetryableError(err error) bool {
 
 	return false
 }
+
+// IsGroupExistsError returns true if the error was caused by creating a consumer group which exists already.
+func IsGroupExistsError(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "BUSYGROUP ")
+}
@@ -340,6 +340,148 @@ func (_c *Client_Del_Call) RunAndReturn(run func(context.Context, ...string) (in
 	return _c
 }
 
+// Eval provides a mock function with given fields: ctx, script, keys, args
+func (_m *Client) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, script, keys)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Eval")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, ...interface{}) (interface{}, error)); ok {
+		return rf(ctx, script, keys, args...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, ...interface{}) interface{}); ok {
+		r0 = rf(ctx, script, keys, args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, ...interface{}) error); ok {
+		r1 = rf(ctx, script, keys, args...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_Eval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Eval'
+type Client_Eval_Call struct {
+	*mock.Call
+}
+
+// Eval is a helper method to define mock.On call
+//   - ctx context.Context
+//   - script string
+//   - keys []string
+//   - args ...interface{}
+func (_e *Client_Expecter) Eval(ctx interface{}, script interface{}, keys interface{}, args ...interface{}) *Client_Eval_Call {
+	return &Client_Eval_Call{Call: _e.mock.On("Eval",
+		append([]interface{}{ctx, script, keys}, args...)...)}
+}
+
+func (_c *Client_Eval_Call) Run(run func(ctx context.Context, script string, keys []string, args ...interface{})) *Client_Eval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Client_Eval_Call) Return(_a0 interface{}, _a1 error) *Client_Eval_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_Eval_Call) RunAndReturn(run func(context.Context, string, []string, ...interface{}) (interface{}, error)) *Client_Eval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EvalSha provides a mock function with given fields: ctx, sha1, keys, args
+func (_m *Client) EvalSha(ctx context.Context, sha1 string, keys []string, args ...interface{}) (interface{}, error) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, sha1, keys)
+	_ca = append(_ca, args...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EvalSha")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, ...interface{}) (interface{}, error)); ok {
+		return rf(ctx, sha1, keys, args...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, ...interface{}) interface{}); ok {
+		r0 = rf(ctx, sha1, keys, args...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, ...interface{}) error); ok {
+		r1 = rf(ctx, sha1, keys, args...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_EvalSha_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EvalSha'
+type Client_EvalSha_Call struct {
+	*mock.Call
+}
+
+// EvalSha is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sha1 string
+//   - keys []string
+//   - args ...interface{}
+func (_e *Client_Expecter) EvalSha(ctx interface{}, sha1 interface{}, keys interface{}, args ...interface{}) *Client_EvalSha_Call {
+	return &Client_EvalSha_Call{Call: _e.mock.On("EvalSha",
+		append([]interface{}{ctx, sha1, keys}, args...)...)}
+}
+
+func (_c *Client_EvalSha_Call) Run(run func(ctx context.Context, sha1 string, keys []string, args ...interface{})) *Client_EvalSha_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Client_EvalSha_Call) Return(_a0 interface{}, _a1 error) *Client_EvalSha_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_EvalSha_Call) RunAndReturn(run func(context.Context, string, []string, ...interface{}) (interface{}, error)) *Client_EvalSha_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Exists provides a mock function with given fields: ctx, keys
 func (_m *Client) Exists(ctx context.Context, keys ...string) (int64, error) {
 	_va := make([]interface{}, len(keys))
@@ -2027,6 +2169,64 @@ func (_c *Client_Pipeline_Call) RunAndReturn(run func() redis.Pipeliner) *Client
 	return _c
 }
 
+// Publish provides a mock function with given fields: ctx, channel, message
+func (_m *Client) Publish(ctx context.Context, channel string, message interface{}) (int64, error) {
+	ret := _m.Called(ctx, channel, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}) (int64, error)); ok {
+		return rf(ctx, channel, message)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, interface{}) int64); ok {
+		r0 = rf(ctx, channel, message)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, interface{}) error); ok {
+		r1 = rf(ctx, channel, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type Client_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channel string
+//   - message interface{}
+func (_e *Client_Expecter) Publish(ctx interface{}, channel interface{}, message interface{}) *Client_Publish_Call {
+	return &Client_Publish_Call{Call: _e.mock.On("Publish", ctx, channel, message)}
+}
+
+func (_c *Client_Publish_Call) Run(run func(ctx context.Context, channel string, message interface{})) *Client_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Client_Publish_Call) Return(_a0 int64, _a1 error) *Client_Publish_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_Publish_Call) RunAndReturn(run func(context.Context, string, interface{}) (int64, error)) *Client_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RPop provides a mock function with given fields: ctx, key
 func (_m *Client) RPop(ctx context.Context, key string) (string, error) {
 	ret := _m.Called(ctx, key)
@@ -3070,6 +3270,63 @@ func (_c *Client_SUnionStore_Call) RunAndReturn(run func(context.Context, string
 	return _c
 }
 
+// ScriptLoad provides a mock function with given fields: ctx, script
+func (_m *Client) ScriptLoad(ctx context.Context, script string) (string, error) {
+	ret := _m.Called(ctx, script)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScriptLoad")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, script)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, script)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, script)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_ScriptLoad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScriptLoad'
+type Client_ScriptLoad_Call struct {
+	*mock.Call
+}
+
+// ScriptLoad is a helper method to define mock.On call
+//   - ctx context.Context
+//   - script string
+func (_e *Client_Expecter) ScriptLoad(ctx interface{}, script interface{}) *Client_ScriptLoad_Call {
+	return &Client_ScriptLoad_Call{Call: _e.mock.On("ScriptLoad", ctx, script)}
+}
+
+func (_c *Client_ScriptLoad_Call) Run(run func(ctx context.Context, script string)) *Client_ScriptLoad_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Client_ScriptLoad_Call) Return(_a0 string, _a1 error) *Client_ScriptLoad_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_ScriptLoad_Call) RunAndReturn(run func(context.Context, string) (string, error)) *Client_ScriptLoad_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Set provides a mock function with given fields: ctx, key, value, ttl
 func (_m *Client) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
 	ret := _m.Called(ctx, key, value, ttl)
@@ -3178,6 +3435,570 @@ func (_c *Client_SetNX_Call) RunAndReturn(run func(context.Context, string, inte
 	return _c
 }
 
+// Subscribe provides a mock function with given fields: ctx, channels
+func (_m *Client) Subscribe(ctx context.Context, channels ...string) (redis.Subscription, error) {
+	_va := make([]interface{}, len(channels))
+	for _i := range channels {
+		_va[_i] = channels[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 redis.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) (redis.Subscription, error)); ok {
+		return rf(ctx, channels...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) redis.Subscription); ok {
+		r0 = rf(ctx, channels...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(redis.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = rf(ctx, channels...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type Client_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channels ...string
+func (_e *Client_Expecter) Subscribe(ctx interface{}, channels ...interface{}) *Client_Subscribe_Call {
+	return &Client_Subscribe_Call{Call: _e.mock.On("Subscribe",
+		append([]interface{}{ctx}, channels...)...)}
+}
+
+func (_c *Client_Subscribe_Call) Run(run func(ctx context.Context, channels ...string)) *Client_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Client_Subscribe_Call) Return(_a0 redis.Subscription, _a1 error) *Client_Subscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_Subscribe_Call) RunAndReturn(run func(context.Context, ...string) (redis.Subscription, error)) *Client_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// XAck provides a mock function with given fields: ctx, stream, group, ids
+func (_m *Client) XAck(ctx context.Context, stream string, group string, ids ...string) (int64, error) {
+	_va := make([]interface{}, len(ids))
+	for _i := range ids {
+		_va[_i] = ids[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, stream, group)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for XAck")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...string) (int64, error)); ok {
+		return rf(ctx, stream, group, ids...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...string) int64); ok {
+		r0 = rf(ctx, stream, group, ids...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, ...string) error); ok {
+		r1 = rf(ctx, stream, group, ids...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_XAck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'XAck'
+type Client_XAck_Call struct {
+	*mock.Call
+}
+
+// XAck is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stream string
+//   - group string
+//   - ids ...string
+func (_e *Client_Expecter) XAck(ctx interface{}, stream interface{}, group interface{}, ids ...interface{}) *Client_XAck_Call {
+	return &Client_XAck_Call{Call: _e.mock.On("XAck",
+		append([]interface{}{ctx, stream, group}, ids...)...)}
+}
+
+func (_c *Client_XAck_Call) Run(run func(ctx context.Context, stream string, group string, ids ...string)) *Client_XAck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Client_XAck_Call) Return(_a0 int64, _a1 error) *Client_XAck_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_XAck_Call) RunAndReturn(run func(context.Context, string, string, ...string) (int64, error)) *Client_XAck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// XAdd provides a mock function with given fields: ctx, args
+func (_m *Client) XAdd(ctx context.Context, args redis.XAddArgs) (string, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for XAdd")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, redis.XAddArgs) (string, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, redis.XAddArgs) string); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, redis.XAddArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_XAdd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'XAdd'
+type Client_XAdd_Call struct {
+	*mock.Call
+}
+
+// XAdd is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args redis.XAddArgs
+func (_e *Client_Expecter) XAdd(ctx interface{}, args interface{}) *Client_XAdd_Call {
+	return &Client_XAdd_Call{Call: _e.mock.On("XAdd", ctx, args)}
+}
+
+func (_c *Client_XAdd_Call) Run(run func(ctx context.Context, args redis.XAddArgs)) *Client_XAdd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(redis.XAddArgs))
+	})
+	return _c
+}
+
+func (_c *Client_XAdd_Call) Return(_a0 string, _a1 error) *Client_XAdd_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_XAdd_Call) RunAndReturn(run func(context.Context, redis.XAddArgs) (string, error)) *Client_XAdd_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// XAutoClaim provides a mock function with given fields: ctx, args
+func (_m *Client) XAutoClaim(ctx context.Context, args redis.XAutoClaimArgs) ([]redis.XMessage, string, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for XAutoClaim")
+	}
+
+	var r0 []redis.XMessage
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, redis.XAutoClaimArgs) ([]redis.XMessage, string, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, redis.XAutoClaimArgs) []redis.XMessage); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]redis.XMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, redis.XAutoClaimArgs) string); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, redis.XAutoClaimArgs) error); ok {
+		r2 = rf(ctx, args)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Client_XAutoClaim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'XAutoClaim'
+type Client_XAutoClaim_Call struct {
+	*mock.Call
+}
+
+// XAutoClaim is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args redis.XAutoClaimArgs
+func (_e *Client_Expecter) XAutoClaim(ctx interface{}, args interface{}) *Client_XAutoClaim_Call {
+	return &Client_XAutoClaim_Call{Call: _e.mock.On("XAutoClaim", ctx, args)}
+}
+
+func (_c *Client_XAutoClaim_Call) Run(run func(ctx context.Context, args redis.XAutoClaimArgs)) *Client_XAutoClaim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(redis.XAutoClaimArgs))
+	})
+	return _c
+}
+
+func (_c *Client_XAutoClaim_Call) Return(_a0 []redis.XMessage, _a1 string, _a2 error) *Client_XAutoClaim_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Client_XAutoClaim_Call) RunAndReturn(run func(context.Context, redis.XAutoClaimArgs) ([]redis.XMessage, string, error)) *Client_XAutoClaim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// XDel provides a mock function with given fields: ctx, stream, ids
+func (_m *Client) XDel(ctx context.Context, stream string, ids ...string) (int64, error) {
+	_va := make([]interface{}, len(ids))
+	for _i := range ids {
+		_va[_i] = ids[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, stream)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for XDel")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) (int64, error)); ok {
+		return rf(ctx, stream, ids...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) int64); ok {
+		r0 = rf(ctx, stream, ids...)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...string) error); ok {
+		r1 = rf(ctx, stream, ids...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_XDel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'XDel'
+type Client_XDel_Call struct {
+	*mock.Call
+}
+
+// XDel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stream string
+//   - ids ...string
+func (_e *Client_Expecter) XDel(ctx interface{}, stream interface{}, ids ...interface{}) *Client_XDel_Call {
+	return &Client_XDel_Call{Call: _e.mock.On("XDel",
+		append([]interface{}{ctx, stream}, ids...)...)}
+}
+
+func (_c *Client_XDel_Call) Run(run func(ctx context.Context, stream string, ids ...string)) *Client_XDel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Client_XDel_Call) Return(_a0 int64, _a1 error) *Client_XDel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_XDel_Call) RunAndReturn(run func(context.Context, string, ...string) (int64, error)) *Client_XDel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// XGroupCreateMkStream provides a mock function with given fields: ctx, stream, group, start
+func (_m *Client) XGroupCreateMkStream(ctx context.Context, stream string, group string, start string) error {
+	ret := _m.Called(ctx, stream, group, start)
+
+	if len(ret) == 0 {
+		panic("no return value specified for XGroupCreateMkStream")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, stream, group, start)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Client_XGroupCreateMkStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'XGroupCreateMkStream'
+type Client_XGroupCreateMkStream_Call struct {
+	*mock.Call
+}
+
+// XGroupCreateMkStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stream string
+//   - group string
+//   - start string
+func (_e *Client_Expecter) XGroupCreateMkStream(ctx interface{}, stream interface{}, group interface{}, start interface{}) *Client_XGroupCreateMkStream_Call {
+	return &Client_XGroupCreateMkStream_Call{Call: _e.mock.On("XGroupCreateMkStream", ctx, stream, group, start)}
+}
+
+func (_c *Client_XGroupCreateMkStream_Call) Run(run func(ctx context.Context, stream string, group string, start string)) *Client_XGroupCreateMkStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *Client_XGroupCreateMkStream_Call) Return(_a0 error) *Client_XGroupCreateMkStream_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_XGroupCreateMkStream_Call) RunAndReturn(run func(context.Context, string, string, string) error) *Client_XGroupCreateMkStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// XLen provides a mock function with given fields: ctx, stream
+func (_m *Client) XLen(ctx context.Context, stream string) (int64, error) {
+	ret := _m.Called(ctx, stream)
+
+	if len(ret) == 0 {
+		panic("no return value specified for XLen")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, stream)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, stream)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, stream)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_XLen_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'XLen'
+type Client_XLen_Call struct {
+	*mock.Call
+}
+
+// XLen is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stream string
+func (_e *Client_Expecter) XLen(ctx interface{}, stream interface{}) *Client_XLen_Call {
+	return &Client_XLen_Call{Call: _e.mock.On("XLen", ctx, stream)}
+}
+
+func (_c *Client_XLen_Call) Run(run func(ctx context.Context, stream string)) *Client_XLen_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Client_XLen_Call) Return(_a0 int64, _a1 error) *Client_XLen_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_XLen_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *Client_XLen_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// XPending provides a mock function with given fields: ctx, stream, group
+func (_m *Client) XPending(ctx context.Context, stream string, group string) (int64, error) {
+	ret := _m.Called(ctx, stream, group)
+
+	if len(ret) == 0 {
+		panic("no return value specified for XPending")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int64, error)); ok {
+		return rf(ctx, stream, group)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, stream, group)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, stream, group)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_XPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'XPending'
+type Client_XPending_Call struct {
+	*mock.Call
+}
+
+// XPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stream string
+//   - group string
+func (_e *Client_Expecter) XPending(ctx interface{}, stream interface{}, group interface{}) *Client_XPending_Call {
+	return &Client_XPending_Call{Call: _e.mock.On("XPending", ctx, stream, group)}
+}
+
+func (_c *Client_XPending_Call) Run(run func(ctx context.Context, stream string, group string)) *Client_XPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Client_XPending_Call) Return(_a0 int64, _a1 error) *Client_XPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_XPending_Call) RunAndReturn(run func(context.Context, string, string) (int64, error)) *Client_XPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// XReadGroup provides a mock function with given fields: ctx, args
+func (_m *Client) XReadGroup(ctx context.Context, args redis.XReadGroupArgs) ([]redis.XMessage, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for XReadGroup")
+	}
+
+	var r0 []redis.XMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, redis.XReadGroupArgs) ([]redis.XMessage, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, redis.XReadGroupArgs) []redis.XMessage); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]redis.XMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, redis.XReadGroupArgs) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_XReadGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'XReadGroup'
+type Client_XReadGroup_Call struct {
+	*mock.Call
+}
+
+// XReadGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args redis.XReadGroupArgs
+func (_e *Client_Expecter) XReadGroup(ctx interface{}, args interface{}) *Client_XReadGroup_Call {
+	return &Client_XReadGroup_Call{Call: _e.mock.On("XReadGroup", ctx, args)}
+}
+
+func (_c *Client_XReadGroup_Call) Run(run func(ctx context.Context, args redis.XReadGroupArgs)) *Client_XReadGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(redis.XReadGroupArgs))
+	})
+	return _c
+}
+
+func (_c *Client_XReadGroup_Call) Return(_a0 []redis.XMessage, _a1 error) *Client_XReadGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_XReadGroup_Call) RunAndReturn(run func(context.Context, redis.XReadGroupArgs) ([]redis.XMessage, error)) *Client_XReadGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ZAdd provides a mock function with given fields: ctx, key, score, member
 func (_m *Client) ZAdd(ctx context.Context, key string, score float64, member string) (int64, error) {
 	ret := _m.Called(ctx, key, score, member)
@@ -0,0 +1,127 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	redis "github.com/justtrackio/gosoline/pkg/redis"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Subscription is an autogenerated mock type for the Subscription type
+type Subscription struct {
+	mock.Mock
+}
+
+type Subscription_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Subscription) EXPECT() *Subscription_Expecter {
+	return &Subscription_Expecter{mock: &_m.Mock}
+}
+
+// Channel provides a mock function with no fields
+func (_m *Subscription) Channel() <-chan *redis.PubSubMessage {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Channel")
+	}
+
+	var r0 <-chan *redis.PubSubMessage
+	if rf, ok := ret.Get(0).(func() <-chan *redis.PubSubMessage); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *redis.PubSubMessage)
+		}
+	}
+
+	return r0
+}
+
+// Subscription_Channel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Channel'
+type Subscription_Channel_Call struct {
+	*mock.Call
+}
+
+// Channel is a helper method to define mock.On call
+func (_e *Subscription_Expecter) Channel() *Subscription_Channel_Call {
+	return &Subscription_Channel_Call{Call: _e.mock.On("Channel")}
+}
+
+func (_c *Subscription_Channel_Call) Run(run func()) *Subscription_Channel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Subscription_Channel_Call) Return(_a0 <-chan *redis.PubSubMessage) *Subscription_Channel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Subscription_Channel_Call) RunAndReturn(run func() <-chan *redis.PubSubMessage) *Subscription_Channel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Close provides a mock function with no fields
+func (_m *Subscription) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Subscription_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type Subscription_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *Subscription_Expecter) Close() *Subscription_Close_Call {
+	return &Subscription_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *Subscription_Close_Call) Run(run func()) *Subscription_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Subscription_Close_Call) Return(_a0 error) *Subscription_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Subscription_Close_Call) RunAndReturn(run func() error) *Subscription_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSubscription creates a new instance of Subscription. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSubscription(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Subscription {
+	mock := &Subscription{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,110 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	baseRedis "github.com/redis/go-redis/v9"
+)
+
+// PubSubMessage is a message published to a channel. The channel is the name of the channel without the key prefix
+// of the client.
+type PubSubMessage struct {
+	Channel string
+	Payload string
+}
+
+// Subscription receives the messages published to the channels it subscribed to. Close unsubscribes from the channels
+// and closes the channel of the messages.
+//
+//go:generate go run github.com/vektra/mockery/v2 --name Subscription
+type Subscription interface {
+	Channel() <-chan *PubSubMessage
+	Close() error
+}
+
+// subscriber is implemented by the go-redis clients which can subscribe to channels. A pipeline can't.
+type subscriber interface {
+	Subscribe(ctx context.Context, channels ...string) *baseRedis.PubSub
+}
+
+type subscription struct {
+	pubSub  *baseRedis.PubSub
+	channel chan *PubSubMessage
+	closed  chan struct{}
+	once    sync.Once
+}
+
+// Publish publishes the message to the channel. The channel is prefixed like a key, so the channels of different
+// applications don't interfere. It returns the number of clients which received the message.
+func (c *redisClient) Publish(ctx context.Context, channel string, message any) (int64, error) {
+	cmd, err := c.executePrefixedKey(ctx, func(channel string) ErrCmder {
+		return c.base.Publish(ctx, channel, message)
+	}, channel)
+
+	return cmd.(*baseRedis.IntCmd).Val(), err
+}
+
+// Subscribe subscribes to the channels and waits until redis confirmed the subscription, so no message published
+// afterward is missed.
+func (c *redisClient) Subscribe(ctx context.Context, channels ...string) (Subscription, error) {
+	base, ok := c.base.(subscriber)
+	if !ok {
+		return nil, fmt.Errorf("the redis client %s does not support subscriptions", c.settings.Name)
+	}
+
+	prefixedChannels, err := c.prefixKeys(channels...)
+	if err != nil {
+		return nil, err
+	}
+
+	pubSub := base.Subscribe(ctx, prefixedChannels...)
+
+	if _, err = pubSub.Receive(ctx); err != nil {
+		if closeErr := pubSub.Close(); closeErr != nil {
+			c.logger.Warn(ctx, "can not close redis subscription: %s", closeErr)
+		}
+
+		return nil, fmt.Errorf("can not subscribe to the channels %v: %w", channels, err)
+	}
+
+	sub := &subscription{
+		pubSub:  pubSub,
+		channel: make(chan *PubSubMessage),
+		closed:  make(chan struct{}),
+	}
+
+	go sub.forward(c.keyPrefix)
+
+	return sub, nil
+}
+
+func (s *subscription) Channel() <-chan *PubSubMessage {
+	return s.channel
+}
+
+func (s *subscription) Close() error {
+	s.once.Do(func() {
+		close(s.closed)
+	})
+
+	return s.pubSub.Close()
+}
+
+// forward passes the messages of the go-redis subscription on until the subscription is closed.
+func (s *subscription) forward(keyPrefix string) {
+	defer close(s.channel)
+
+	for msg := range s.pubSub.Channel() {
+		select {
+		case s.channel <- &PubSubMessage{
+			Channel: strings.TrimPrefix(msg.Channel, keyPrefix),
+			Payload: msg.Payload,
+		}:
+		case <-s.closed:
+			return
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package redis
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+
+	baseRedis "github.com/redis/go-redis/v9"
+)
+
+// Script is a lua script which is executed by its hash. The script is only sent to redis if redis doesn't know the
+// hash yet.
+type Script struct {
+	src  string
+	hash string
+}
+
+func NewScript(src string) *Script {
+	hash := sha1.Sum([]byte(src))
+
+	return &Script{
+		src:  src,
+		hash: hex.EncodeToString(hash[:]),
+	}
+}
+
+func (s *Script) Hash() string {
+	return s.hash
+}
+
+// Run executes the script with EVALSHA and falls back to EVAL if the script is not cached by redis yet.
+func (s *Script) Run(ctx context.Context, client Client, keys []string, args ...any) (any, error) {
+	result, err := client.EvalSha(ctx, s.hash, keys, args...)
+	if baseRedis.HasErrorPrefix(err, "NOSCRIPT") {
+		return client.Eval(ctx, s.src, keys, args...)
+	}
+
+	return result, err
+}
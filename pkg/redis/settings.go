@@ -2,9 +2,17 @@ package redis
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/tlsx"
+)
+
+const (
+	ModeSingle   = "single"
+	ModeCluster  = "cluster"
+	ModeSentinel = "sentinel"
 )
 
 type Naming struct {
@@ -16,14 +24,42 @@ type Naming struct {
 
 type Settings struct {
 	cfg.Identity
-	DB              int    `cfg:"db" default:"0"`
-	Name            string `cfg:"name"`
-	Dialer          string `cfg:"dialer" default:"tcp"`
-	Address         string `cfg:"address" default:"127.0.0.1:6379"`
-	Naming          Naming `cfg:"naming"`
+	DB   int    `cfg:"db" default:"0"`
+	Name string `cfg:"name"`
+	// Mode is the topology of the redis: a single instance, a cluster or a master monitored by sentinels.
+	Mode   string `cfg:"mode" default:"single" validate:"oneof=single cluster sentinel"`
+	Dialer string `cfg:"dialer" default:"tcp"`
+	// Address is the address of the instance, a node of the cluster or a sentinel. With the srv dialer it is the name
+	// of the srv records of the instance, the nodes or the sentinels.
+	Address string `cfg:"address" default:"127.0.0.1:6379"`
+	// Addresses are further nodes of the cluster or sentinels, which are used if the address is not reachable.
+	Addresses       []string            `cfg:"addresses"`
+	Sentinel        SentinelSettings    `cfg:"sentinel"`
+	Auth            AuthSettings        `cfg:"auth"`
+	Tls             tlsx.ClientSettings `cfg:"tls"`
+	Naming          Naming              `cfg:"naming"`
 	BackoffSettings exec.BackoffSettings
 }
 
+type SentinelSettings struct {
+	// MasterName is the name of the master monitored by the sentinels.
+	MasterName string `cfg:"master_name"`
+	// Username and Password authenticate the connections to the sentinels.
+	Username string `cfg:"username"`
+	Password string `cfg:"password"`
+}
+
+// AuthSettings configure the credentials of the connections to redis. If a secret name is configured, the credentials
+// are read from the secrets manager secret, which has to contain a json object with a password and optionally a
+// username. They are read again after the refresh interval, so rotated credentials are used for new connections.
+type AuthSettings struct {
+	Username              string        `cfg:"username"`
+	Password              string        `cfg:"password"`
+	SecretName            string        `cfg:"secret_name"`
+	SecretRefreshInterval time.Duration `cfg:"secret_refresh_interval" default:"5m"`
+	SecretsManagerClient  string        `cfg:"secrets_manager_client" default:"default"`
+}
+
 func GetRedisConfigKey(name string) string {
 	return fmt.Sprintf("redis.%s", name)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/exec"
 	"github.com/justtrackio/gosoline/pkg/redis"
+	"github.com/justtrackio/gosoline/pkg/tlsx"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -64,8 +65,18 @@ func (s *SettingsTestSuite) TestDefault() {
 			KeyPattern:       "{key}",
 			KeyDelimiter:     "-",
 		},
-		Dialer:  "tcp",
-		Address: "127.0.0.1:6379",
+		Dialer:    "tcp",
+		Address:   "127.0.0.1:6379",
+		Mode:      "single",
+		Addresses: []string{},
+		Auth: redis.AuthSettings{
+			SecretRefreshInterval: 5 * time.Minute,
+			SecretsManagerClient:  "default",
+		},
+		Tls: tlsx.ClientSettings{
+			MinVersion:     "tls1.2",
+			ReloadInterval: time.Minute,
+		},
 		BackoffSettings: exec.BackoffSettings{
 			InitialInterval: 50 * time.Millisecond,
 			MaxAttempts:     10,
@@ -110,8 +121,18 @@ func (s *SettingsTestSuite) TestDedicated() {
 			KeyPattern:       "{key}",
 			KeyDelimiter:     "-",
 		},
-		Dialer:  "srv",
-		Address: "dedicated.address",
+		Dialer:    "srv",
+		Address:   "dedicated.address",
+		Mode:      "single",
+		Addresses: []string{},
+		Auth: redis.AuthSettings{
+			SecretRefreshInterval: 5 * time.Minute,
+			SecretsManagerClient:  "default",
+		},
+		Tls: tlsx.ClientSettings{
+			MinVersion:     "tls1.2",
+			ReloadInterval: time.Minute,
+		},
 		BackoffSettings: exec.BackoffSettings{
 			InitialInterval: 50 * time.Millisecond,
 			MaxAttempts:     10,
@@ -158,8 +179,18 @@ func (s *SettingsTestSuite) TestWithDefaults() {
 			KeyPattern:       "{key}",
 			KeyDelimiter:     "-",
 		},
-		Dialer:  "srv",
-		Address: "partial.address",
+		Dialer:    "srv",
+		Address:   "partial.address",
+		Mode:      "single",
+		Addresses: []string{},
+		Auth: redis.AuthSettings{
+			SecretRefreshInterval: 5 * time.Minute,
+			SecretsManagerClient:  "default",
+		},
+		Tls: tlsx.ClientSettings{
+			MinVersion:     "tls1.2",
+			ReloadInterval: time.Minute,
+		},
 		BackoffSettings: exec.BackoffSettings{
 			InitialInterval: 50 * time.Millisecond,
 			MaxAttempts:     10,
@@ -194,3 +225,42 @@ func (s *SettingsTestSuite) TestKeyNamingPattern() {
 	s.NoError(err, "there should be no error reading the settings")
 	s.Equal("{app.env}-{app.name}-{key}", settings.Naming.KeyPattern)
 }
+
+func (s *SettingsTestSuite) TestCluster() {
+	s.initConfig(map[string]any{
+		"redis": map[string]any{
+			"cluster": map[string]any{
+				"mode":      "cluster",
+				"address":   "node-1:6379",
+				"addresses": []string{"node-2:6379", "node-3:6379"},
+				"auth": map[string]any{
+					"username":    "app",
+					"secret_name": "redis-credentials",
+				},
+				"tls": map[string]any{
+					"enabled":     true,
+					"server_name": "cluster.redis",
+				},
+			},
+		},
+	})
+
+	settings, err := redis.ReadSettings(s.config, "cluster")
+	s.NoError(err, "there should be no error reading the settings")
+
+	s.Equal(redis.ModeCluster, settings.Mode)
+	s.Equal("node-1:6379", settings.Address)
+	s.Equal([]string{"node-2:6379", "node-3:6379"}, settings.Addresses)
+	s.Equal(redis.AuthSettings{
+		Username:              "app",
+		SecretName:            "redis-credentials",
+		SecretRefreshInterval: 5 * time.Minute,
+		SecretsManagerClient:  "default",
+	}, settings.Auth)
+	s.Equal(tlsx.ClientSettings{
+		Enabled:        true,
+		ServerName:     "cluster.redis",
+		MinVersion:     "tls1.2",
+		ReloadInterval: time.Minute,
+	}, settings.Tls)
+}
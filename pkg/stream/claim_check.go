@@ -0,0 +1,232 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/blob"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+)
+
+// ClaimCheckSettings configure the claim check pattern: the body of a message which is too large for the output is
+// written to a blob store and only a pointer to it is published. Consumers fetch the body from the store again before
+// decoding the message. The blob store is configured at blob.<store> and requires the blob.ProvideBatchRunner module.
+type ClaimCheckSettings struct {
+	// Enabled moves oversized bodies of produced messages to the store. Consumers need it to fetch these bodies again.
+	Enabled bool   `cfg:"enabled" default:"false"`
+	Store   string `cfg:"store" default:"claim_check"`
+	// Threshold is the size in bytes of body and attributes above which a message is moved to the store. It defaults
+	// to the max message size of the output of the producer.
+	Threshold int `cfg:"threshold" default:"0" validate:"min=0"`
+	// DeletePayload deletes the stored body after the consumer consumed the message successfully.
+	DeletePayload bool `cfg:"delete_payload" default:"false"`
+}
+
+// ClaimCheckPointer is the body of a message whose original body got moved to the blob store.
+type ClaimCheckPointer struct {
+	Bucket string `json:"bucket"`
+	Key    string `json:"key"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name ClaimCheck
+type ClaimCheck interface {
+	// Check moves the body of the message to the store if the message exceeds the threshold and replaces the body
+	// with a pointer to it.
+	Check(ctx context.Context, msg *Message) (*Message, error)
+	// Fetch returns a copy of a claim check message with the body read from the store. Other messages are returned as is.
+	Fetch(ctx context.Context, msg *Message) (*Message, error)
+	// Release deletes the stored body of a consumed claim check message if the settings ask for it.
+	Release(ctx context.Context, msg *Message) error
+}
+
+type claimCheck struct {
+	store         blob.Store
+	threshold     int
+	deletePayload bool
+}
+
+// NewClaimCheck creates the claim check moving bodies to the configured blob store. The max message size of the
+// output is used as threshold if the settings don't define one.
+func NewClaimCheck(ctx context.Context, config cfg.Config, logger log.Logger, settings ClaimCheckSettings, maxMessageSize *int) (ClaimCheck, error) {
+	store, err := blob.ProvideStore(ctx, config, logger, settings.Store)
+	if err != nil {
+		return nil, fmt.Errorf("can not create blob store %s: %w", settings.Store, err)
+	}
+
+	if settings.Threshold == 0 {
+		settings.Threshold = mdl.EmptyIfNil(maxMessageSize)
+	}
+
+	return NewClaimCheckWithInterfaces(store, settings), nil
+}
+
+func NewClaimCheckWithInterfaces(store blob.Store, settings ClaimCheckSettings) ClaimCheck {
+	return &claimCheck{
+		store:         store,
+		threshold:     settings.Threshold,
+		deletePayload: settings.DeletePayload,
+	}
+}
+
+func (c *claimCheck) Check(_ context.Context, msg *Message) (*Message, error) {
+	if c.threshold == 0 || messageSize(msg) <= c.threshold {
+		return msg, nil
+	}
+
+	if _, ok := msg.Attributes[AttributeClaimCheck]; ok {
+		return nil, fmt.Errorf("the message has a %s attribute already", AttributeClaimCheck)
+	}
+
+	key := blob.CreateKey()
+	obj := &blob.Object{
+		Key:  mdl.Box(key),
+		Body: blob.StreamBytes([]byte(msg.Body)),
+	}
+
+	if err := c.store.WriteOne(obj); err != nil {
+		return nil, fmt.Errorf("can not write message body to the claim check store: %w", err)
+	}
+
+	pointer, err := json.Marshal(ClaimCheckPointer{
+		Bucket: c.store.BucketName(),
+		Key:    key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can not encode claim check pointer: %w", err)
+	}
+
+	attributes := make(map[string]string, len(msg.Attributes)+1)
+	for k, v := range msg.Attributes {
+		attributes[k] = v
+	}
+	attributes[AttributeClaimCheck] = "true"
+
+	return &Message{
+		Attributes: attributes,
+		Body:       string(pointer),
+	}, nil
+}
+
+func (c *claimCheck) Fetch(_ context.Context, msg *Message) (*Message, error) {
+	if !IsClaimCheckMessage(msg) {
+		return msg, nil
+	}
+
+	pointer, err := c.pointer(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &blob.Object{
+		Key: mdl.Box(pointer.Key),
+	}
+
+	if err = c.store.ReadOne(obj); err != nil {
+		return nil, fmt.Errorf("can not read message body %s from the claim check store: %w", pointer.Key, err)
+	}
+
+	body, err := obj.Body.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("can not read message body %s from the claim check store: %w", pointer.Key, err)
+	}
+
+	attributes := make(map[string]string, len(msg.Attributes))
+	for k, v := range msg.Attributes {
+		if k != AttributeClaimCheck {
+			attributes[k] = v
+		}
+	}
+
+	return &Message{
+		Attributes: attributes,
+		Body:       string(body),
+	}, nil
+}
+
+func (c *claimCheck) Release(_ context.Context, msg *Message) error {
+	if !c.deletePayload || !IsClaimCheckMessage(msg) {
+		return nil
+	}
+
+	pointer, err := c.pointer(msg)
+	if err != nil {
+		return err
+	}
+
+	obj := &blob.Object{
+		Key: mdl.Box(pointer.Key),
+	}
+
+	if err = c.store.DeleteOne(obj); err != nil {
+		return fmt.Errorf("can not delete message body %s from the claim check store: %w", pointer.Key, err)
+	}
+
+	return nil
+}
+
+func (c *claimCheck) pointer(msg *Message) (*ClaimCheckPointer, error) {
+	pointer := &ClaimCheckPointer{}
+
+	if err := json.Unmarshal([]byte(msg.Body), pointer); err != nil {
+		return nil, fmt.Errorf("can not decode claim check pointer: %w", err)
+	}
+
+	if pointer.Bucket != c.store.BucketName() {
+		return nil, fmt.Errorf("the message body is stored in bucket %s, but the claim check store uses bucket %s", pointer.Bucket, c.store.BucketName())
+	}
+
+	return pointer, nil
+}
+
+type claimCheckNoop struct{}
+
+// NewClaimCheckNoop returns a claim check for disabled claim checks. It leaves produced messages untouched and fails
+// on consumed claim check messages, as their body can't be fetched.
+func NewClaimCheckNoop() ClaimCheck {
+	return claimCheckNoop{}
+}
+
+func (c claimCheckNoop) Check(_ context.Context, msg *Message) (*Message, error) {
+	return msg, nil
+}
+
+func (c claimCheckNoop) Fetch(_ context.Context, msg *Message) (*Message, error) {
+	if IsClaimCheckMessage(msg) {
+		return nil, fmt.Errorf("the message body is stored in a claim check store, but claim checks are not enabled")
+	}
+
+	return msg, nil
+}
+
+func (c claimCheckNoop) Release(_ context.Context, _ *Message) error {
+	return nil
+}
+
+// IsClaimCheckMessage returns whether the body of the message is a pointer to the body in a claim check store.
+func IsClaimCheckMessage(msg *Message) bool {
+	_, ok := msg.Attributes[AttributeClaimCheck]
+
+	return ok
+}
+
+// messageSize estimates the size of the message on the wire from its body and attributes.
+func messageSize(msg *Message) int {
+	size := len(msg.Body)
+
+	for k, v := range msg.Attributes {
+		size += len(k) + len(v)
+	}
+
+	return size
+}
+
+func newConsumerClaimCheck(ctx context.Context, config cfg.Config, logger log.Logger, settings ConsumerSettings) (ClaimCheck, error) {
+	if !settings.ClaimCheck.Enabled {
+		return NewClaimCheckNoop(), nil
+	}
+
+	return NewClaimCheck(ctx, config, logger, settings.ClaimCheck, nil)
+}
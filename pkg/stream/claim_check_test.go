@@ -0,0 +1,140 @@
+package stream_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/blob"
+	blobMocks "github.com/justtrackio/gosoline/pkg/blob/mocks"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ClaimCheckTestSuite struct {
+	suite.Suite
+	ctx   context.Context
+	store *blobMocks.Store
+	data  encodingTestStruct
+}
+
+func TestClaimCheckTestSuite(t *testing.T) {
+	suite.Run(t, new(ClaimCheckTestSuite))
+}
+
+func (s *ClaimCheckTestSuite) SetupTest() {
+	s.ctx = s.T().Context()
+	s.store = blobMocks.NewStore(s.T())
+	s.store.EXPECT().BucketName().Return("claim-check-bucket").Maybe()
+	s.data = encodingTestStruct{
+		Id:   3,
+		Text: strings.Repeat("a", 100),
+	}
+
+	blob.WithNamingStrategy(func() string {
+		return "2026/10/17/key"
+	})
+	s.T().Cleanup(func() {
+		blob.WithNamingStrategy(blob.DefaultNamingStrategy())
+	})
+}
+
+func (s *ClaimCheckTestSuite) TestSmallMessage() {
+	encoder := s.encoder(stream.ClaimCheckSettings{Threshold: 1000})
+
+	msg, err := encoder.Encode(s.ctx, s.data)
+	s.NoError(err)
+	s.False(stream.IsClaimCheckMessage(msg))
+	s.Contains(msg.Body, s.data.Text)
+}
+
+func (s *ClaimCheckTestSuite) TestLargeMessage() {
+	var stored []byte
+
+	s.store.EXPECT().WriteOne(mock.AnythingOfType("*blob.Object")).RunAndReturn(func(obj *blob.Object) error {
+		s.Equal("2026/10/17/key", *obj.Key)
+
+		var err error
+		stored, err = obj.Body.ReadAll()
+
+		return err
+	}).Once()
+	s.store.EXPECT().ReadOne(mock.AnythingOfType("*blob.Object")).RunAndReturn(func(obj *blob.Object) error {
+		s.Equal("2026/10/17/key", *obj.Key)
+		obj.Body = blob.StreamBytes(stored)
+
+		return nil
+	}).Once()
+
+	encoder := s.encoder(stream.ClaimCheckSettings{Threshold: 100})
+
+	msg, err := encoder.Encode(s.ctx, s.data, map[string]string{"type": "test"})
+	s.NoError(err)
+	s.Equal(&stream.Message{
+		Attributes: map[string]string{
+			"encoding":   "application/json",
+			"claimCheck": "true",
+			"type":       "test",
+		},
+		Body: `{"bucket":"claim-check-bucket","key":"2026/10/17/key"}`,
+	}, msg)
+	s.JSONEq(fmt.Sprintf(`{"id":3,"text":"%s","createdAt":"0001-01-01T00:00:00Z"}`, s.data.Text), string(stored))
+
+	out := encodingTestStruct{}
+	_, attributes, err := encoder.Decode(s.ctx, msg, &out)
+	s.NoError(err)
+	s.Equal(s.data, out)
+	s.Equal(map[string]string{
+		"encoding": "application/json",
+		"type":     "test",
+	}, attributes)
+}
+
+func (s *ClaimCheckTestSuite) TestRelease() {
+	msg := stream.NewMessage(`{"bucket":"claim-check-bucket","key":"2026/10/17/key"}`, map[string]string{
+		stream.AttributeClaimCheck: "true",
+	})
+
+	err := stream.NewClaimCheckWithInterfaces(s.store, stream.ClaimCheckSettings{}).Release(s.ctx, msg)
+	s.NoError(err)
+
+	s.store.EXPECT().DeleteOne(mock.AnythingOfType("*blob.Object")).RunAndReturn(func(obj *blob.Object) error {
+		s.Equal("2026/10/17/key", *obj.Key)
+
+		return nil
+	}).Once()
+
+	err = stream.NewClaimCheckWithInterfaces(s.store, stream.ClaimCheckSettings{DeletePayload: true}).Release(s.ctx, msg)
+	s.NoError(err)
+
+	err = stream.NewClaimCheckWithInterfaces(s.store, stream.ClaimCheckSettings{DeletePayload: true}).Release(s.ctx, stream.NewJsonMessage("{}"))
+	s.NoError(err)
+}
+
+func (s *ClaimCheckTestSuite) TestFetchFromOtherBucket() {
+	msg := stream.NewMessage(`{"bucket":"other-bucket","key":"2026/10/17/key"}`, map[string]string{
+		stream.AttributeClaimCheck: "true",
+	})
+
+	_, _, err := s.encoder(stream.ClaimCheckSettings{}).Decode(s.ctx, msg, &encodingTestStruct{})
+	s.EqualError(err, "can not fetch message body of claim check: the message body is stored in bucket other-bucket, but the claim check store uses bucket claim-check-bucket")
+}
+
+func (s *ClaimCheckTestSuite) TestDecodeWithoutClaimCheck() {
+	msg := stream.NewMessage(`{"bucket":"claim-check-bucket","key":"2026/10/17/key"}`, map[string]string{
+		stream.AttributeClaimCheck: "true",
+	})
+
+	_, _, err := stream.NewMessageEncoder(&stream.MessageEncoderSettings{}).Decode(s.ctx, msg, &encodingTestStruct{})
+	s.EqualError(err, "can not fetch message body of claim check: the message body is stored in a claim check store, but claim checks are not enabled")
+}
+
+func (s *ClaimCheckTestSuite) encoder(settings stream.ClaimCheckSettings) stream.MessageEncoder {
+	return stream.NewMessageEncoder(&stream.MessageEncoderSettings{
+		Encoding:       stream.EncodingJson,
+		EncodeHandlers: []stream.EncodeHandler{},
+		ClaimCheck:     stream.NewClaimCheckWithInterfaces(s.store, settings),
+	})
+}
@@ -224,20 +224,20 @@ func (c *Consumer) process(ctx context.Context, msg *Message, hasNativeRetry boo
 
 		c.handleError(ctx, err, "an error occurred during the consume operation")
 
-		return false
+		return c.deadLetterUnprocessable(ctx, msg, err)
 	}
 
 	if model == nil {
 		err := fmt.Errorf("can not get model for message attributes %v", msg.Attributes)
 		c.handleError(ctx, err, "an error occurred during the consume operation")
 
-		return false
+		return c.deadLetterUnprocessable(ctx, msg, err)
 	}
 
 	if ctx, attributes, err = c.encoder.Decode(ctx, msg, model); err != nil {
 		c.handleError(ctx, err, "an error occurred during the consume operation")
 
-		return false
+		return c.deadLetterUnprocessable(ctx, msg, err)
 	}
 
 	if smplCtx, _, err := c.samplingDecider.Decide(ctx); err != nil {
@@ -255,6 +255,11 @@ func (c *Consumer) process(ctx context.Context, msg *Message, hasNativeRetry boo
 		}).Debug(ctx, "processing sqs message")
 	}
 
+	idempotencyKey, duplicate := c.isDuplicate(ctx, model, attributes)
+	if duplicate {
+		return true
+	}
+
 	delayedCtx, stop := exec.WithDelayedCancelContext(ctx, c.settings.ConsumeGraceTime)
 	defer stop()
 
@@ -262,8 +267,13 @@ func (c *Consumer) process(ctx context.Context, msg *Message, hasNativeRetry boo
 		c.handleError(ctx, err, "an error occurred during the consume operation")
 	}
 
+	if ack {
+		c.markConsumed(ctx, idempotencyKey)
+		c.releaseClaimCheck(ctx, msg)
+	}
+
 	if !ack && !hasNativeRetry {
-		c.retry(ctx, msg)
+		ack = c.retryOrDeadLetter(ctx, msg, err)
 	}
 
 	return ack
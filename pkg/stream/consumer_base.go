@@ -27,6 +27,8 @@ const (
 	metricNameConsumerProcessedCount    = "ProcessedCount"
 	metricNameConsumerRetryGetCount     = "RetryGetCount"
 	metricNameConsumerRetryPutCount     = "RetryPutCount"
+	metricNameConsumerDeadLetterCount   = "DeadLetterCount"
+	metricNameConsumerDuplicateCount    = "DuplicateCount"
 	metricNameConsumerUnknownModelError = "UnknownModelError"
 	dataSourceInput                     = "input"
 	dataSourceRetry                     = "retry"
@@ -37,6 +39,8 @@ type ConsumerMetadata struct {
 	Name         string `json:"name"`
 	RetryEnabled bool   `json:"retry_enabled"`
 	RetryType    string `json:"retry_type"`
+	DeadLetter   bool   `json:"dead_letter"`
+	Idempotent   bool   `json:"idempotent"`
 	RunnerCount  int    `json:"runner_count"`
 }
 
@@ -86,6 +90,10 @@ type baseConsumer struct {
 	encoder      MessageEncoder
 	retryInput   Input
 	retryHandler RetryHandler
+	deadLetter   DeadLetterHandler
+	maxAttempts  int
+	idempotency  IdempotencyHandler
+	claimCheck   ClaimCheck
 
 	wg      sync.WaitGroup
 	stopped sync.Once
@@ -99,6 +107,30 @@ type baseConsumer struct {
 	processed        int32
 }
 
+type BaseConsumerOption func(c *baseConsumer)
+
+// WithDeadLetterHandler hands the messages to the dead letter handler once they failed maxAttempts times.
+func WithDeadLetterHandler(handler DeadLetterHandler, maxAttempts int) BaseConsumerOption {
+	return func(c *baseConsumer) {
+		c.deadLetter = handler
+		c.maxAttempts = maxAttempts
+	}
+}
+
+// WithIdempotencyHandler skips messages the idempotency handler has seen consumed already.
+func WithIdempotencyHandler(handler IdempotencyHandler) BaseConsumerOption {
+	return func(c *baseConsumer) {
+		c.idempotency = handler
+	}
+}
+
+// WithClaimCheck releases the claimed bodies of the messages with the claim check once they got consumed.
+func WithClaimCheck(claimCheck ClaimCheck) BaseConsumerOption {
+	return func(c *baseConsumer) {
+		c.claimCheck = claimCheck
+	}
+}
+
 func NewBaseConsumer(
 	ctx context.Context,
 	config cfg.Config,
@@ -128,6 +160,10 @@ func NewBaseConsumer(
 
 	var input, retryInput Input
 	var retryHandler RetryHandler
+	var retryHandlerSettings RetryHandlerSettings
+	var deadLetterHandler DeadLetterHandler
+	var idempotencyHandler IdempotencyHandler
+	var claimCheck ClaimCheck
 
 	if input, err = NewConfigurableInput(ctx, config, logger, settings.Input); err != nil {
 		return nil, err
@@ -157,6 +193,17 @@ func NewBaseConsumer(
 		encoderSettings.ExternalEncoder = externalEncoder
 	}
 
+	if settings.Encryption.Enabled {
+		if encoderSettings.Encryptor, err = NewMessageBodyEncryptor(ctx, config, logger, settings.Encryption); err != nil {
+			return nil, fmt.Errorf("can not create message body encryptor: %w", err)
+		}
+	}
+
+	if claimCheck, err = newConsumerClaimCheck(ctx, config, logger, settings); err != nil {
+		return nil, fmt.Errorf("can not create claim check: %w", err)
+	}
+
+	encoderSettings.ClaimCheck = claimCheck
 	encoder := NewMessageEncoder(encoderSettings)
 
 	// if our input knows how to retry already,
@@ -167,10 +214,24 @@ func NewBaseConsumer(
 		return nil, fmt.Errorf("can not create retry handler: %w", err)
 	}
 
+	if retryHandlerSettings, err = ReadRetryHandlerSettings(config, name); err != nil {
+		return nil, err
+	}
+
+	if deadLetterHandler, err = NewDeadLetterHandler(ctx, config, logger, name, settings); err != nil {
+		return nil, fmt.Errorf("can not create dead letter handler: %w", err)
+	}
+
+	if idempotencyHandler, err = NewIdempotencyHandler(ctx, config, logger, name, settings, consumerCallback); err != nil {
+		return nil, fmt.Errorf("can not create idempotency handler: %w", err)
+	}
+
 	consumerMetadata := ConsumerMetadata{
 		Name:         name,
 		RetryEnabled: settings.Retry.Enabled,
 		RetryType:    settings.Retry.Type,
+		DeadLetter:   settings.DeadLetter.Enabled,
+		Idempotent:   settings.Idempotency.Enabled,
 		RunnerCount:  settings.RunnerCount,
 	}
 
@@ -191,6 +252,9 @@ func NewBaseConsumer(
 		settings,
 		name,
 		identity,
+		WithDeadLetterHandler(deadLetterHandler, retryHandlerSettings.MaxAttempts),
+		WithIdempotencyHandler(idempotencyHandler),
+		WithClaimCheck(claimCheck),
 	), nil
 }
 
@@ -207,8 +271,9 @@ func NewBaseConsumerWithInterfaces(
 	settings ConsumerSettings,
 	name string,
 	identity cfg.Identity,
+	options ...BaseConsumerOption,
 ) *baseConsumer {
-	return &baseConsumer{
+	consumer := &baseConsumer{
 		name:                name,
 		id:                  fmt.Sprintf("consumer-%s", name),
 		clock:               clock.Provider,
@@ -220,10 +285,19 @@ func NewBaseConsumerWithInterfaces(
 		encoder:             encoder,
 		retryInput:          retryInput,
 		retryHandler:        retryHandler,
+		deadLetter:          NewDeadLetterHandlerNoop(),
+		idempotency:         NewIdempotencyHandlerNoop(),
+		claimCheck:          NewClaimCheckNoop(),
 		settings:            settings,
 		consumerCallback:    consumerCallback,
 		data:                make(chan *consumerData),
 	}
+
+	for _, opt := range options {
+		opt(consumer)
+	}
+
+	return consumer
 }
 
 func (c *baseConsumer) run(kernelCtx context.Context, inputRunner func(ctx context.Context) error) error {
@@ -413,7 +487,22 @@ func (c *baseConsumer) recover(ctx context.Context, msg *Message) {
 		return
 	}
 
+	c.retryOrDeadLetter(ctx, msg, err)
+}
+
+// retryOrDeadLetter hands a failed message to the retry handler. Once all attempts are used up (or if retrying is
+// disabled) and a dead letter output is configured, the message is written to the dead letter output instead. The
+// returned bool is true if the message got dead lettered and can be acknowledged.
+func (c *baseConsumer) retryOrDeadLetter(ctx context.Context, msg *Message, reason error) bool {
+	attempt := c.getAttempt(msg)
+
+	if c.settings.DeadLetter.Enabled && (!c.settings.Retry.Enabled || attempt >= c.maxAttempts) {
+		return c.putDeadLetter(ctx, msg, attempt, reason)
+	}
+
 	c.retry(ctx, msg)
+
+	return false
 }
 
 func (c *baseConsumer) retry(ctx context.Context, msg *Message) {
@@ -421,7 +510,7 @@ func (c *baseConsumer) retry(ctx context.Context, msg *Message) {
 		return
 	}
 
-	retryMsg, retryId := c.buildRetryMessage(msg)
+	retryMsg, retryId := c.buildRetryMessage(msg, c.getAttempt(msg))
 
 	ctx = log.AppendGlobalContextFields(ctx, log.Fields{
 		"retry_id": retryId,
@@ -438,22 +527,99 @@ func (c *baseConsumer) retry(ctx context.Context, msg *Message) {
 	}
 }
 
+// deadLetterUnprocessable writes a message which can't be decoded at all directly to the dead letter output (if enabled)
+// as retrying it would not change the outcome. The returned bool is true if the message can be acknowledged.
+func (c *baseConsumer) deadLetterUnprocessable(ctx context.Context, msg *Message, reason error) bool {
+	if !c.settings.DeadLetter.Enabled {
+		return false
+	}
+
+	return c.putDeadLetter(ctx, msg, c.getAttempt(msg), reason)
+}
+
+// putDeadLetter writes the message to the dead letter output and returns true if this was successful.
+func (c *baseConsumer) putDeadLetter(ctx context.Context, msg *Message, attempts int, reason error) bool {
+	c.logger.Warn(ctx, "putting message into dead letter output after %d attempt(s)", attempts)
+	c.writeMetricRetryCount(ctx, metricNameConsumerDeadLetterCount)
+
+	ctx, stop := exec.WithDelayedCancelContext(ctx, c.settings.Retry.GraceTime)
+	defer stop()
+
+	if err := c.deadLetter.Put(ctx, msg, attempts, reason); err != nil {
+		c.handleError(ctx, err, "can not put the message into the dead letter output")
+
+		return false
+	}
+
+	return true
+}
+
+// getAttempt returns how often the message has been consumed including the current attempt.
+func (c *baseConsumer) getAttempt(msg *Message) int {
+	attempt := 1
+
+	if value, err := strconv.Atoi(msg.Attributes[AttributeRetryAttempt]); err == nil {
+		attempt = value
+	}
+
+	// some retry handlers (like sqs) redeliver a message on their own instead of putting it again into the retry handler
+	if receiveCount, err := strconv.Atoi(msg.Attributes[AttributeSqsApproximateReceiveCount]); err == nil && receiveCount > 1 {
+		attempt += receiveCount - 1
+	}
+
+	return attempt
+}
+
+// isDuplicate checks if a message with the same deduplication key got consumed already and returns the key to record
+// once the message is consumed. If the check fails, the message is consumed anyway.
+func (c *baseConsumer) isDuplicate(ctx context.Context, model any, attributes map[string]string) (key string, duplicate bool) {
+	key, duplicate, err := c.idempotency.IsDuplicate(ctx, model, attributes)
+	if err != nil {
+		c.handleError(ctx, err, "can not check if the message is a duplicate")
+
+		return key, false
+	}
+
+	if duplicate {
+		c.logger.Info(ctx, "skipping duplicate message with idempotency key %s", key)
+		c.writeMetricRetryCount(ctx, metricNameConsumerDuplicateCount)
+	}
+
+	return key, duplicate
+}
+
+func (c *baseConsumer) markConsumed(ctx context.Context, key string) {
+	if err := c.idempotency.MarkConsumed(ctx, key); err != nil {
+		c.handleError(ctx, err, "can not mark the message as consumed")
+	}
+}
+
+// releaseClaimCheck deletes the stored body of a consumed claim check message. If the deletion fails, the message is
+// consumed anyway and the body remains in the store.
+func (c *baseConsumer) releaseClaimCheck(ctx context.Context, msg *Message) {
+	if err := c.claimCheck.Release(ctx, msg); err != nil {
+		c.handleError(ctx, err, "can not release the claim check of the message")
+	}
+}
+
 func (c *baseConsumer) hasNativeRetry() bool {
 	_, ok := c.input.(RetryingInput)
 
 	return ok
 }
 
-func (c *baseConsumer) buildRetryMessage(msg *Message) (retryMsg *Message, retryId string) {
-	if retryId, ok := msg.Attributes[AttributeRetryId]; ok {
-		return msg, retryId
+func (c *baseConsumer) buildRetryMessage(msg *Message, attempt int) (retryMsg *Message, retryId string) {
+	var ok bool
+
+	if retryId, ok = msg.Attributes[AttributeRetryId]; !ok {
+		retryId = c.uuidGen.NewV4()
 	}
 
-	retryId = c.uuidGen.NewV4()
 	retryMsg = &Message{
 		Attributes: funk.MergeMaps(msg.Attributes, map[string]string{
-			AttributeRetry:   strconv.FormatBool(true),
-			AttributeRetryId: retryId,
+			AttributeRetry:        strconv.FormatBool(true),
+			AttributeRetryAttempt: strconv.Itoa(attempt + 1),
+			AttributeRetryId:      retryId,
 		}),
 		Body: msg.Body,
 	}
@@ -561,6 +727,24 @@ func getConsumerDefaultMetrics(name string) metric.Data {
 			Unit:  metric.UnitCount,
 			Value: 0.0,
 		},
+		{
+			Priority:   metric.PriorityHigh,
+			MetricName: metricNameConsumerDeadLetterCount,
+			Dimensions: map[string]string{
+				"Consumer": name,
+			},
+			Unit:  metric.UnitCount,
+			Value: 0.0,
+		},
+		{
+			Priority:   metric.PriorityHigh,
+			MetricName: metricNameConsumerDuplicateCount,
+			Dimensions: map[string]string{
+				"Consumer": name,
+			},
+			Unit:  metric.UnitCount,
+			Value: 0.0,
+		},
 		{
 			Priority:   metric.PriorityHigh,
 			MetricName: metricNameConsumerUnknownModelError,
@@ -182,7 +182,7 @@ func (c *BatchConsumer) consumeBatch(ctx context.Context, batch []*consumerData)
 		return
 	}
 
-	batch, models, attributes, subSpans := c.decodeMessages(batchCtx, batch)
+	batch, models, attributes, idempotencyKeys, subSpans, settled := c.decodeMessages(batchCtx, batch)
 	defer func() {
 		for i := range subSpans {
 			subSpans[i].Finish()
@@ -206,14 +206,23 @@ func (c *BatchConsumer) consumeBatch(ctx context.Context, batch []*consumerData)
 		acks = acks[:len(batch)]
 	}
 
-	ackMessages := make([]*consumerData, 0, len(batch))
+	ackMessages := make([]*consumerData, 0, len(batch)+len(settled))
 	for i, ack := range acks {
 		ackMessages = append(ackMessages, batch[i])
-		if !ack && !c.hasNativeRetry() {
-			c.retry(batchCtx, batch[i].msg)
+
+		if ack {
+			c.markConsumed(batchCtx, idempotencyKeys[i])
+			c.releaseClaimCheck(batchCtx, batch[i].msg)
+		} else if !c.hasNativeRetry() {
+			acks[i] = c.retryOrDeadLetter(batchCtx, batch[i].msg, err)
 		}
 	}
 
+	for _, cdata := range settled {
+		ackMessages = append(ackMessages, cdata)
+		acks = append(acks, true)
+	}
+
 	c.AcknowledgeBatch(batchCtx, ackMessages, acks)
 
 	duration := c.clock.Now().Sub(start)
@@ -222,12 +231,15 @@ func (c *BatchConsumer) consumeBatch(ctx context.Context, batch []*consumerData)
 	c.writeMetricDurationAndProcessedCount(batchCtx, duration, len(batch))
 }
 
+// decodeMessages returns the messages to consume together with their models, attributes and idempotency keys. Messages
+// which got dead lettered or are duplicates are settled already and only need to be acknowledged.
 func (c *BatchConsumer) decodeMessages(
 	batchCtx context.Context,
 	batch []*consumerData,
-) (newBatch []*consumerData, models []any, attributes []map[string]string, spans []tracing.Span) {
+) (newBatch []*consumerData, models []any, attributes []map[string]string, idempotencyKeys []string, spans []tracing.Span, settled []*consumerData) {
 	models = make([]any, 0, len(batch))
 	attributes = make([]map[string]string, 0, len(batch))
+	idempotencyKeys = make([]string, 0, len(batch))
 	spans = make([]tracing.Span, 0, len(batch))
 	newBatch = make([]*consumerData, 0, len(batch))
 
@@ -254,6 +266,10 @@ func (c *BatchConsumer) decodeMessages(
 
 			c.logger.Error(batchCtx, "an error occurred during the batch GetModel operation: %w", err)
 
+			if c.deadLetterUnprocessable(batchCtx, cdata.msg, err) {
+				settled = append(settled, cdata)
+			}
+
 			continue
 		}
 
@@ -261,16 +277,28 @@ func (c *BatchConsumer) decodeMessages(
 		if err != nil {
 			c.logger.Error(msgCtx, "an error occurred during the batch decode message operation: %w", err)
 
+			if c.deadLetterUnprocessable(batchCtx, cdata.msg, err) {
+				settled = append(settled, cdata)
+			}
+
+			continue
+		}
+
+		idempotencyKey, duplicate := c.isDuplicate(msgCtx, model, attribute)
+		if duplicate {
+			settled = append(settled, cdata)
+
 			continue
 		}
 
 		models = append(models, model)
 		attributes = append(attributes, attribute)
+		idempotencyKeys = append(idempotencyKeys, idempotencyKey)
 		newBatch = append(newBatch, cdata)
 
 		_, span := c.tracer.StartSubSpan(msgCtx, c.id)
 		spans = append(spans, span)
 	}
 
-	return newBatch, models, attributes, spans
+	return newBatch, models, attributes, idempotencyKeys, spans, settled
 }
@@ -35,7 +35,8 @@ type BatchConsumerTestSuite struct {
 	inputDataOut <-chan *stream.Message
 	inputStop    func(context.Context)
 
-	input *mocks.AcknowledgeableInput
+	input       *mocks.AcknowledgeableInput
+	idempotency stream.IdempotencyHandler
 
 	callback      *mocks.RunnableUntypedBatchConsumerCallback
 	batchConsumer *stream.BatchConsumer
@@ -55,7 +56,12 @@ func (s *BatchConsumerTestSuite) SetupTest() {
 
 	s.input = mocks.NewAcknowledgeableInput(s.T())
 	s.callback = mocks.NewRunnableUntypedBatchConsumerCallback(s.T())
+	s.idempotency = stream.NewIdempotencyHandlerNoop()
 
+	s.setupConsumer()
+}
+
+func (s *BatchConsumerTestSuite) setupConsumer() {
 	uuidGen := uuid.New()
 	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
 	tracer := tracing.NewLocalTracer()
@@ -89,6 +95,7 @@ func (s *BatchConsumerTestSuite) SetupTest() {
 		settings,
 		"test",
 		cfg.Identity{},
+		stream.WithIdempotencyHandler(s.idempotency),
 	)
 	s.batchConsumer = stream.NewUntypedBatchConsumerWithInterfaces(baseConsumer, s.callback, ticker, batchSettings)
 }
@@ -288,3 +295,57 @@ func (s *BatchConsumerTestSuite) TestRun_AggregateMessage() {
 	s.Nil(err, "there should be no error returned on consume")
 	s.Equal(processed, 2)
 }
+
+func (s *BatchConsumerTestSuite) TestRun_SkipDuplicates() {
+	idempotency := mocks.NewIdempotencyHandler(s.T())
+	s.idempotency = idempotency
+	s.setupConsumer()
+
+	s.input.EXPECT().Data().Return(s.inputDataOut)
+	s.input.EXPECT().Stop(matcher.Context).Run(s.inputStop).Once()
+
+	s.input.
+		EXPECT().
+		Run(matcher.Context).
+		Run(func(ctx context.Context) {
+			s.inputData <- stream.NewJsonMessage(`"foo"`, map[string]string{"key": "1"})
+			s.inputData <- stream.NewJsonMessage(`"bar"`, map[string]string{"key": "2"})
+			s.inputData <- stream.NewJsonMessage(`"foobar"`, map[string]string{"key": "3"})
+		}).Return(nil)
+
+	idempotency.EXPECT().IsDuplicate(matcher.Context, mdl.Box("foo"), map[string]string{"encoding": "application/json", "key": "1"}).Return("1", false, nil).Once()
+	idempotency.EXPECT().IsDuplicate(matcher.Context, mdl.Box("bar"), map[string]string{"encoding": "application/json", "key": "2"}).Return("2", true, nil).Once()
+	idempotency.EXPECT().IsDuplicate(matcher.Context, mdl.Box("foobar"), map[string]string{"encoding": "application/json", "key": "3"}).Return("3", false, nil).Once()
+	idempotency.EXPECT().MarkConsumed(matcher.Context, "1").Return(nil).Once()
+
+	s.callback.EXPECT().GetModel(mock.AnythingOfType("map[string]string")).
+		RunAndReturn(func(map[string]string) (any, error) {
+			return mdl.Box(""), nil
+		}).
+		Times(3)
+
+	s.callback.EXPECT().
+		Consume(matcher.Context, []any{mdl.Box("foo"), mdl.Box("foobar")}, []map[string]string{
+			{"encoding": "application/json", "key": "1"},
+			{"encoding": "application/json", "key": "3"},
+		}).
+		Return([]bool{true, false}, nil).
+		Once()
+
+	s.input.
+		EXPECT().
+		AckBatch(matcher.Context, mock.AnythingOfType("[]*stream.Message"), []bool{true, false, true}).
+		Run(func(ctx context.Context, msgs []*stream.Message, acks []bool) {
+			s.Equal(`"bar"`, msgs[2].Body)
+			s.kernelCancel()
+		}).
+		Return(nil).
+		Once()
+
+	s.callback.EXPECT().Run(matcher.Context).
+		Return(nil).
+		Once()
+
+	err := s.batchConsumer.Run(s.kernelCtx)
+	s.NoError(err, "there should be no error during run")
+}
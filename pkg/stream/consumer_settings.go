@@ -18,10 +18,14 @@ type ConsumerSettings struct {
 	Input                 string                        `cfg:"input" default:"consumer" validate:"required"`
 	RunnerCount           int                           `cfg:"runner_count" default:"1" validate:"min=1"`
 	Encoding              EncodingType                  `cfg:"encoding" default:"application/json"`
+	Encryption            EncryptionSettings            `cfg:"encryption"`
+	ClaimCheck            ClaimCheckSettings            `cfg:"claim_check"`
 	IdleTimeout           time.Duration                 `cfg:"idle_timeout" default:"10s"`
 	AcknowledgeGraceTime  time.Duration                 `cfg:"acknowledge_grace_time" default:"10s"`
 	ConsumeGraceTime      time.Duration                 `cfg:"consume_grace_time" default:"10s"`
 	Retry                 ConsumerRetrySettings         `cfg:"retry"`
+	DeadLetter            ConsumerDeadLetterSettings    `cfg:"dead_letter"`
+	Idempotency           ConsumerIdempotencySettings   `cfg:"idempotency"`
 	Healthcheck           health.HealthCheckSettings    `cfg:"healthcheck"`
 	AggregateMessageMode  string                        `cfg:"aggregate_message_mode" default:"atMostOnce" validate:"oneof=atLeastOnce atMostOnce"`
 	IgnoreOnGetModelError IgnoreOnGetModelErrorSettings `cfg:"ignore_on_get_model_error"`
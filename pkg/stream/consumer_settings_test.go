@@ -20,6 +20,8 @@ func TestReadConsumerSettings_Empty(t *testing.T) {
 		Input:                "consumer",
 		RunnerCount:          1,
 		Encoding:             "application/json",
+		Encryption:           stream.EncryptionSettings{KeyProvider: "default"},
+		ClaimCheck:           stream.ClaimCheckSettings{Store: "claim_check"},
 		IdleTimeout:          time.Second * 10,
 		ConsumeGraceTime:     time.Second * 10,
 		AcknowledgeGraceTime: time.Second * 10,
@@ -49,6 +51,8 @@ func TestReadConsumerSettings_ReadKernelKillTimeout(t *testing.T) {
 		Input:                "consumer",
 		RunnerCount:          1,
 		Encoding:             "application/json",
+		Encryption:           stream.EncryptionSettings{KeyProvider: "default"},
+		ClaimCheck:           stream.ClaimCheckSettings{Store: "claim_check"},
 		IdleTimeout:          time.Second * 10,
 		ConsumeGraceTime:     time.Second * 10,
 		AcknowledgeGraceTime: time.Second * 10,
@@ -80,6 +84,10 @@ func TestReadConsumerSettings_SpecifyAll(t *testing.T) {
 						"type":       "kinesis",
 						"grace_time": "3s",
 					},
+					"dead_letter": map[string]any{
+						"enabled": true,
+						"output":  "my_dead_letters",
+					},
 					"healthcheck": map[string]any{
 						"timeout": "3m",
 					},
@@ -99,6 +107,8 @@ func TestReadConsumerSettings_SpecifyAll(t *testing.T) {
 		Input:                "my_consumer",
 		RunnerCount:          2,
 		Encoding:             "application/protobuf",
+		Encryption:           stream.EncryptionSettings{KeyProvider: "default"},
+		ClaimCheck:           stream.ClaimCheckSettings{Store: "claim_check"},
 		IdleTimeout:          time.Second * 5,
 		ConsumeGraceTime:     time.Second * 3,
 		AcknowledgeGraceTime: time.Second * 2,
@@ -107,6 +117,10 @@ func TestReadConsumerSettings_SpecifyAll(t *testing.T) {
 			Type:      "kinesis",
 			GraceTime: time.Second * 3,
 		},
+		DeadLetter: stream.ConsumerDeadLetterSettings{
+			Enabled: true,
+			Output:  "my_dead_letters",
+		},
 		Healthcheck: health.HealthCheckSettings{
 			Timeout: 3 * time.Minute,
 		},
@@ -22,6 +22,7 @@ import (
 	"github.com/justtrackio/gosoline/pkg/test/matcher"
 	"github.com/justtrackio/gosoline/pkg/tracing"
 	uuidMocks "github.com/justtrackio/gosoline/pkg/uuid/mocks"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
@@ -49,6 +50,9 @@ type ConsumerTestSuite struct {
 	retryStopOnce sync.Once
 	retryStop     func(context.Context)
 
+	deadLetter  *mocks.DeadLetterHandler
+	idempotency stream.IdempotencyHandler
+
 	uuidGen  *uuidMocks.Uuid
 	callback *mocks.RunnableUntypedConsumerCallback
 	consumer *stream.Consumer
@@ -84,17 +88,13 @@ func (s *ConsumerTestSuite) SetupTest() {
 	s.retryInput.EXPECT().Stop(matcher.Context).Run(s.retryStop).Once()
 
 	s.retryHandler = mocks.NewRetryHandler(s.T())
+	s.deadLetter = mocks.NewDeadLetterHandler(s.T())
+	s.idempotency = stream.NewIdempotencyHandlerNoop()
 
 	s.uuidGen = uuidMocks.NewUuid(s.T())
 	s.callback = mocks.NewRunnableUntypedConsumerCallback(s.T())
 
-	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
-	tracer := tracing.NewLocalTracer()
-	mw := metricMocks.NewWriter(s.T())
-	mw.EXPECT().Write(matcher.Context, mock.Anything).Return().Maybe()
-	me := stream.NewMessageEncoder(&stream.MessageEncoderSettings{})
-
-	settings := stream.ConsumerSettings{
+	s.setupConsumer(stream.ConsumerSettings{
 		Input:       "test",
 		RunnerCount: 1,
 		IdleTimeout: time.Second,
@@ -105,7 +105,15 @@ func (s *ConsumerTestSuite) SetupTest() {
 			Timeout: time.Minute,
 		},
 		AggregateMessageMode: stream.AggregateMessageModeAtMostOnce,
-	}
+	})
+}
+
+func (s *ConsumerTestSuite) setupConsumer(settings stream.ConsumerSettings) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	tracer := tracing.NewLocalTracer()
+	mw := metricMocks.NewWriter(s.T())
+	mw.EXPECT().Write(matcher.Context, mock.Anything).Return().Maybe()
+	me := stream.NewMessageEncoder(&stream.MessageEncoderSettings{})
 
 	healthCheckTimer := clock.NewHealthCheckTimerWithInterfaces(clock.NewFakeClock(), settings.Healthcheck.Timeout)
 
@@ -127,6 +135,8 @@ func (s *ConsumerTestSuite) SetupTest() {
 		settings,
 		"test",
 		cfg.Identity{},
+		stream.WithDeadLetterHandler(s.deadLetter, 3),
+		stream.WithIdempotencyHandler(s.idempotency),
 	)
 	s.consumer = stream.NewUntypedConsumerWithInterfaces(baseConsumer, s.callback, healthCheckTimer, samplingDecider)
 }
@@ -279,9 +289,10 @@ func (s *ConsumerTestSuite) TestRun_CallbackRunPanic() {
 
 	retryMsg := &stream.Message{
 		Attributes: map[string]string{
-			stream.AttributeEncoding: stream.EncodingJson.String(),
-			stream.AttributeRetry:    "true",
-			stream.AttributeRetryId:  "75828fe1-4c7d-4a21-99e5-03d63876ed23",
+			stream.AttributeEncoding:     stream.EncodingJson.String(),
+			stream.AttributeRetry:        "true",
+			stream.AttributeRetryAttempt: "2",
+			stream.AttributeRetryId:      "75828fe1-4c7d-4a21-99e5-03d63876ed23",
 		},
 		Body: `"bar"`,
 	}
@@ -394,9 +405,10 @@ func (s *ConsumerTestSuite) TestRunWithRetry() {
 
 	originalMessage := stream.NewJsonMessage(`"foo"`)
 	retryMessage := stream.NewMessage(`"foo"`, map[string]string{
-		stream.AttributeEncoding: stream.EncodingJson.String(),
-		stream.AttributeRetry:    "true",
-		stream.AttributeRetryId:  uuid,
+		stream.AttributeEncoding:     stream.EncodingJson.String(),
+		stream.AttributeRetry:        "true",
+		stream.AttributeRetryAttempt: "2",
+		stream.AttributeRetryId:      uuid,
 	})
 
 	s.input.EXPECT().
@@ -457,3 +469,58 @@ func (s *ConsumerTestSuite) TestRunWithRetry() {
 	s.Equal("foo", consumed[0])
 	s.Equal("foo from retry", consumed[1])
 }
+
+func (s *ConsumerTestSuite) TestRunWithDeadLetter() {
+	s.setupConsumer(stream.ConsumerSettings{
+		Input:       "test",
+		RunnerCount: 1,
+		IdleTimeout: time.Second,
+		Retry: stream.ConsumerRetrySettings{
+			Enabled: true,
+		},
+		DeadLetter: stream.ConsumerDeadLetterSettings{
+			Enabled: true,
+		},
+		Healthcheck: health.HealthCheckSettings{
+			Timeout: time.Minute,
+		},
+		AggregateMessageMode: stream.AggregateMessageModeAtMostOnce,
+	})
+
+	retriedMessage := stream.NewJsonMessage(`"foo"`, map[string]string{
+		stream.AttributeRetry:        "true",
+		stream.AttributeRetryAttempt: "3",
+		stream.AttributeRetryId:      "243da976-c43f-4578-9307-596146e7dd9a",
+	})
+
+	s.input.EXPECT().Run(matcher.Context).Return(nil).Once()
+	s.retryInput.EXPECT().
+		Run(matcher.Context).
+		Run(func(ctx context.Context) {
+			s.retryData <- retriedMessage
+		}).
+		Return(nil).
+		Once()
+
+	s.deadLetter.EXPECT().
+		Put(matcher.Context, retriedMessage, 3, assert.AnError).
+		Run(func(ctx context.Context, msg *stream.Message, attempts int, reason error) {
+			s.kernelCancel()
+		}).
+		Return(nil).
+		Once()
+
+	s.callback.EXPECT().
+		GetModel(mock.AnythingOfType("map[string]string")).
+		Return(mdl.Box(""), nil).
+		Once()
+	s.callback.EXPECT().
+		Consume(matcher.Context, mock.AnythingOfType("*string"), mock.AnythingOfType("map[string]string")).
+		Return(false, assert.AnError).
+		Once()
+	s.callback.EXPECT().Run(matcher.Context).Return(nil)
+
+	err := s.consumer.Run(s.kernelCtx)
+
+	s.NoError(err, "there should be no error during run")
+}
@@ -0,0 +1,126 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/funk"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const (
+	AttributeDeadLetterAttempts = "goso.dead_letter.attempts"
+	AttributeDeadLetterConsumer = "goso.dead_letter.consumer"
+	AttributeDeadLetterError    = "goso.dead_letter.error"
+	AttributeDeadLetterInput    = "goso.dead_letter.input"
+	AttributeDeadLetterTime     = "goso.dead_letter.time"
+)
+
+// deadLetterAttributes are removed from a message once it gets replayed into the original input.
+var deadLetterAttributes = []string{
+	AttributeDeadLetterAttempts,
+	AttributeDeadLetterConsumer,
+	AttributeDeadLetterError,
+	AttributeDeadLetterInput,
+	AttributeDeadLetterTime,
+	AttributeRetry,
+	AttributeRetryAttempt,
+	AttributeRetryId,
+	AttributeSqsMessageId,
+	AttributeSqsReceiptHandle,
+	AttributeSqsApproximateReceiveCount,
+}
+
+type ConsumerDeadLetterSettings struct {
+	Enabled bool   `cfg:"enabled" default:"false"`
+	Output  string `cfg:"output"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name DeadLetterHandler
+type DeadLetterHandler interface {
+	// Put writes the message together with the reason it failed to the dead letter output.
+	Put(ctx context.Context, msg *Message, attempts int, reason error) error
+}
+
+type deadLetterHandler struct {
+	clock        clock.Clock
+	output       Output
+	consumerName string
+	inputName    string
+}
+
+// NewDeadLetterHandler creates the handler receiving the messages of a consumer for which all retries are exhausted.
+// If dead lettering is disabled, a handler dropping all messages is returned instead.
+func NewDeadLetterHandler(ctx context.Context, config cfg.Config, logger log.Logger, consumerName string, settings ConsumerSettings) (DeadLetterHandler, error) {
+	if !settings.DeadLetter.Enabled {
+		return NewDeadLetterHandlerNoop(), nil
+	}
+
+	outputName := settings.DeadLetter.Output
+	if outputName == "" {
+		outputName = DeadLetterOutputName(consumerName)
+	}
+
+	output, _, err := NewConfigurableOutput(ctx, config, logger, outputName)
+	if err != nil {
+		return nil, fmt.Errorf("can not create dead letter output %s: %w", outputName, err)
+	}
+
+	return NewDeadLetterHandlerWithInterfaces(clock.Provider, output, consumerName, settings.Input), nil
+}
+
+func NewDeadLetterHandlerWithInterfaces(clock clock.Clock, output Output, consumerName string, inputName string) DeadLetterHandler {
+	return &deadLetterHandler{
+		clock:        clock,
+		output:       output,
+		consumerName: consumerName,
+		inputName:    inputName,
+	}
+}
+
+func (h *deadLetterHandler) Put(ctx context.Context, msg *Message, attempts int, reason error) error {
+	errText := "the message was not acknowledged"
+	if reason != nil {
+		errText = reason.Error()
+	}
+
+	deadLetterMsg := &Message{
+		Attributes: funk.MergeMaps(msg.Attributes, map[string]string{
+			AttributeDeadLetterAttempts: strconv.Itoa(attempts),
+			AttributeDeadLetterConsumer: h.consumerName,
+			AttributeDeadLetterError:    errText,
+			AttributeDeadLetterInput:    h.inputName,
+			AttributeDeadLetterTime:     h.clock.Now().Format(time.RFC3339),
+		}),
+		Body: msg.Body,
+	}
+
+	// attributes of the input the message was read from don't make any sense on a different input
+	delete(deadLetterMsg.Attributes, AttributeSqsMessageId)
+	delete(deadLetterMsg.Attributes, AttributeSqsReceiptHandle)
+	delete(deadLetterMsg.Attributes, AttributeSqsApproximateReceiveCount)
+
+	if err := h.output.WriteOne(ctx, deadLetterMsg); err != nil {
+		return fmt.Errorf("can not write the message to the dead letter output: %w", err)
+	}
+
+	return nil
+}
+
+type deadLetterHandlerNoop struct{}
+
+func NewDeadLetterHandlerNoop() DeadLetterHandler {
+	return deadLetterHandlerNoop{}
+}
+
+func (h deadLetterHandlerNoop) Put(context.Context, *Message, int, error) error {
+	return nil
+}
+
+func DeadLetterOutputName(consumerName string) string {
+	return fmt.Sprintf("consumer-dead-letter-%s", consumerName)
+}
@@ -0,0 +1,171 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/coffin"
+	"github.com/justtrackio/gosoline/pkg/funk"
+	"github.com/justtrackio/gosoline/pkg/kernel"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type DeadLetterReplaySettings struct {
+	// Input is the name of the input reading the dead letter messages, it defaults to the dead letter output name of the consumer.
+	Input string `cfg:"input"`
+	// Output is the name of an output writing to the original input of the consumer.
+	Output string `cfg:"output" validate:"required"`
+	// IdleTimeout stops the replay if there was no message for the given duration. A value of 0 keeps the replay running.
+	IdleTimeout time.Duration `cfg:"idle_timeout" default:"30s"`
+}
+
+// DeadLetterReplayModule reads the messages of a dead letter input, removes all retry and dead letter attributes and
+// writes them back into the original input of the consumer. Add it as the only module of an application to replay
+// a dead letter queue once or together with the consumer to replay continuously.
+type DeadLetterReplayModule struct {
+	kernel.ForegroundModule
+	kernel.ApplicationStage
+
+	logger   log.Logger
+	clock    clock.Clock
+	input    Input
+	output   Output
+	settings *DeadLetterReplaySettings
+	replayed int
+}
+
+func NewDeadLetterReplayModule(consumerName string) kernel.ModuleFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (kernel.Module, error) {
+		var err error
+		var settings *DeadLetterReplaySettings
+		var input Input
+		var output Output
+
+		logger = logger.WithChannel(fmt.Sprintf("dead-letter-replay-%s", consumerName))
+
+		if settings, err = ReadDeadLetterReplaySettings(config, consumerName); err != nil {
+			return nil, err
+		}
+
+		if input, err = NewConfigurableInput(ctx, config, logger, settings.Input); err != nil {
+			return nil, fmt.Errorf("can not create dead letter input %s: %w", settings.Input, err)
+		}
+
+		if output, _, err = NewConfigurableOutput(ctx, config, logger, settings.Output); err != nil {
+			return nil, fmt.Errorf("can not create replay output %s: %w", settings.Output, err)
+		}
+
+		return NewDeadLetterReplayModuleWithInterfaces(logger, clock.Provider, input, output, settings), nil
+	}
+}
+
+func NewDeadLetterReplayModuleWithInterfaces(logger log.Logger, clock clock.Clock, input Input, output Output, settings *DeadLetterReplaySettings) *DeadLetterReplayModule {
+	return &DeadLetterReplayModule{
+		logger:   logger,
+		clock:    clock,
+		input:    input,
+		output:   output,
+		settings: settings,
+	}
+}
+
+func (m *DeadLetterReplayModule) Run(ctx context.Context) error {
+	cfn, cfnCtx := coffin.WithContext(ctx)
+
+	cfn.GoWithContextf(cfnCtx, m.input.Run, "panic during run of the dead letter input")
+	cfn.GoWithContextf(cfnCtx, m.replay, "panic during replaying dead letters")
+
+	if err := cfn.Wait(); err != nil {
+		return fmt.Errorf("can not replay dead letters: %w", err)
+	}
+
+	m.logger.Info(ctx, "replayed %d dead letter messages", m.replayed)
+
+	return nil
+}
+
+func (m *DeadLetterReplayModule) replay(ctx context.Context) error {
+	defer m.input.Stop(ctx)
+
+	var idle <-chan time.Time
+
+	for {
+		if m.settings.IdleTimeout > 0 {
+			idle = m.clock.After(m.settings.IdleTimeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-idle:
+			m.logger.Info(ctx, "no dead letter messages received for %s, stopping the replay", m.settings.IdleTimeout)
+
+			return nil
+
+		case msg, ok := <-m.input.Data():
+			if !ok {
+				return nil
+			}
+
+			if err := m.replayMessage(ctx, msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *DeadLetterReplayModule) replayMessage(ctx context.Context, msg *Message) error {
+	replayMsg := &Message{
+		Attributes: funk.MergeMaps(msg.Attributes),
+		Body:       msg.Body,
+	}
+
+	m.logger.Info(
+		ctx,
+		"replaying message of consumer %s which failed after %s attempt(s) with error: %s",
+		msg.Attributes[AttributeDeadLetterConsumer],
+		msg.Attributes[AttributeDeadLetterAttempts],
+		msg.Attributes[AttributeDeadLetterError],
+	)
+
+	for _, attribute := range deadLetterAttributes {
+		delete(replayMsg.Attributes, attribute)
+	}
+
+	if err := m.output.WriteOne(ctx, replayMsg); err != nil {
+		return fmt.Errorf("can not write the message to the replay output: %w", err)
+	}
+
+	if ackInput, ok := m.input.(AcknowledgeableInput); ok {
+		if err := ackInput.Ack(ctx, msg, true); err != nil {
+			return fmt.Errorf("can not acknowledge the replayed message: %w", err)
+		}
+	}
+
+	m.replayed++
+
+	return nil
+}
+
+func ConfigurableDeadLetterReplayKey(consumerName string) string {
+	return fmt.Sprintf("%s.dead_letter.replay", ConfigurableConsumerKey(consumerName))
+}
+
+func ReadDeadLetterReplaySettings(config cfg.Config, consumerName string) (*DeadLetterReplaySettings, error) {
+	key := ConfigurableDeadLetterReplayKey(consumerName)
+
+	settings := &DeadLetterReplaySettings{}
+	if err := config.UnmarshalKey(key, settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal dead letter replay settings for key %q: %w", key, err)
+	}
+
+	if settings.Input == "" {
+		settings.Input = DeadLetterOutputName(consumerName)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,96 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/justtrackio/gosoline/pkg/stream/mocks"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeadLetterHandler_Put(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	output := mocks.NewOutput(t)
+	handler := stream.NewDeadLetterHandlerWithInterfaces(clock.NewFakeClockAt(now), output, "consumer", "input")
+
+	msg := stream.NewJsonMessage(`"foo"`, map[string]string{
+		"modelId":                                  "model",
+		stream.AttributeRetryAttempt:               "3",
+		stream.AttributeSqsReceiptHandle:           "handle",
+		stream.AttributeSqsApproximateReceiveCount: "2",
+	})
+
+	output.EXPECT().WriteOne(matcher.Context, &stream.Message{
+		Attributes: map[string]string{
+			"encoding":                         "application/json",
+			"modelId":                          "model",
+			stream.AttributeRetryAttempt:       "3",
+			stream.AttributeDeadLetterAttempts: "3",
+			stream.AttributeDeadLetterConsumer: "consumer",
+			stream.AttributeDeadLetterError:    assert.AnError.Error(),
+			stream.AttributeDeadLetterInput:    "input",
+			stream.AttributeDeadLetterTime:     "2024-01-02T03:04:05Z",
+		},
+		Body: `"foo"`,
+	}).Return(nil).Once()
+
+	err := handler.Put(t.Context(), msg, 3, assert.AnError)
+	assert.NoError(t, err)
+	assert.Equal(t, "handle", msg.Attributes[stream.AttributeSqsReceiptHandle], "the original message should not be modified")
+}
+
+func TestDeadLetterHandler_PutOutputFailure(t *testing.T) {
+	output := mocks.NewOutput(t)
+	handler := stream.NewDeadLetterHandlerWithInterfaces(clock.NewFakeClock(), output, "consumer", "input")
+
+	output.EXPECT().WriteOne(matcher.Context, mock.Anything).Return(assert.AnError).Once()
+
+	err := handler.Put(t.Context(), stream.NewJsonMessage(`"foo"`), 1, nil)
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestDeadLetterReplayModule_Run(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	input := stream.NewInMemoryInput(&stream.InMemorySettings{Size: 2})
+	output := mocks.NewOutput(t)
+
+	input.Publish(stream.NewJsonMessage(`"foo"`, map[string]string{
+		"modelId":                          "model",
+		stream.AttributeRetry:              "true",
+		stream.AttributeRetryAttempt:       "3",
+		stream.AttributeRetryId:            "243da976-c43f-4578-9307-596146e7dd9a",
+		stream.AttributeDeadLetterAttempts: "3",
+		stream.AttributeDeadLetterConsumer: "consumer",
+		stream.AttributeDeadLetterError:    "error",
+		stream.AttributeDeadLetterInput:    "input",
+		stream.AttributeDeadLetterTime:     "2024-01-02T03:04:05Z",
+	}))
+	input.Publish(stream.NewJsonMessage(`"bar"`))
+
+	output.EXPECT().WriteOne(matcher.Context, &stream.Message{
+		Attributes: map[string]string{
+			"encoding": "application/json",
+			"modelId":  "model",
+		},
+		Body: `"foo"`,
+	}).Return(nil).Once()
+	output.EXPECT().WriteOne(matcher.Context, &stream.Message{
+		Attributes: map[string]string{
+			"encoding": "application/json",
+		},
+		Body: `"bar"`,
+	}).Run(func(ctx context.Context, msg stream.WritableMessage) {
+		input.Stop(ctx)
+	}).Return(nil).Once()
+
+	module := stream.NewDeadLetterReplayModuleWithInterfaces(logger, clock.NewFakeClock(), input, output, &stream.DeadLetterReplaySettings{})
+
+	err := module.Run(t.Context())
+	assert.NoError(t, err)
+}
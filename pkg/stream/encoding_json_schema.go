@@ -0,0 +1,53 @@
+package stream
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+type jsonSchemaEncoder struct {
+	schema *gojsonschema.Schema
+}
+
+// NewJsonSchemaEncoder returns an encoder which validates every encoded message against the json schema, so a producer
+// can't write messages its consumers are unable to read. Decoding doesn't validate to stay tolerant to newer schemas.
+func NewJsonSchemaEncoder(schema string) (MessageBodyEncoder, error) {
+	jsonSchema, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(schema))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse json schema: %w", err)
+	}
+
+	return jsonSchemaEncoder{
+		schema: jsonSchema,
+	}, nil
+}
+
+func (e jsonSchemaEncoder) Encode(data any) ([]byte, error) {
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := e.schema.Validate(gojsonschema.NewBytesLoader(bytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate message against json schema: %w", err)
+	}
+
+	if !result.Valid() {
+		violations := make([]string, len(result.Errors()))
+		for i, violation := range result.Errors() {
+			violations[i] = violation.String()
+		}
+
+		return nil, fmt.Errorf("message does not match the json schema: %s", strings.Join(violations, "; "))
+	}
+
+	return bytes, nil
+}
+
+func (e jsonSchemaEncoder) Decode(data []byte, out any) error {
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,164 @@
+package stream
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+type EncryptionType string
+
+const (
+	EncryptionAesGcm EncryptionType = "aes-gcm"
+)
+
+func (s EncryptionType) String() string {
+	return string(s)
+}
+
+var _ fmt.Stringer = EncryptionType("")
+
+type EncryptionSettings struct {
+	// Enabled encrypts the bodies of produced messages. Consumers need it to decrypt encrypted messages.
+	Enabled bool `cfg:"enabled" default:"false"`
+	// KeyProvider is the name of the key provider configured at stream.encryption.key_provider.<name>.
+	KeyProvider string `cfg:"key_provider" default:"default"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name MessageBodyEncryptor
+type MessageBodyEncryptor interface {
+	// Encrypt encrypts the body and adds the attributes needed to decrypt it again.
+	Encrypt(ctx context.Context, body []byte, attributes map[string]string) ([]byte, error)
+	// Decrypt decrypts a body which got encrypted by Encrypt with the attributes of the message.
+	Decrypt(ctx context.Context, body []byte, attributes map[string]string) ([]byte, error)
+}
+
+// aesGcmEncryptor implements envelope encryption: every body is encrypted with AES-GCM using a data key from the key
+// provider. The data key encrypted with the master key of the provider is stored in front of the encrypted body, the
+// id of the master key is stored in the message attributes:
+//
+//	| length of encrypted data key (2 bytes) | encrypted data key | nonce (12 bytes) | encrypted body |
+type aesGcmEncryptor struct {
+	keyProvider EncryptionKeyProvider
+}
+
+func NewMessageBodyEncryptor(ctx context.Context, config cfg.Config, logger log.Logger, settings EncryptionSettings) (MessageBodyEncryptor, error) {
+	keyProvider, err := ProvideEncryptionKeyProvider(ctx, config, logger, settings.KeyProvider)
+	if err != nil {
+		return nil, fmt.Errorf("can not create encryption key provider %s: %w", settings.KeyProvider, err)
+	}
+
+	return NewAesGcmEncryptorWithInterfaces(keyProvider), nil
+}
+
+func NewAesGcmEncryptorWithInterfaces(keyProvider EncryptionKeyProvider) MessageBodyEncryptor {
+	return &aesGcmEncryptor{
+		keyProvider: keyProvider,
+	}
+}
+
+func (e *aesGcmEncryptor) Encrypt(ctx context.Context, body []byte, attributes map[string]string) ([]byte, error) {
+	dataKey, err := e.keyProvider.GenerateDataKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("can not generate data key: %w", err)
+	}
+
+	if len(dataKey.Encrypted) > 0xffff {
+		return nil, fmt.Errorf("the encrypted data key of master key %s is too long: %d bytes", dataKey.KeyId, len(dataKey.Encrypted))
+	}
+
+	sealed, err := sealAesGcm(dataKey.Plaintext, body, []byte(dataKey.KeyId))
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := make([]byte, 0, 2+len(dataKey.Encrypted)+len(sealed))
+	envelope = binary.BigEndian.AppendUint16(envelope, uint16(len(dataKey.Encrypted)))
+	envelope = append(envelope, dataKey.Encrypted...)
+	envelope = append(envelope, sealed...)
+
+	attributes[AttributeEncryption] = EncryptionAesGcm.String()
+	attributes[AttributeEncryptionKeyId] = dataKey.KeyId
+
+	return envelope, nil
+}
+
+func (e *aesGcmEncryptor) Decrypt(ctx context.Context, body []byte, attributes map[string]string) ([]byte, error) {
+	if encryption := GetEncryptionAttribute(attributes); encryption == nil || *encryption != EncryptionAesGcm {
+		return nil, fmt.Errorf("the message is not encrypted with %s", EncryptionAesGcm)
+	}
+
+	keyId, ok := attributes[AttributeEncryptionKeyId]
+	if !ok {
+		return nil, fmt.Errorf("the message has no %s attribute", AttributeEncryptionKeyId)
+	}
+
+	if len(body) < 2 {
+		return nil, fmt.Errorf("the encrypted body is too short")
+	}
+
+	keyLength := int(binary.BigEndian.Uint16(body))
+	if len(body) < 2+keyLength {
+		return nil, fmt.Errorf("the encrypted body is too short for a data key of %d bytes", keyLength)
+	}
+
+	plaintextKey, err := e.keyProvider.DecryptDataKey(ctx, keyId, body[2:2+keyLength])
+	if err != nil {
+		return nil, fmt.Errorf("can not decrypt data key of master key %s: %w", keyId, err)
+	}
+
+	return openAesGcm(plaintextKey, body[2+keyLength:], []byte(keyId))
+}
+
+// sealAesGcm encrypts the plaintext with a random nonce and returns the nonce followed by the ciphertext.
+func sealAesGcm(key []byte, plaintext []byte, additionalData []byte) ([]byte, error) {
+	aead, err := newAesGcm(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("can not generate nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func openAesGcm(key []byte, sealed []byte, additionalData []byte) ([]byte, error) {
+	aead, err := newAesGcm(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("the ciphertext is too short")
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("can not decrypt: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+func newAesGcm(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("can not create aes cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("can not create gcm cipher: %w", err)
+	}
+
+	return aead, nil
+}
@@ -0,0 +1,239 @@
+package stream
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cache"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	gosoKms "github.com/justtrackio/gosoline/pkg/cloud/aws/kms"
+	"github.com/justtrackio/gosoline/pkg/encoding/base64"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+const (
+	EncryptionKeyProviderTypeStatic = "static"
+	EncryptionKeyProviderTypeKms    = "kms"
+
+	dataKeySize        = 32
+	generatedDataKeyId = "generated"
+)
+
+// DataKey is a key to encrypt a single message body with. Only its encrypted form is stored with the message.
+type DataKey struct {
+	// KeyId is the id of the master key which encrypted the data key.
+	KeyId     string
+	Plaintext []byte
+	Encrypted []byte
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name EncryptionKeyProvider
+type EncryptionKeyProvider interface {
+	// GenerateDataKey returns a data key encrypted with the current master key.
+	GenerateDataKey(ctx context.Context) (*DataKey, error)
+	// DecryptDataKey decrypts a data key encrypted with the master key with the given id. This has to work for older
+	// master keys as well after a key rotation, as long as there are still messages encrypted with them.
+	DecryptDataKey(ctx context.Context, keyId string, encrypted []byte) ([]byte, error)
+}
+
+type EncryptionKeyProviderFactory func(ctx context.Context, config cfg.Config, logger log.Logger, name string) (EncryptionKeyProvider, error)
+
+var encryptionKeyProviderFactories = map[string]EncryptionKeyProviderFactory{
+	EncryptionKeyProviderTypeStatic: newStaticEncryptionKeyProviderFromConfig,
+	EncryptionKeyProviderTypeKms:    newKmsEncryptionKeyProviderFromConfig,
+}
+
+func SetEncryptionKeyProviderFactory(typ string, factory EncryptionKeyProviderFactory) {
+	encryptionKeyProviderFactories[typ] = factory
+}
+
+type encryptionKeyProviderKey string
+
+func ProvideEncryptionKeyProvider(ctx context.Context, config cfg.Config, logger log.Logger, name string) (EncryptionKeyProvider, error) {
+	return appctx.Provide(ctx, encryptionKeyProviderKey(name), func() (EncryptionKeyProvider, error) {
+		return NewEncryptionKeyProvider(ctx, config, logger, name)
+	})
+}
+
+func NewEncryptionKeyProvider(ctx context.Context, config cfg.Config, logger log.Logger, name string) (EncryptionKeyProvider, error) {
+	key := ConfigurableEncryptionKeyProviderKey(name)
+
+	if !config.IsSet(key) {
+		return nil, fmt.Errorf("there is no encryption key provider configured at %s", key)
+	}
+
+	typ, err := config.GetString(fmt.Sprintf("%s.type", key), EncryptionKeyProviderTypeStatic)
+	if err != nil {
+		return nil, fmt.Errorf("can not read type of encryption key provider %s: %w", name, err)
+	}
+
+	factory, ok := encryptionKeyProviderFactories[typ]
+	if !ok {
+		return nil, fmt.Errorf("invalid encryption key provider %s of type %s", name, typ)
+	}
+
+	return factory(ctx, config, logger, name)
+}
+
+func ConfigurableEncryptionKeyProviderKey(name string) string {
+	return fmt.Sprintf("stream.encryption.key_provider.%s", name)
+}
+
+type StaticEncryptionKeyProviderSettings struct {
+	// CurrentKeyId is the id of the key new messages are encrypted with. To rotate the key, add a new key, make it the
+	// current one and keep the old key until all messages encrypted with it are consumed.
+	CurrentKeyId string `cfg:"current_key_id" validate:"required"`
+	// Keys maps key ids to base64 encoded AES keys of 16, 24 or 32 bytes.
+	Keys map[string]string `cfg:"keys" validate:"required"`
+}
+
+// staticEncryptionKeyProvider encrypts the data keys with master keys from the config.
+type staticEncryptionKeyProvider struct {
+	currentKeyId string
+	keys         map[string][]byte
+}
+
+func newStaticEncryptionKeyProviderFromConfig(_ context.Context, config cfg.Config, _ log.Logger, name string) (EncryptionKeyProvider, error) {
+	key := ConfigurableEncryptionKeyProviderKey(name)
+
+	settings := &StaticEncryptionKeyProviderSettings{}
+	if err := config.UnmarshalKey(key, settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal static encryption key provider settings for key %q: %w", key, err)
+	}
+
+	return NewStaticEncryptionKeyProvider(settings)
+}
+
+func NewStaticEncryptionKeyProvider(settings *StaticEncryptionKeyProviderSettings) (EncryptionKeyProvider, error) {
+	keys := make(map[string][]byte, len(settings.Keys))
+
+	for keyId, encoded := range settings.Keys {
+		key, err := base64.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("can not decode key %s: %w", keyId, err)
+		}
+
+		if _, err = newAesGcm(key); err != nil {
+			return nil, fmt.Errorf("invalid key %s: %w", keyId, err)
+		}
+
+		keys[keyId] = key
+	}
+
+	if _, ok := keys[settings.CurrentKeyId]; !ok {
+		return nil, fmt.Errorf("there is no key for the current key id %s", settings.CurrentKeyId)
+	}
+
+	return &staticEncryptionKeyProvider{
+		currentKeyId: settings.CurrentKeyId,
+		keys:         keys,
+	}, nil
+}
+
+func (p *staticEncryptionKeyProvider) GenerateDataKey(_ context.Context) (*DataKey, error) {
+	plaintext := make([]byte, dataKeySize)
+	if _, err := rand.Read(plaintext); err != nil {
+		return nil, fmt.Errorf("can not generate data key: %w", err)
+	}
+
+	encrypted, err := sealAesGcm(p.keys[p.currentKeyId], plaintext, []byte(p.currentKeyId))
+	if err != nil {
+		return nil, fmt.Errorf("can not encrypt data key: %w", err)
+	}
+
+	return &DataKey{
+		KeyId:     p.currentKeyId,
+		Plaintext: plaintext,
+		Encrypted: encrypted,
+	}, nil
+}
+
+func (p *staticEncryptionKeyProvider) DecryptDataKey(_ context.Context, keyId string, encrypted []byte) ([]byte, error) {
+	key, ok := p.keys[keyId]
+	if !ok {
+		return nil, fmt.Errorf("there is no key with id %s", keyId)
+	}
+
+	return openAesGcm(key, encrypted, []byte(keyId))
+}
+
+type KmsEncryptionKeyProviderSettings struct {
+	// KeyId is the id, arn or alias of the kms key new messages are encrypted with. Kms takes care of decrypting data
+	// keys of older key versions after a rotation.
+	KeyId      string `cfg:"key_id" validate:"required"`
+	ClientName string `cfg:"client_name" default:"default"`
+	// DataKeyTtl defines how long a data key is used to encrypt messages and how long decrypted data keys are cached,
+	// so not every message requires a call to kms.
+	DataKeyTtl time.Duration `cfg:"data_key_ttl" default:"5m"`
+}
+
+// kmsEncryptionKeyProvider generates and decrypts data keys with a kms compatible api.
+type kmsEncryptionKeyProvider struct {
+	client        gosoKms.Client
+	settings      *KmsEncryptionKeyProviderSettings
+	generatedKeys cache.Cache[*DataKey]
+	decryptedKeys cache.Cache[[]byte]
+}
+
+func newKmsEncryptionKeyProviderFromConfig(ctx context.Context, config cfg.Config, logger log.Logger, name string) (EncryptionKeyProvider, error) {
+	key := ConfigurableEncryptionKeyProviderKey(name)
+
+	settings := &KmsEncryptionKeyProviderSettings{}
+	if err := config.UnmarshalKey(key, settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal kms encryption key provider settings for key %q: %w", key, err)
+	}
+
+	client, err := gosoKms.ProvideClient(ctx, config, logger, settings.ClientName)
+	if err != nil {
+		return nil, fmt.Errorf("can not create kms client %s: %w", settings.ClientName, err)
+	}
+
+	return NewKmsEncryptionKeyProviderWithInterfaces(client, settings), nil
+}
+
+func NewKmsEncryptionKeyProviderWithInterfaces(client gosoKms.Client, settings *KmsEncryptionKeyProviderSettings) EncryptionKeyProvider {
+	return &kmsEncryptionKeyProvider{
+		client:        client,
+		settings:      settings,
+		generatedKeys: cache.New[*DataKey](1, 1, settings.DataKeyTtl),
+		decryptedKeys: cache.New[[]byte](1000, 100, settings.DataKeyTtl),
+	}
+}
+
+func (p *kmsEncryptionKeyProvider) GenerateDataKey(ctx context.Context) (*DataKey, error) {
+	return p.generatedKeys.ProvideWithError(generatedDataKeyId, func() (*DataKey, error) {
+		out, err := p.client.GenerateDataKey(ctx, &kms.GenerateDataKeyInput{
+			KeyId:   aws.String(p.settings.KeyId),
+			KeySpec: types.DataKeySpecAes256,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("can not generate data key with kms key %s: %w", p.settings.KeyId, err)
+		}
+
+		return &DataKey{
+			KeyId:     aws.ToString(out.KeyId),
+			Plaintext: out.Plaintext,
+			Encrypted: out.CiphertextBlob,
+		}, nil
+	})
+}
+
+func (p *kmsEncryptionKeyProvider) DecryptDataKey(ctx context.Context, keyId string, encrypted []byte) ([]byte, error) {
+	return p.decryptedKeys.ProvideWithError(base64.EncodeToString(encrypted), func() ([]byte, error) {
+		out, err := p.client.Decrypt(ctx, &kms.DecryptInput{
+			KeyId:          aws.String(keyId),
+			CiphertextBlob: encrypted,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("can not decrypt data key with kms key %s: %w", keyId, err)
+		}
+
+		return out.Plaintext, nil
+	})
+}
@@ -0,0 +1,214 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/justtrackio/gosoline/pkg/appctx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	kmsMocks "github.com/justtrackio/gosoline/pkg/cloud/aws/kms/mocks"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	encryptionKey1 = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	encryptionKey2 = "ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="
+)
+
+type EncryptionTestSuite struct {
+	suite.Suite
+	ctx  context.Context
+	data encodingTestStruct
+}
+
+func TestEncryptionTestSuite(t *testing.T) {
+	suite.Run(t, new(EncryptionTestSuite))
+}
+
+func (s *EncryptionTestSuite) SetupTest() {
+	s.ctx = s.T().Context()
+	s.data = encodingTestStruct{
+		Id:   3,
+		Text: "some personal data",
+	}
+}
+
+func (s *EncryptionTestSuite) TestEncodeDecode() {
+	encoder := s.encoder(s.staticProvider("key-1"), stream.CompressionGZip)
+
+	msg, err := encoder.Encode(s.ctx, s.data)
+	s.NoError(err)
+	s.Equal(map[string]string{
+		"encoding":        "application/json",
+		"compression":     "application/gzip",
+		"encryption":      "aes-gcm",
+		"encryptionKeyId": "key-1",
+	}, msg.Attributes)
+	s.NotContains(msg.Body, "some personal data")
+
+	out := encodingTestStruct{}
+	_, _, err = encoder.Decode(s.ctx, msg, &out)
+	s.NoError(err)
+	s.Equal(s.data, out)
+}
+
+func (s *EncryptionTestSuite) TestKeyRotation() {
+	oldEncoder := s.encoder(s.staticProvider("key-1"), stream.CompressionNone)
+	newEncoder := s.encoder(s.staticProvider("key-2"), stream.CompressionNone)
+
+	oldMsg, err := oldEncoder.Encode(s.ctx, s.data)
+	s.NoError(err)
+
+	newMsg, err := newEncoder.Encode(s.ctx, s.data)
+	s.NoError(err)
+	s.Equal("key-2", newMsg.Attributes[stream.AttributeEncryptionKeyId])
+
+	for _, msg := range []*stream.Message{oldMsg, newMsg} {
+		out := encodingTestStruct{}
+		_, _, err = newEncoder.Decode(s.ctx, msg, &out)
+		s.NoError(err)
+		s.Equal(s.data, out)
+	}
+}
+
+func (s *EncryptionTestSuite) TestDecodeUnknownKey() {
+	provider, err := stream.NewStaticEncryptionKeyProvider(&stream.StaticEncryptionKeyProviderSettings{
+		CurrentKeyId: "key-3",
+		Keys: map[string]string{
+			"key-3": encryptionKey1,
+		},
+	})
+	s.Require().NoError(err)
+
+	msg, err := s.encoder(s.staticProvider("key-1"), stream.CompressionNone).Encode(s.ctx, s.data)
+	s.NoError(err)
+
+	_, _, err = s.encoder(provider, stream.CompressionNone).Decode(s.ctx, msg, &encodingTestStruct{})
+	s.EqualError(err, "can not decrypt message body: can not decrypt data key of master key key-1: there is no key with id key-1")
+}
+
+func (s *EncryptionTestSuite) TestDecodeTamperedBody() {
+	encoder := s.encoder(s.staticProvider("key-1"), stream.CompressionNone)
+
+	msg, err := encoder.Encode(s.ctx, s.data)
+	s.NoError(err)
+
+	// pretend the message was encrypted with the other key
+	msg.Attributes[stream.AttributeEncryptionKeyId] = "key-2"
+
+	_, _, err = encoder.Decode(s.ctx, msg, &encodingTestStruct{})
+	s.EqualError(err, "can not decrypt message body: can not decrypt data key of master key key-2: can not decrypt: cipher: message authentication failed")
+}
+
+func (s *EncryptionTestSuite) TestDecodeWithoutEncryption() {
+	msg, err := s.encoder(s.staticProvider("key-1"), stream.CompressionNone).Encode(s.ctx, s.data)
+	s.NoError(err)
+
+	encoder := stream.NewMessageEncoder(&stream.MessageEncoderSettings{})
+
+	_, _, err = encoder.Decode(s.ctx, msg, &encodingTestStruct{})
+	s.EqualError(err, "can not decrypt message body: the message is encrypted with aes-gcm, but encryption is not enabled")
+}
+
+func (s *EncryptionTestSuite) TestKeyProviderFromConfig() {
+	config := cfg.New(map[string]any{
+		"stream": map[string]any{
+			"encryption": map[string]any{
+				"key_provider": map[string]any{
+					"default": map[string]any{
+						"type":           "static",
+						"current_key_id": "key-1",
+						"keys": map[string]any{
+							"key-1": encryptionKey1,
+						},
+					},
+				},
+			},
+		},
+	})
+
+	ctx := appctx.WithContainer(s.ctx)
+	encryptor, err := stream.NewMessageBodyEncryptor(ctx, config, log.NewLogger(), stream.EncryptionSettings{
+		Enabled:     true,
+		KeyProvider: "default",
+	})
+	s.Require().NoError(err)
+
+	attributes := map[string]string{}
+	encrypted, err := encryptor.Encrypt(ctx, []byte("body"), attributes)
+	s.NoError(err)
+
+	decrypted, err := encryptor.Decrypt(ctx, encrypted, attributes)
+	s.NoError(err)
+	s.Equal("body", string(decrypted))
+
+	_, err = stream.NewMessageBodyEncryptor(ctx, config, log.NewLogger(), stream.EncryptionSettings{
+		Enabled:     true,
+		KeyProvider: "missing",
+	})
+	s.EqualError(err, "can not create encryption key provider missing: there is no encryption key provider configured at stream.encryption.key_provider.missing")
+}
+
+func (s *EncryptionTestSuite) TestKmsKeyProvider() {
+	client := kmsMocks.NewClient(s.T())
+	client.EXPECT().GenerateDataKey(mock.Anything, &kms.GenerateDataKeyInput{
+		KeyId:   aws.String("alias/pii"),
+		KeySpec: "AES_256",
+	}).Return(&kms.GenerateDataKeyOutput{
+		KeyId:          aws.String("arn:aws:kms:eu-central-1:123456789012:key/pii"),
+		Plaintext:      []byte("0123456789abcdef0123456789abcdef"),
+		CiphertextBlob: []byte("encrypted data key"),
+	}, nil).Once()
+	client.EXPECT().Decrypt(mock.Anything, &kms.DecryptInput{
+		KeyId:          aws.String("arn:aws:kms:eu-central-1:123456789012:key/pii"),
+		CiphertextBlob: []byte("encrypted data key"),
+	}).Return(&kms.DecryptOutput{
+		Plaintext: []byte("0123456789abcdef0123456789abcdef"),
+	}, nil).Once()
+
+	provider := stream.NewKmsEncryptionKeyProviderWithInterfaces(client, &stream.KmsEncryptionKeyProviderSettings{
+		KeyId:      "alias/pii",
+		DataKeyTtl: time.Minute,
+	})
+	encoder := s.encoder(provider, stream.CompressionNone)
+
+	// the data key is generated and decrypted only once and cached afterward
+	for range 3 {
+		msg, err := encoder.Encode(s.ctx, s.data)
+		s.NoError(err)
+		s.Equal("arn:aws:kms:eu-central-1:123456789012:key/pii", msg.Attributes[stream.AttributeEncryptionKeyId])
+
+		out := encodingTestStruct{}
+		_, _, err = encoder.Decode(s.ctx, msg, &out)
+		s.NoError(err)
+		s.Equal(s.data, out)
+	}
+}
+
+func (s *EncryptionTestSuite) staticProvider(currentKeyId string) stream.EncryptionKeyProvider {
+	provider, err := stream.NewStaticEncryptionKeyProvider(&stream.StaticEncryptionKeyProviderSettings{
+		CurrentKeyId: currentKeyId,
+		Keys: map[string]string{
+			"key-1": encryptionKey1,
+			"key-2": encryptionKey2,
+		},
+	})
+	s.Require().NoError(err)
+
+	return provider
+}
+
+func (s *EncryptionTestSuite) encoder(provider stream.EncryptionKeyProvider, compression stream.CompressionType) stream.MessageEncoder {
+	return stream.NewMessageEncoder(&stream.MessageEncoderSettings{
+		Encoding:       stream.EncodingJson,
+		Compression:    compression,
+		EncodeHandlers: []stream.EncodeHandler{},
+		Encryptor:      stream.NewAesGcmEncryptorWithInterfaces(provider),
+	})
+}
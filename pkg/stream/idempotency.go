@@ -0,0 +1,151 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/kvstore"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+// ConsumerIdempotencySettings enable the deduplication of messages. The deduplication key of a message is either the
+// value of the configured attribute or provided by a callback implementing IdempotencyKeyAwareCallback. The keys of
+// consumed messages are recorded in the configured kvstore, which also defines how long a key is remembered (ttl).
+type ConsumerIdempotencySettings struct {
+	Enabled   bool   `cfg:"enabled" default:"false"`
+	Attribute string `cfg:"attribute"`
+	// Store is the name of the kvstore, it defaults to consumer-idempotency-<consumer name>.
+	Store string `cfg:"store"`
+}
+
+// IdempotencyKeyAwareCallback can be implemented by a consumer callback to derive the deduplication key of a message
+// from its model. Returning an empty key disables the deduplication for the message.
+type IdempotencyKeyAwareCallback interface {
+	GetIdempotencyKey(ctx context.Context, model any, attributes map[string]string) (string, error)
+}
+
+// TypedIdempotencyKeyAwareCallback is the counterpart of IdempotencyKeyAwareCallback for typed consumer callbacks.
+type TypedIdempotencyKeyAwareCallback[M any] interface {
+	GetIdempotencyKey(ctx context.Context, model M, attributes map[string]string) (string, error)
+}
+
+type untypedIdempotencyKeyAwareCallback[M any] struct {
+	callback TypedIdempotencyKeyAwareCallback[M]
+}
+
+func (u untypedIdempotencyKeyAwareCallback[M]) GetIdempotencyKey(ctx context.Context, model any, attributes map[string]string) (string, error) {
+	return u.callback.GetIdempotencyKey(ctx, *model.(*M), attributes)
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name IdempotencyHandler
+type IdempotencyHandler interface {
+	// IsDuplicate returns the deduplication key of the message and whether a message with the same key got consumed
+	// already. An empty key means the message can't be deduplicated.
+	IsDuplicate(ctx context.Context, model any, attributes map[string]string) (key string, duplicate bool, err error)
+	// MarkConsumed records the key of a successfully consumed message.
+	MarkConsumed(ctx context.Context, key string) error
+}
+
+type idempotencyHandler struct {
+	store     kvstore.KvStore[bool]
+	attribute string
+	callback  IdempotencyKeyAwareCallback
+}
+
+// NewIdempotencyHandler creates the handler deduplicating the messages of a consumer. If deduplication is disabled,
+// a handler treating every message as new is returned instead.
+func NewIdempotencyHandler(
+	ctx context.Context,
+	config cfg.Config,
+	logger log.Logger,
+	consumerName string,
+	settings ConsumerSettings,
+	consumerCallback any,
+) (IdempotencyHandler, error) {
+	if !settings.Idempotency.Enabled {
+		return NewIdempotencyHandlerNoop(), nil
+	}
+
+	callback, _ := consumerCallback.(IdempotencyKeyAwareCallback)
+	if settings.Idempotency.Attribute == "" && callback == nil {
+		return nil, fmt.Errorf("idempotency of consumer %s requires either an attribute or a callback implementing IdempotencyKeyAwareCallback", consumerName)
+	}
+
+	storeName := settings.Idempotency.Store
+	if storeName == "" {
+		storeName = IdempotencyStoreName(consumerName)
+	}
+
+	store, err := kvstore.ProvideConfigurableKvStore[bool](ctx, config, logger, storeName)
+	if err != nil {
+		return nil, fmt.Errorf("can not create idempotency kvstore %s: %w", storeName, err)
+	}
+
+	return NewIdempotencyHandlerWithInterfaces(store, settings.Idempotency.Attribute, callback), nil
+}
+
+func NewIdempotencyHandlerWithInterfaces(store kvstore.KvStore[bool], attribute string, callback IdempotencyKeyAwareCallback) IdempotencyHandler {
+	return &idempotencyHandler{
+		store:     store,
+		attribute: attribute,
+		callback:  callback,
+	}
+}
+
+func (h *idempotencyHandler) IsDuplicate(ctx context.Context, model any, attributes map[string]string) (string, bool, error) {
+	key, err := h.getKey(ctx, model, attributes)
+	if err != nil || key == "" {
+		return "", false, err
+	}
+
+	duplicate, err := h.store.Contains(ctx, key)
+	if err != nil {
+		return key, false, fmt.Errorf("can not check the idempotency key %s: %w", key, err)
+	}
+
+	return key, duplicate, nil
+}
+
+func (h *idempotencyHandler) MarkConsumed(ctx context.Context, key string) error {
+	if key == "" {
+		return nil
+	}
+
+	if err := h.store.Put(ctx, key, true); err != nil {
+		return fmt.Errorf("can not record the idempotency key %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (h *idempotencyHandler) getKey(ctx context.Context, model any, attributes map[string]string) (string, error) {
+	if h.attribute != "" {
+		return attributes[h.attribute], nil
+	}
+
+	key, err := h.callback.GetIdempotencyKey(ctx, model, attributes)
+	if err != nil {
+		return "", fmt.Errorf("can not get the idempotency key: %w", err)
+	}
+
+	return key, nil
+}
+
+type idempotencyHandlerNoop struct{}
+
+func NewIdempotencyHandlerNoop() IdempotencyHandler {
+	return idempotencyHandlerNoop{}
+}
+
+func (h idempotencyHandlerNoop) IsDuplicate(context.Context, any, map[string]string) (string, bool, error) {
+	return "", false, nil
+}
+
+func (h idempotencyHandlerNoop) MarkConsumed(context.Context, string) error {
+	return nil
+}
+
+func IdempotencyStoreName(consumerName string) string {
+	return fmt.Sprintf("consumer-idempotency-%s", consumerName)
+}
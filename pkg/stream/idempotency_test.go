@@ -0,0 +1,70 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+
+	kvStoreMocks "github.com/justtrackio/gosoline/pkg/kvstore/mocks"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+)
+
+type idempotencyKeyCallback struct{}
+
+func (c idempotencyKeyCallback) GetIdempotencyKey(_ context.Context, model any, _ map[string]string) (string, error) {
+	return *model.(*string), nil
+}
+
+func TestIdempotencyHandler_Attribute(t *testing.T) {
+	store := kvStoreMocks.NewKvStore[bool](t)
+	handler := stream.NewIdempotencyHandlerWithInterfaces(store, "eventId", nil)
+
+	store.EXPECT().Contains(matcher.Context, "abc").Return(true, nil).Once()
+
+	key, duplicate, err := handler.IsDuplicate(t.Context(), mdl.Box("foo"), map[string]string{"eventId": "abc"})
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", key)
+	assert.True(t, duplicate)
+}
+
+func TestIdempotencyHandler_MissingAttribute(t *testing.T) {
+	store := kvStoreMocks.NewKvStore[bool](t)
+	handler := stream.NewIdempotencyHandlerWithInterfaces(store, "eventId", nil)
+
+	key, duplicate, err := handler.IsDuplicate(t.Context(), mdl.Box("foo"), map[string]string{})
+	assert.NoError(t, err)
+	assert.Empty(t, key)
+	assert.False(t, duplicate)
+
+	err = handler.MarkConsumed(t.Context(), key)
+	assert.NoError(t, err)
+}
+
+func TestIdempotencyHandler_Callback(t *testing.T) {
+	store := kvStoreMocks.NewKvStore[bool](t)
+	handler := stream.NewIdempotencyHandlerWithInterfaces(store, "", idempotencyKeyCallback{})
+
+	store.EXPECT().Contains(matcher.Context, "foo").Return(false, nil).Once()
+	store.EXPECT().Put(matcher.Context, "foo", true).Return(nil).Once()
+
+	key, duplicate, err := handler.IsDuplicate(t.Context(), mdl.Box("foo"), map[string]string{})
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", key)
+	assert.False(t, duplicate)
+
+	err = handler.MarkConsumed(t.Context(), key)
+	assert.NoError(t, err)
+}
+
+func TestIdempotencyHandler_StoreError(t *testing.T) {
+	store := kvStoreMocks.NewKvStore[bool](t)
+	handler := stream.NewIdempotencyHandlerWithInterfaces(store, "eventId", nil)
+
+	store.EXPECT().Contains(matcher.Context, "abc").Return(false, assert.AnError).Once()
+
+	_, duplicate, err := handler.IsDuplicate(t.Context(), mdl.Box("foo"), map[string]string{"eventId": "abc"})
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.False(t, duplicate)
+}
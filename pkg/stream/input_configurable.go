@@ -3,6 +3,7 @@ package stream
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/justtrackio/gosoline/pkg/appctx"
@@ -15,25 +16,29 @@ import (
 )
 
 const (
-	InputTypeFile     = "file"
-	InputTypeInMemory = "inMemory"
-	InputTypeKafka    = "kafka"
-	InputTypeKinesis  = "kinesis"
-	InputTypeRedis    = "redis"
-	InputTypeSns      = "sns"
-	InputTypeSqs      = "sqs"
+	InputTypeFile        = "file"
+	InputTypeInMemory    = "inMemory"
+	InputTypeKafka       = "kafka"
+	InputTypeKinesis     = "kinesis"
+	InputTypeRedis       = "redis"
+	InputTypeRedisPubSub = "redisPubSub"
+	InputTypeRedisStream = "redisStream"
+	InputTypeSns         = "sns"
+	InputTypeSqs         = "sqs"
 )
 
 type InputFactory func(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Input, error)
 
 var inputFactories = map[string]InputFactory{
-	InputTypeFile:     newFileInputFromConfig,
-	InputTypeInMemory: newInMemoryInputFromConfig,
-	InputTypeKafka:    newKafkaInputFromConfig,
-	InputTypeKinesis:  newKinesisInputFromConfig,
-	InputTypeRedis:    newRedisInputFromConfig,
-	InputTypeSns:      newSnsInputFromConfig,
-	InputTypeSqs:      newSqsInputFromConfig,
+	InputTypeFile:        newFileInputFromConfig,
+	InputTypeInMemory:    newInMemoryInputFromConfig,
+	InputTypeKafka:       newKafkaInputFromConfig,
+	InputTypeKinesis:     newKinesisInputFromConfig,
+	InputTypeRedis:       newRedisInputFromConfig,
+	InputTypeRedisPubSub: newRedisPubSubInputFromConfig,
+	InputTypeRedisStream: newRedisStreamInputFromConfig,
+	InputTypeSns:         newSnsInputFromConfig,
+	InputTypeSqs:         newSqsInputFromConfig,
 }
 
 func SetInputFactory(typ string, factory InputFactory) {
@@ -146,6 +151,84 @@ func newRedisInputFromConfig(ctx context.Context, config cfg.Config, logger log.
 	return NewRedisListInput(ctx, config, logger, settings)
 }
 
+type redisStreamInputConfiguration struct {
+	ServerName string `cfg:"server_name" default:"default" validate:"min=1"`
+	Key        string `cfg:"key" validate:"required,min=1"`
+	// Group defaults to the name of the app and Consumer to the hostname.
+	Group       string                        `cfg:"group"`
+	Consumer    string                        `cfg:"consumer"`
+	StartId     string                        `cfg:"start_id" default:"0"`
+	BatchSize   int64                         `cfg:"batch_size" default:"10" validate:"min=1"`
+	WaitTime    time.Duration                 `cfg:"wait_time" default:"3s"`
+	Claim       redisStreamClaimConfiguration `cfg:"claim"`
+	Healthcheck health.HealthCheckSettings    `cfg:"healthcheck"`
+}
+
+type redisStreamClaimConfiguration struct {
+	MinIdleTime time.Duration `cfg:"min_idle_time" default:"5m" validate:"min=0"`
+	Interval    time.Duration `cfg:"interval" default:"1m" validate:"min=0"`
+}
+
+func newRedisStreamInputFromConfig(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Input, error) {
+	key := ConfigurableInputKey(name)
+
+	configuration := redisStreamInputConfiguration{}
+	if err := config.UnmarshalKey(key, &configuration); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal redis stream input settings: %w", err)
+	}
+
+	if configuration.Group == "" {
+		identity, err := cfg.GetAppIdentity(config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get app identity from config: %w", err)
+		}
+
+		configuration.Group = identity.Name
+	}
+
+	if configuration.Consumer == "" {
+		var err error
+
+		if configuration.Consumer, err = os.Hostname(); err != nil {
+			return nil, fmt.Errorf("failed to get hostname as consumer name: %w", err)
+		}
+	}
+
+	settings := &RedisStreamInputSettings{
+		ServerName:         configuration.ServerName,
+		Key:                configuration.Key,
+		Group:              configuration.Group,
+		Consumer:           configuration.Consumer,
+		StartId:            configuration.StartId,
+		BatchSize:          configuration.BatchSize,
+		WaitTime:           configuration.WaitTime,
+		ClaimMinIdleTime:   configuration.Claim.MinIdleTime,
+		ClaimInterval:      configuration.Claim.Interval,
+		HealthcheckTimeout: configuration.Healthcheck.Timeout,
+	}
+
+	return NewRedisStreamInput(ctx, config, logger, settings)
+}
+
+type redisPubSubInputConfiguration struct {
+	ServerName string   `cfg:"server_name" default:"default" validate:"min=1"`
+	Channels   []string `cfg:"channels" validate:"min=1"`
+}
+
+func newRedisPubSubInputFromConfig(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Input, error) {
+	key := ConfigurableInputKey(name)
+
+	configuration := redisPubSubInputConfiguration{}
+	if err := config.UnmarshalKey(key, &configuration); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal redis pubsub input settings: %w", err)
+	}
+
+	return NewRedisPubSubInput(ctx, config, logger, &RedisPubSubInputSettings{
+		ServerName: configuration.ServerName,
+		Channels:   configuration.Channels,
+	})
+}
+
 type SnsInputTargetConfiguration struct {
 	cfg.ResourceIdentifier
 	TopicId      string              `cfg:"topic_id" validate:"required"`
@@ -6,6 +6,7 @@ import (
 	"sync/atomic"
 
 	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/kafka"
 	"github.com/justtrackio/gosoline/pkg/kafka/connection"
 	kafkaConsumer "github.com/justtrackio/gosoline/pkg/kafka/consumer"
 	schemaRegistry "github.com/justtrackio/gosoline/pkg/kafka/schema-registry"
@@ -17,6 +18,7 @@ type kafkaInput struct {
 	schemaRegistryService schemaRegistry.Service
 	schemaRegistryReady   atomic.Bool
 	channel               chan *Message
+	topic                 string
 }
 
 var _ SchemaRegistryAwareInput = &kafkaInput{}
@@ -35,15 +37,27 @@ func NewKafkaInput(ctx context.Context, config cfg.Config, logger log.Logger, se
 		return nil, fmt.Errorf("failed to parse kafka connection settings for connection name %q: %w", settings.Connection, err)
 	}
 
-	schemaRegistryService, err := schemaRegistry.NewService(config, logger, settings.Connection, *conn)
+	schemaRegistryService, err := schemaRegistry.ProvideService(ctx, config, logger, settings.Connection, *conn)
 	if err != nil {
 		return nil, fmt.Errorf("can not create schema registry service: %w", err)
 	}
 
-	return NewKafkaInputWithInterfaces(consumer, schemaRegistryService, channel), nil
+	topic, err := kafka.BuildFullTopicName(config, settings.ToIdentity(), settings.TopicId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build full kafka topic name: %w", err)
+	}
+
+	inp := newKafkaInput(consumer, schemaRegistryService, channel)
+	inp.topic = topic
+
+	return inp, nil
 }
 
 func NewKafkaInputWithInterfaces(consumer kafkaConsumer.Consumer, schemaRegistryService schemaRegistry.Service, channel chan *Message) Input {
+	return newKafkaInput(consumer, schemaRegistryService, channel)
+}
+
+func newKafkaInput(consumer kafkaConsumer.Consumer, schemaRegistryService schemaRegistry.Service, channel chan *Message) *kafkaInput {
 	inp := &kafkaInput{
 		consumer:              consumer,
 		schemaRegistryService: schemaRegistryService,
@@ -76,6 +90,10 @@ func (i *kafkaInput) IsHealthy() bool {
 func (i *kafkaInput) InitSchemaRegistry(ctx context.Context, settings SchemaSettingsWithEncoding) (MessageBodyEncoder, error) {
 	i.schemaRegistryReady.Store(false)
 
+	if settings.Topic == "" {
+		settings.Topic = i.topic
+	}
+
 	encoder, err := InitKafkaSchemaRegistry(ctx, settings, i.schemaRegistryService)
 	if err != nil {
 		return nil, err
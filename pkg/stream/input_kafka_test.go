@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	kafkaConsumerMocks "github.com/justtrackio/gosoline/pkg/kafka/consumer/mocks"
+	schemaRegistry "github.com/justtrackio/gosoline/pkg/kafka/schema-registry"
+	schemaRegistryMocks "github.com/justtrackio/gosoline/pkg/kafka/schema-registry/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -60,3 +62,20 @@ func TestKafkaInputIsUnhealthyWhenSchemaRegistryNotReady(t *testing.T) {
 
 	assert.False(t, inp.IsHealthy())
 }
+
+func TestKafkaInputInitSchemaRegistryUsesTopicOfInput(t *testing.T) {
+	consumer := kafkaConsumerMocks.NewConsumer(t)
+	service := schemaRegistryMocks.NewService(t)
+
+	service.EXPECT().GetSubjectSchemaId(mock.Anything, "input-topic-value", `{"type":"object"}`, schemaRegistry.Json).Return(1, nil).Once()
+
+	inp := NewKafkaInputWithInterfaces(consumer, service, make(chan *Message)).(*kafkaInput)
+	inp.topic = "input-topic"
+
+	_, err := inp.InitSchemaRegistry(t.Context(), SchemaSettingsWithEncoding{
+		Schema:   `{"type":"object"}`,
+		Encoding: EncodingJson,
+		Model:    &struct{}{},
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,110 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/redis"
+)
+
+const AttributeRedisPubSubChannel = "redisPubSubChannel"
+
+// RedisPubSubInputSettings configure an input receiving the messages published to redis channels. Messages are only
+// received while the input is running and there are no acknowledgements: a message published while the input isn't
+// subscribed is lost.
+type RedisPubSubInputSettings struct {
+	ServerName string
+	Channels   []string
+}
+
+type redisPubSubInput struct {
+	logger   log.Logger
+	client   redis.Client
+	settings *RedisPubSubInputSettings
+
+	channel  chan *Message
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func NewRedisPubSubInput(ctx context.Context, config cfg.Config, logger log.Logger, settings *RedisPubSubInputSettings) (Input, error) {
+	var err error
+	var client redis.Client
+
+	if client, err = redis.ProvideClient(ctx, config, logger, settings.ServerName); err != nil {
+		return nil, fmt.Errorf("can not create redis client: %w", err)
+	}
+
+	return NewRedisPubSubInputWithInterfaces(logger, client, settings), nil
+}
+
+func NewRedisPubSubInputWithInterfaces(logger log.Logger, client redis.Client, settings *RedisPubSubInputSettings) Input {
+	return &redisPubSubInput{
+		logger:   logger,
+		client:   client,
+		settings: settings,
+		channel:  make(chan *Message),
+		stop:     make(chan struct{}),
+	}
+}
+
+func (i *redisPubSubInput) Data() <-chan *Message {
+	return i.channel
+}
+
+func (i *redisPubSubInput) Run(ctx context.Context) error {
+	defer close(i.channel)
+
+	sub, err := i.client.Subscribe(ctx, i.settings.Channels...)
+	if err != nil {
+		return fmt.Errorf("can not subscribe to redis channels: %w", err)
+	}
+
+	defer func() {
+		if err := sub.Close(); err != nil {
+			i.logger.Warn(ctx, "could not close redis subscription: %s", err)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-i.stop:
+			return nil
+		case pubSubMsg, ok := <-sub.Channel():
+			if !ok {
+				return nil
+			}
+
+			msg := &Message{}
+			if err = json.Unmarshal([]byte(pubSubMsg.Payload), msg); err != nil {
+				i.logger.Error(ctx, "could not unmarshal message: %w", err)
+
+				continue
+			}
+
+			if msg.Attributes == nil {
+				msg.Attributes = make(map[string]string)
+			}
+
+			msg.Attributes[AttributeRedisPubSubChannel] = pubSubMsg.Channel
+
+			i.channel <- msg
+		}
+	}
+}
+
+func (i *redisPubSubInput) Stop(_ context.Context) {
+	i.stopOnce.Do(func() {
+		close(i.stop)
+	})
+}
+
+func (i *redisPubSubInput) IsHealthy() bool {
+	return true
+}
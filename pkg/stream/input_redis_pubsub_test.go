@@ -0,0 +1,60 @@
+package stream_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/redis"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	baseRedis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisPubSub(t *testing.T) {
+	ctx := t.Context()
+	server := miniredis.RunT(t)
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	baseClient := baseRedis.NewClient(&baseRedis.Options{
+		Addr: server.Addr(),
+	})
+	client := redis.NewClientWithInterfaces(logger, baseClient, exec.NewDefaultExecutor(), &redis.Settings{}, "")
+
+	input := stream.NewRedisPubSubInputWithInterfaces(logger, client, &stream.RedisPubSubInputSettings{
+		Channels: []string{"channel"},
+	})
+	output := stream.NewRedisPubSubOutputWithInterfaces(logger, client, &stream.RedisPubSubOutputSettings{
+		Channel: "channel",
+	})
+
+	done := make(chan error)
+	go func() {
+		done <- input.Run(ctx)
+	}()
+
+	// the message is lost if it is published before the input subscribed
+	require.Eventually(t, func() bool {
+		return len(server.PubSubChannels("channel")) == 1
+	}, time.Second, time.Millisecond)
+
+	err := output.WriteOne(ctx, stream.NewMessage("foo", map[string]string{"encoding": "text/plain"}))
+	require.NoError(t, err)
+
+	select {
+	case msg := <-input.Data():
+		assert.Equal(t, "foo", msg.Body)
+		assert.Equal(t, "text/plain", msg.Attributes["encoding"])
+		assert.Equal(t, "channel", msg.Attributes[stream.AttributeRedisPubSubChannel])
+	case <-time.After(time.Second):
+		require.FailNow(t, "no message received")
+	}
+
+	input.Stop(ctx)
+	assert.NoError(t, <-done)
+
+	_, ok := <-input.Data()
+	assert.False(t, ok, "the data channel should be closed")
+}
@@ -0,0 +1,304 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	"github.com/justtrackio/gosoline/pkg/redis"
+)
+
+const (
+	AttributeRedisStreamMessageId = "redisStreamMessageId"
+
+	metricNameRedisStreamInputReads  = "StreamRedisStreamInputReads"
+	metricNameRedisStreamInputClaims = "StreamRedisStreamInputClaims"
+	redisStreamFieldMessage          = "message"
+	redisStreamAutoClaimStartId      = "0-0"
+	redisStreamReadGroupNewEntriesId = ">"
+)
+
+var _ AcknowledgeableInput = &redisStreamInput{}
+
+// RedisStreamInputSettings configure an input reading a redis stream as a member of a consumer group. Every entry is
+// delivered to one consumer of the group and stays pending until it is acknowledged. Pending entries of crashed
+// consumers are claimed by the other consumers once they have been idle for the claim min idle time.
+type RedisStreamInputSettings struct {
+	ServerName string
+	Key        string
+	Group      string
+	Consumer   string
+	// StartId is the id of the last entry the consumer group has read when it is created. "0" reads the whole stream,
+	// "$" only reads the entries added after the creation.
+	StartId   string
+	BatchSize int64
+	WaitTime  time.Duration
+	// ClaimMinIdleTime is the time after which a pending entry is claimed from its consumer. 0 disables claiming.
+	ClaimMinIdleTime   time.Duration
+	ClaimInterval      time.Duration
+	HealthcheckTimeout time.Duration
+}
+
+type redisStreamInput struct {
+	logger           log.Logger
+	mw               metric.Writer
+	client           redis.Client
+	clock            clock.Clock
+	settings         *RedisStreamInputSettings
+	healthCheckTimer clock.HealthCheckTimer
+
+	channel   chan *Message
+	stopped   int32
+	claimedAt time.Time
+}
+
+func NewRedisStreamInput(ctx context.Context, config cfg.Config, logger log.Logger, settings *RedisStreamInputSettings) (AcknowledgeableInput, error) {
+	var err error
+	var client redis.Client
+
+	if client, err = redis.ProvideClient(ctx, config, logger, settings.ServerName); err != nil {
+		return nil, fmt.Errorf("can not create redis client: %w", err)
+	}
+
+	defaultMetrics := getRedisStreamInputDefaultMetrics(settings)
+	mw := metric.NewWriter(defaultMetrics...)
+
+	healthCheckTimer, err := clock.NewHealthCheckTimer(settings.HealthcheckTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create healthcheck timer: %w", err)
+	}
+
+	return NewRedisStreamInputWithInterfaces(logger, client, mw, clock.Provider, healthCheckTimer, settings), nil
+}
+
+func NewRedisStreamInputWithInterfaces(
+	logger log.Logger,
+	client redis.Client,
+	mw metric.Writer,
+	clock clock.Clock,
+	healthCheckTimer clock.HealthCheckTimer,
+	settings *RedisStreamInputSettings,
+) AcknowledgeableInput {
+	return &redisStreamInput{
+		logger:           logger,
+		mw:               mw,
+		client:           client,
+		clock:            clock,
+		settings:         settings,
+		healthCheckTimer: healthCheckTimer,
+		channel:          make(chan *Message),
+	}
+}
+
+func (i *redisStreamInput) Data() <-chan *Message {
+	return i.channel
+}
+
+func (i *redisStreamInput) Run(ctx context.Context) error {
+	defer close(i.channel)
+
+	if i.settings.WaitTime <= 0 {
+		return errors.New("wait time should be bigger than 0")
+	}
+
+	err := i.client.XGroupCreateMkStream(ctx, i.settings.Key, i.settings.Group, i.settings.StartId)
+	if err != nil && !redis.IsGroupExistsError(err) {
+		return fmt.Errorf("can not create consumer group %s of redis stream %s: %w", i.settings.Group, i.settings.Key, err)
+	}
+
+	for {
+		if atomic.LoadInt32(&i.stopped) != 0 || ctx.Err() != nil {
+			return nil
+		}
+
+		i.healthCheckTimer.MarkHealthy()
+
+		if err = i.claim(ctx); err != nil {
+			i.logger.Error(ctx, "could not claim pending entries: %w", err)
+		}
+
+		messages, err := i.client.XReadGroup(ctx, redis.XReadGroupArgs{
+			Group:    i.settings.Group,
+			Consumer: i.settings.Consumer,
+			Stream:   i.settings.Key,
+			ID:       redisStreamReadGroupNewEntriesId,
+			Count:    i.settings.BatchSize,
+			Block:    i.settings.WaitTime,
+		})
+
+		if err != nil && !errors.Is(err, redis.Nil) {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			i.logger.Error(ctx, "could not XReadGroup from redis: %w", err)
+
+			return err
+		}
+
+		i.publish(ctx, messages)
+		i.writeMetric(ctx, metricNameRedisStreamInputReads, len(messages))
+	}
+}
+
+func (i *redisStreamInput) Stop(_ context.Context) {
+	atomic.StoreInt32(&i.stopped, 1)
+}
+
+func (i *redisStreamInput) IsHealthy() bool {
+	return i.healthCheckTimer.IsHealthy()
+}
+
+// Ack acknowledges the entry of the message, so it is no longer pending. An entry which isn't acknowledged is
+// delivered again once it is claimed by a consumer of the group.
+func (i *redisStreamInput) Ack(ctx context.Context, msg *Message, ack bool) error {
+	return i.AckBatch(ctx, []*Message{msg}, []bool{ack})
+}
+
+func (i *redisStreamInput) AckBatch(ctx context.Context, msgs []*Message, acks []bool) error {
+	ids := make([]string, 0, len(msgs))
+	multiError := new(multierror.Error)
+
+	for j, msg := range msgs {
+		if !acks[j] {
+			continue
+		}
+
+		id, ok := msg.Attributes[AttributeRedisStreamMessageId]
+		if !ok || id == "" {
+			multiError = multierror.Append(multiError, fmt.Errorf("the message has no attribute %s", AttributeRedisStreamMessageId))
+
+			continue
+		}
+
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 {
+		return multiError.ErrorOrNil()
+	}
+
+	if _, err := i.client.XAck(ctx, i.settings.Key, i.settings.Group, ids...); err != nil {
+		multiError = multierror.Append(multiError, fmt.Errorf("can not acknowledge the entries of redis stream %s: %w", i.settings.Key, err))
+	}
+
+	return multiError.ErrorOrNil()
+}
+
+// claim takes over the entries which have been pending for longer than the claim min idle time, as their consumer most
+// likely crashed before acknowledging them.
+func (i *redisStreamInput) claim(ctx context.Context) error {
+	if i.settings.ClaimMinIdleTime <= 0 || i.clock.Since(i.claimedAt) < i.settings.ClaimInterval {
+		return nil
+	}
+
+	i.claimedAt = i.clock.Now()
+	start := redisStreamAutoClaimStartId
+
+	for {
+		messages, next, err := i.client.XAutoClaim(ctx, redis.XAutoClaimArgs{
+			Stream:   i.settings.Key,
+			Group:    i.settings.Group,
+			Consumer: i.settings.Consumer,
+			MinIdle:  i.settings.ClaimMinIdleTime,
+			Start:    start,
+			Count:    i.settings.BatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("can not claim the pending entries of redis stream %s: %w", i.settings.Key, err)
+		}
+
+		if len(messages) > 0 {
+			i.logger.Info(ctx, "claimed %d pending entries of redis stream %s", len(messages), i.settings.Key)
+		}
+
+		i.publish(ctx, messages)
+		i.writeMetric(ctx, metricNameRedisStreamInputClaims, len(messages))
+
+		if next == "" || next == redisStreamAutoClaimStartId {
+			return nil
+		}
+
+		start = next
+	}
+}
+
+func (i *redisStreamInput) publish(ctx context.Context, messages []redis.XMessage) {
+	for _, xMsg := range messages {
+		msg, err := i.decode(xMsg)
+		if err != nil {
+			i.logger.Error(ctx, "could not decode entry %s: %w", xMsg.ID, err)
+
+			// the entry would be claimed again and again otherwise
+			if _, err = i.client.XAck(ctx, i.settings.Key, i.settings.Group, xMsg.ID); err != nil {
+				i.logger.Error(ctx, "could not acknowledge undecodable entry %s: %w", xMsg.ID, err)
+			}
+
+			continue
+		}
+
+		i.channel <- msg
+
+		// we made some progress, even though the other side might be slow
+		i.healthCheckTimer.MarkHealthy()
+	}
+}
+
+func (i *redisStreamInput) decode(xMsg redis.XMessage) (*Message, error) {
+	data, ok := xMsg.Values[redisStreamFieldMessage].(string)
+	if !ok {
+		return nil, fmt.Errorf("the entry has no field %s", redisStreamFieldMessage)
+	}
+
+	msg := &Message{}
+	if err := json.Unmarshal([]byte(data), msg); err != nil {
+		return nil, fmt.Errorf("could not unmarshal message: %w", err)
+	}
+
+	if msg.Attributes == nil {
+		msg.Attributes = make(map[string]string)
+	}
+
+	msg.Attributes[AttributeRedisStreamMessageId] = xMsg.ID
+
+	return msg, nil
+}
+
+func (i *redisStreamInput) writeMetric(ctx context.Context, name string, count int) {
+	data := metric.Data{{
+		MetricName: name,
+		Dimensions: map[string]string{
+			"StreamName": fmt.Sprintf("%s-%s", i.settings.ServerName, i.settings.Key),
+		},
+		Unit:  metric.UnitCount,
+		Value: float64(count),
+	}}
+
+	i.mw.Write(ctx, data)
+}
+
+func getRedisStreamInputDefaultMetrics(settings *RedisStreamInputSettings) metric.Data {
+	data := metric.Data{}
+
+	for _, name := range []string{metricNameRedisStreamInputReads, metricNameRedisStreamInputClaims} {
+		data = append(data, &metric.Datum{
+			Priority:   metric.PriorityHigh,
+			MetricName: name,
+			Dimensions: map[string]string{
+				"StreamName": fmt.Sprintf("%s-%s", settings.ServerName, settings.Key),
+			},
+			Unit:  metric.UnitCount,
+			Value: 0.0,
+		})
+	}
+
+	return data
+}
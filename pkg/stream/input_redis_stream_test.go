@@ -0,0 +1,178 @@
+package stream_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	metricMocks "github.com/justtrackio/gosoline/pkg/metric/mocks"
+	"github.com/justtrackio/gosoline/pkg/redis"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	baseRedis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type redisStreamInputTestSuite struct {
+	suite.Suite
+
+	server   *miniredis.Miniredis
+	client   redis.Client
+	clock    clock.FakeClock
+	settings *stream.RedisStreamInputSettings
+	input    stream.AcknowledgeableInput
+	done     chan error
+}
+
+func TestRedisStreamInput(t *testing.T) {
+	suite.Run(t, new(redisStreamInputTestSuite))
+}
+
+func (s *redisStreamInputTestSuite) SetupTest() {
+	s.server = miniredis.RunT(s.T())
+	s.server.SetTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	baseClient := baseRedis.NewClient(&baseRedis.Options{
+		Addr: s.server.Addr(),
+	})
+	s.client = redis.NewClientWithInterfaces(logger, baseClient, exec.NewDefaultExecutor(), &redis.Settings{}, "")
+
+	mw := metricMocks.NewWriter(s.T())
+	mw.EXPECT().Write(matcher.Context, mock.Anything).Return().Maybe()
+
+	s.clock = clock.NewFakeClock()
+	s.settings = &stream.RedisStreamInputSettings{
+		Key:              "stream",
+		Group:            "group",
+		Consumer:         "consumer",
+		StartId:          "0",
+		BatchSize:        10,
+		WaitTime:         10 * time.Millisecond,
+		ClaimMinIdleTime: time.Minute,
+		ClaimInterval:    time.Minute,
+	}
+
+	healthCheckTimer := clock.NewHealthCheckTimerWithInterfaces(s.clock, time.Minute)
+	s.input = stream.NewRedisStreamInputWithInterfaces(logger, s.client, mw, s.clock, healthCheckTimer, s.settings)
+	s.done = make(chan error)
+}
+
+func (s *redisStreamInputTestSuite) TestReadAndAck() {
+	s.addEntry(`{"attributes":{"encoding":"text/plain"},"body":"foo"}`)
+	s.addEntry(`{"attributes":{"encoding":"text/plain"},"body":"bar"}`)
+
+	s.run()
+	foo := s.receive()
+	bar := s.receive()
+	s.stop()
+
+	s.Equal("foo", foo.Body)
+	s.Equal("bar", bar.Body)
+	s.NotEmpty(foo.Attributes[stream.AttributeRedisStreamMessageId])
+	s.Equal("text/plain", foo.Attributes["encoding"])
+
+	err := s.input.AckBatch(s.T().Context(), []*stream.Message{foo, bar}, []bool{true, false})
+	s.NoError(err)
+
+	pending, err := s.client.XPending(s.T().Context(), "stream", "group")
+	s.NoError(err)
+	s.Equal(int64(1), pending, "only the unacknowledged entry should be pending")
+}
+
+func (s *redisStreamInputTestSuite) TestAck_MissingAttribute() {
+	err := s.input.Ack(s.T().Context(), &stream.Message{Body: "foo"}, true)
+	s.EqualError(err, "1 error occurred:\n\t* the message has no attribute redisStreamMessageId\n\n")
+}
+
+func (s *redisStreamInputTestSuite) TestClaimPendingEntries() {
+	ctx := s.T().Context()
+	id := s.addEntry(`{"body":"foo"}`)
+
+	// a consumer reads the entry and crashes before acknowledging it
+	err := s.client.XGroupCreateMkStream(ctx, "stream", "group", "0")
+	s.NoError(err)
+
+	_, err = s.client.XReadGroup(ctx, redis.XReadGroupArgs{
+		Group:    "group",
+		Consumer: "crashed",
+		Stream:   "stream",
+		ID:       ">",
+		Block:    -1,
+	})
+	s.NoError(err)
+
+	s.server.SetTime(time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC))
+	s.clock.Advance(time.Hour)
+
+	s.run()
+	msg := s.receive()
+	s.stop()
+
+	s.Equal("foo", msg.Body)
+	s.Equal(id, msg.Attributes[stream.AttributeRedisStreamMessageId])
+
+	err = s.input.Ack(ctx, msg, true)
+	s.NoError(err)
+
+	pending, err := s.client.XPending(ctx, "stream", "group")
+	s.NoError(err)
+	s.Equal(int64(0), pending)
+}
+
+func (s *redisStreamInputTestSuite) TestUndecodableEntry() {
+	s.addEntry(`not json`)
+	s.addEntry(`{"body":"foo"}`)
+
+	s.run()
+	msg := s.receive()
+	s.stop()
+
+	s.Equal("foo", msg.Body)
+
+	pending, err := s.client.XPending(s.T().Context(), "stream", "group")
+	s.NoError(err)
+	s.Equal(int64(1), pending, "the undecodable entry should have been acknowledged")
+}
+
+func (s *redisStreamInputTestSuite) addEntry(message string) string {
+	id, err := s.client.XAdd(s.T().Context(), redis.XAddArgs{
+		Stream: "stream",
+		Values: map[string]any{"message": message},
+	})
+	s.Require().NoError(err)
+
+	return id
+}
+
+func (s *redisStreamInputTestSuite) run() {
+	go func() {
+		s.done <- s.input.Run(s.T().Context())
+	}()
+}
+
+func (s *redisStreamInputTestSuite) receive() *stream.Message {
+	select {
+	case msg := <-s.input.Data():
+		return msg
+	case <-time.After(time.Second):
+		s.FailNow("no message received")
+
+		return nil
+	}
+}
+
+func (s *redisStreamInputTestSuite) stop() {
+	s.input.Stop(s.T().Context())
+
+	select {
+	case err := <-s.done:
+		s.NoError(err)
+	case <-time.After(time.Second):
+		s.FailNow("the input did not stop")
+	}
+}
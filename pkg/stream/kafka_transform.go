@@ -0,0 +1,219 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/kafka"
+	kafkaConsumer "github.com/justtrackio/gosoline/pkg/kafka/consumer"
+	"github.com/justtrackio/gosoline/pkg/kernel"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+type KafkaTransformSettings struct {
+	// Input is the name of the kafka input to consume, it defaults to the name of the module.
+	Input string `cfg:"input"`
+	// Output is the name of the kafka output to write the transformed messages to.
+	Output string `cfg:"output" validate:"required"`
+	// BatchSize is the maximum number of consumed messages written in a single transaction.
+	BatchSize int `cfg:"batch_size" default:"100" validate:"min=1"`
+}
+
+//go:generate go run github.com/vektra/mockery/v2 --name KafkaTransformer
+type KafkaTransformer interface {
+	// Transform returns the messages to write for a consumed message. Returning no messages is fine, the message counts
+	// as consumed nonetheless.
+	Transform(ctx context.Context, msg *Message) ([]WritableMessage, error)
+}
+
+type KafkaTransformerFactory func(ctx context.Context, config cfg.Config, logger log.Logger) (KafkaTransformer, error)
+
+// KafkaTransformModule implements consume-transform-produce with exactly once semantics: the transformed messages
+// are written and the offsets of the consumed messages are committed in a single kafka transaction. The input and the
+// output only provide the settings, consuming and producing is done by a single transactional client which is part
+// of the consumer group. If the transform or the write fails, the transaction is aborted and the module fails, the
+// messages get consumed again after the restart of the application. If the group rebalances during a transaction,
+// the transaction is aborted and the messages get consumed again by the new owner of the partition.
+type KafkaTransformModule struct {
+	kernel.EssentialModule
+	kernel.ApplicationStage
+
+	logger      log.Logger
+	session     kafkaConsumer.TransactSession
+	transformer KafkaTransformer
+	settings    *KafkaTransformSettings
+}
+
+func NewKafkaTransformModule(name string, transformerFactory KafkaTransformerFactory) kernel.ModuleFactory {
+	return func(ctx context.Context, config cfg.Config, logger log.Logger) (kernel.Module, error) {
+		var err error
+		var settings *KafkaTransformSettings
+		var session kafkaConsumer.TransactSession
+		var transformer KafkaTransformer
+
+		logger = logger.WithChannel(fmt.Sprintf("kafka-transform-%s", name))
+
+		if settings, err = ReadKafkaTransformSettings(config, name); err != nil {
+			return nil, err
+		}
+
+		if session, err = newKafkaTransactSession(ctx, config, logger, settings, name); err != nil {
+			return nil, err
+		}
+
+		if transformer, err = transformerFactory(ctx, config, logger); err != nil {
+			return nil, fmt.Errorf("can not create transformer: %w", err)
+		}
+
+		return NewKafkaTransformModuleWithInterfaces(logger, session, transformer, settings), nil
+	}
+}
+
+func NewKafkaTransformModuleWithInterfaces(
+	logger log.Logger,
+	session kafkaConsumer.TransactSession,
+	transformer KafkaTransformer,
+	settings *KafkaTransformSettings,
+) *KafkaTransformModule {
+	return &KafkaTransformModule{
+		logger:      logger,
+		session:     session,
+		transformer: transformer,
+		settings:    settings,
+	}
+}
+
+func newKafkaTransactSession(ctx context.Context, config cfg.Config, logger log.Logger, settings *KafkaTransformSettings, name string) (kafkaConsumer.TransactSession, error) {
+	key := ConfigurableInputKey(settings.Input)
+
+	input := KafkaInputConfiguration{}
+	if err := config.UnmarshalKey(key, &input); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal kafka input settings for key %q: %w", key, err)
+	}
+
+	if input.Type != InputTypeKafka {
+		return nil, fmt.Errorf("input %s has to be of type %s but is of type %s", settings.Input, InputTypeKafka, input.Type)
+	}
+
+	_, producerSettings, err := ReadKafkaOutputSettings(config, settings.Output)
+	if err != nil {
+		return nil, fmt.Errorf("can not read settings of output %s: %w", settings.Output, err)
+	}
+
+	if producerSettings.TransactionalId, err = kafka.BuildFullTransactionalId(config, name); err != nil {
+		return nil, fmt.Errorf("failed to build transactional id for kafka transform %q: %w", name, err)
+	}
+
+	session, err := kafkaConsumer.NewTransactSession(ctx, config, logger, input.Settings, producerSettings, name)
+	if err != nil {
+		return nil, fmt.Errorf("can not create kafka transact session: %w", err)
+	}
+
+	return session, nil
+}
+
+func (m *KafkaTransformModule) Run(ctx context.Context) error {
+	defer m.session.Close()
+
+	for {
+		fetches := m.session.PollRecords(ctx, m.settings.BatchSize)
+
+		if ctx.Err() != nil || fetches.IsClientClosed() {
+			return nil
+		}
+
+		if err := fetches.Err(); err != nil {
+			return fmt.Errorf("can not poll records: %w", err)
+		}
+
+		records := fetches.Records()
+		if len(records) == 0 {
+			continue
+		}
+
+		if err := m.transformBatch(ctx, records); err != nil {
+			return err
+		}
+	}
+}
+
+func (m *KafkaTransformModule) transformBatch(ctx context.Context, consumed []*kgo.Record) error {
+	if err := m.session.Begin(); err != nil {
+		return fmt.Errorf("can not begin transaction: %w", err)
+	}
+
+	produced, err := m.transformRecords(ctx, consumed)
+	if err != nil {
+		return m.abort(ctx, err)
+	}
+
+	if err = m.session.ProduceSync(ctx, produced...).FirstErr(); err != nil {
+		return m.abort(ctx, fmt.Errorf("can not write %d transformed messages of %d consumed messages: %w", len(produced), len(consumed), err))
+	}
+
+	committed, err := m.session.End(ctx, kgo.TryCommit)
+	if err != nil {
+		return fmt.Errorf("can not commit transaction: %w", err)
+	}
+
+	if !committed {
+		m.logger.Warn(ctx, "aborted transaction of %d consumed messages because of a rebalance, they get consumed again", len(consumed))
+
+		return nil
+	}
+
+	m.logger.Debug(ctx, "wrote %d transformed messages of %d consumed messages", len(produced), len(consumed))
+
+	return nil
+}
+
+func (m *KafkaTransformModule) transformRecords(ctx context.Context, consumed []*kgo.Record) ([]*kgo.Record, error) {
+	batch := make([]WritableMessage, 0, len(consumed))
+
+	for _, record := range consumed {
+		transformed, err := m.transformer.Transform(ctx, KafkaToGosoMessage(*record))
+		if err != nil {
+			return nil, fmt.Errorf("can not transform message: %w", err)
+		}
+
+		batch = append(batch, transformed...)
+	}
+
+	produced, err := NewKafkaMessages(batch)
+	if err != nil {
+		return nil, fmt.Errorf("can not build kafka messages: %w", err)
+	}
+
+	return produced, nil
+}
+
+func (m *KafkaTransformModule) abort(ctx context.Context, reason error) error {
+	// the context might be canceled already, but we still want to abort the transaction instead of waiting for the timeout
+	if _, err := m.session.End(context.WithoutCancel(ctx), kgo.TryAbort); err != nil {
+		return errors.Join(reason, fmt.Errorf("can not abort transaction: %w", err))
+	}
+
+	return reason
+}
+
+func ConfigurableKafkaTransformKey(name string) string {
+	return fmt.Sprintf("stream.kafka_transform.%s", name)
+}
+
+func ReadKafkaTransformSettings(config cfg.Config, name string) (*KafkaTransformSettings, error) {
+	key := ConfigurableKafkaTransformKey(name)
+
+	settings := &KafkaTransformSettings{}
+	if err := config.UnmarshalKey(key, settings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal kafka transform settings for key %q: %w", key, err)
+	}
+
+	if settings.Input == "" {
+		settings.Input = name
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,85 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+
+	kafkaConsumerMocks "github.com/justtrackio/gosoline/pkg/kafka/consumer/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	streamMocks "github.com/justtrackio/gosoline/pkg/stream/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func TestKafkaTransformModule_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	session := kafkaConsumerMocks.NewTransactSession(t)
+	transformer := streamMocks.NewKafkaTransformer(t)
+
+	first := &kgo.Record{Topic: "input", Offset: 1, Value: []byte("1")}
+	second := &kgo.Record{Topic: "input", Offset: 2, Value: []byte("2")}
+
+	session.EXPECT().PollRecords(ctx, 10).Return(newKafkaFetches(first, second)).Once()
+	session.EXPECT().PollRecords(ctx, 10).Run(func(context.Context, int) {
+		cancel()
+	}).Return(kgo.Fetches{}).Once()
+
+	transformer.EXPECT().Transform(mock.Anything, stream.KafkaToGosoMessage(*first)).Return([]stream.WritableMessage{stream.NewMessage("1a"), stream.NewMessage("1b")}, nil).Once()
+	transformer.EXPECT().Transform(mock.Anything, stream.KafkaToGosoMessage(*second)).Return(nil, nil).Once()
+
+	session.EXPECT().Begin().Return(nil).Once()
+	session.EXPECT().ProduceSync(ctx, mock.Anything, mock.Anything).Run(func(_ context.Context, rs ...*kgo.Record) {
+		assert.Equal(t, []byte("1a"), rs[0].Value)
+		assert.Equal(t, []byte("1b"), rs[1].Value)
+	}).Return(kgo.ProduceResults{}).Once()
+	session.EXPECT().End(ctx, kgo.TryCommit).Return(true, nil).Once()
+	session.EXPECT().Close().Once()
+
+	module := stream.NewKafkaTransformModuleWithInterfaces(logger, session, transformer, &stream.KafkaTransformSettings{
+		BatchSize: 10,
+	})
+
+	err := module.Run(ctx)
+	assert.NoError(t, err)
+}
+
+func TestKafkaTransformModule_RunAbortsOnTransformError(t *testing.T) {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	session := kafkaConsumerMocks.NewTransactSession(t)
+	transformer := streamMocks.NewKafkaTransformer(t)
+
+	record := &kgo.Record{Topic: "input", Offset: 1, Value: []byte("1")}
+
+	session.EXPECT().PollRecords(mock.Anything, 10).Return(newKafkaFetches(record)).Once()
+	session.EXPECT().Begin().Return(nil).Once()
+	transformer.EXPECT().Transform(mock.Anything, stream.KafkaToGosoMessage(*record)).Return(nil, assert.AnError).Once()
+	session.EXPECT().End(mock.Anything, kgo.TryAbort).Return(false, nil).Once()
+	session.EXPECT().Close().Once()
+
+	module := stream.NewKafkaTransformModuleWithInterfaces(logger, session, transformer, &stream.KafkaTransformSettings{
+		BatchSize: 10,
+	})
+
+	err := module.Run(t.Context())
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func newKafkaFetches(records ...*kgo.Record) kgo.Fetches {
+	return kgo.Fetches{
+		{
+			Topics: []kgo.FetchTopic{
+				{
+					Topic: "input",
+					Partitions: []kgo.FetchPartition{
+						{Records: records},
+					},
+				},
+			},
+		},
+	}
+}
@@ -5,8 +5,11 @@ import (
 )
 
 const (
-	AttributeEncoding    = "encoding"
-	AttributeCompression = "compression"
+	AttributeEncoding        = "encoding"
+	AttributeCompression     = "compression"
+	AttributeEncryption      = "encryption"
+	AttributeEncryptionKeyId = "encryptionKeyId"
+	AttributeClaimCheck      = "claimCheck"
 )
 
 // GetEncodingAttribute returns the encoding attribute if one is set, nil if none is set,
@@ -33,6 +36,17 @@ func GetCompressionAttribute(attributes map[string]string) *CompressionType {
 	return nil
 }
 
+// GetEncryptionAttribute returns the encryption attribute if one is set, nil if none is set.
+func GetEncryptionAttribute(attributes map[string]string) *EncryptionType {
+	if attrEncryption, ok := attributes[AttributeEncryption]; ok {
+		encryption := EncryptionType(attrEncryption)
+
+		return &encryption
+	}
+
+	return nil
+}
+
 func NewMessage(body string, attributes ...map[string]string) *Message {
 	msg := &Message{
 		Attributes: map[string]string{},
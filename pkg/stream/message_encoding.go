@@ -24,6 +24,8 @@ type MessageEncoderSettings struct {
 	Compression     CompressionType
 	EncodeHandlers  []EncodeHandler
 	ExternalEncoder MessageBodyEncoder
+	Encryptor       MessageBodyEncryptor
+	ClaimCheck      ClaimCheck
 }
 
 //go:generate go run github.com/vektra/mockery/v2 --name MessageEncoder
@@ -37,6 +39,8 @@ type messageEncoder struct {
 	compression     CompressionType
 	encodeHandlers  []EncodeHandler
 	externalEncoder MessageBodyEncoder
+	encryptor       MessageBodyEncryptor
+	claimCheck      ClaimCheck
 }
 
 func NewMessageEncoder(config *MessageEncoderSettings) *messageEncoder {
@@ -52,11 +56,17 @@ func NewMessageEncoder(config *MessageEncoderSettings) *messageEncoder {
 		config.EncodeHandlers = defaultEncodeHandlers
 	}
 
+	if config.ClaimCheck == nil {
+		config.ClaimCheck = NewClaimCheckNoop()
+	}
+
 	return &messageEncoder{
 		encoding:        config.Encoding,
 		compression:     config.Compression,
 		encodeHandlers:  config.EncodeHandlers,
 		externalEncoder: config.ExternalEncoder,
+		encryptor:       config.Encryptor,
+		claimCheck:      config.ClaimCheck,
 	}
 }
 
@@ -73,6 +83,14 @@ func (e *messageEncoder) Encode(ctx context.Context, data any, attributeSets ...
 		return nil, fmt.Errorf("could not compress message body: %w", err)
 	}
 
+	if body, err = e.encryptBody(ctx, attributes, body); err != nil {
+		return nil, fmt.Errorf("could not encrypt message body: %w", err)
+	}
+
+	if e.compression != CompressionNone || e.encryptor != nil {
+		body = base64.Encode(body)
+	}
+
 	if attributes, err = e.mergeAttributes(attributes, attributeSets); err != nil {
 		return nil, err
 	}
@@ -88,6 +106,11 @@ func (e *messageEncoder) Encode(ctx context.Context, data any, attributeSets ...
 		Body:       string(body),
 	}
 
+	// the claim check has to see the final message, as it decides by the size of body and attributes
+	if msg, err = e.claimCheck.Check(ctx, msg); err != nil {
+		return nil, fmt.Errorf("can not apply claim check on message: %w", err)
+	}
+
 	return msg, nil
 }
 
@@ -121,10 +144,18 @@ func (e *messageEncoder) compressBody(attributes map[string]string, body []byte)
 		return nil, err
 	}
 
-	compressedBase64 := base64.Encode(compressed)
 	attributes[AttributeCompression] = e.compression.String()
 
-	return compressedBase64, nil
+	return compressed, nil
+}
+
+// encryptBody encrypts the already compressed body, as encrypted data can't be compressed anymore.
+func (e *messageEncoder) encryptBody(ctx context.Context, attributes map[string]string, body []byte) ([]byte, error) {
+	if e.encryptor == nil {
+		return body, nil
+	}
+
+	return e.encryptor.Encrypt(ctx, body, attributes)
 }
 
 func (e *messageEncoder) mergeAttributes(attributes map[string]string, attributeSets []map[string]string) (map[string]string, error) {
@@ -145,8 +176,21 @@ func (e *messageEncoder) Decode(ctx context.Context, msg *Message, out any) (con
 	var err error
 	var body []byte
 
-	attributes := msg.Attributes
-	body = []byte(msg.Body)
+	var fetched *Message
+	if fetched, err = e.claimCheck.Fetch(ctx, msg); err != nil {
+		return ctx, msg.Attributes, fmt.Errorf("can not fetch message body of claim check: %w", err)
+	}
+
+	attributes := fetched.Attributes
+	body = []byte(fetched.Body)
+
+	if body, err = e.decodeBinaryBody(attributes, body); err != nil {
+		return ctx, attributes, err
+	}
+
+	if body, err = e.decryptBody(ctx, attributes, body); err != nil {
+		return ctx, attributes, fmt.Errorf("can not decrypt message body: %w", err)
+	}
 
 	if body, err = e.decompressBody(attributes, body); err != nil {
 		return ctx, attributes, err
@@ -165,10 +209,9 @@ func (e *messageEncoder) Decode(ctx context.Context, msg *Message, out any) (con
 	return ctx, attributes, nil
 }
 
-func (e *messageEncoder) decompressBody(attributes map[string]string, body []byte) ([]byte, error) {
-	compression := GetCompressionAttribute(attributes)
-
-	if compression == nil {
+// decodeBinaryBody reverts the base64 encoding of compressed or encrypted bodies.
+func (e *messageEncoder) decodeBinaryBody(attributes map[string]string, body []byte) ([]byte, error) {
+	if GetCompressionAttribute(attributes) == nil && GetEncryptionAttribute(attributes) == nil {
 		return body, nil
 	}
 
@@ -177,7 +220,31 @@ func (e *messageEncoder) decompressBody(attributes map[string]string, body []byt
 		return nil, fmt.Errorf("can not base64 decode the body: %w", err)
 	}
 
-	return DecompressMessage(*compression, base64Decoded)
+	return base64Decoded, nil
+}
+
+func (e *messageEncoder) decryptBody(ctx context.Context, attributes map[string]string, body []byte) ([]byte, error) {
+	encryption := GetEncryptionAttribute(attributes)
+
+	if encryption == nil {
+		return body, nil
+	}
+
+	if e.encryptor == nil {
+		return nil, fmt.Errorf("the message is encrypted with %s, but encryption is not enabled", *encryption)
+	}
+
+	return e.encryptor.Decrypt(ctx, body, attributes)
+}
+
+func (e *messageEncoder) decompressBody(attributes map[string]string, body []byte) ([]byte, error) {
+	compression := GetCompressionAttribute(attributes)
+
+	if compression == nil {
+		return body, nil
+	}
+
+	return DecompressMessage(*compression, body)
 }
 
 func (e *messageEncoder) decodeBody(attributes map[string]string, body []byte, out any) error {
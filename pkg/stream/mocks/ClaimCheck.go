@@ -0,0 +1,202 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	stream "github.com/justtrackio/gosoline/pkg/stream"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ClaimCheck is an autogenerated mock type for the ClaimCheck type
+type ClaimCheck struct {
+	mock.Mock
+}
+
+type ClaimCheck_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ClaimCheck) EXPECT() *ClaimCheck_Expecter {
+	return &ClaimCheck_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: ctx, msg
+func (_m *ClaimCheck) Check(ctx context.Context, msg *stream.Message) (*stream.Message, error) {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 *stream.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *stream.Message) (*stream.Message, error)); ok {
+		return rf(ctx, msg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *stream.Message) *stream.Message); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stream.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *stream.Message) error); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimCheck_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type ClaimCheck_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg *stream.Message
+func (_e *ClaimCheck_Expecter) Check(ctx interface{}, msg interface{}) *ClaimCheck_Check_Call {
+	return &ClaimCheck_Check_Call{Call: _e.mock.On("Check", ctx, msg)}
+}
+
+func (_c *ClaimCheck_Check_Call) Run(run func(ctx context.Context, msg *stream.Message)) *ClaimCheck_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*stream.Message))
+	})
+	return _c
+}
+
+func (_c *ClaimCheck_Check_Call) Return(_a0 *stream.Message, _a1 error) *ClaimCheck_Check_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimCheck_Check_Call) RunAndReturn(run func(context.Context, *stream.Message) (*stream.Message, error)) *ClaimCheck_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fetch provides a mock function with given fields: ctx, msg
+func (_m *ClaimCheck) Fetch(ctx context.Context, msg *stream.Message) (*stream.Message, error) {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fetch")
+	}
+
+	var r0 *stream.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *stream.Message) (*stream.Message, error)); ok {
+		return rf(ctx, msg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *stream.Message) *stream.Message); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stream.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *stream.Message) error); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimCheck_Fetch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fetch'
+type ClaimCheck_Fetch_Call struct {
+	*mock.Call
+}
+
+// Fetch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg *stream.Message
+func (_e *ClaimCheck_Expecter) Fetch(ctx interface{}, msg interface{}) *ClaimCheck_Fetch_Call {
+	return &ClaimCheck_Fetch_Call{Call: _e.mock.On("Fetch", ctx, msg)}
+}
+
+func (_c *ClaimCheck_Fetch_Call) Run(run func(ctx context.Context, msg *stream.Message)) *ClaimCheck_Fetch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*stream.Message))
+	})
+	return _c
+}
+
+func (_c *ClaimCheck_Fetch_Call) Return(_a0 *stream.Message, _a1 error) *ClaimCheck_Fetch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClaimCheck_Fetch_Call) RunAndReturn(run func(context.Context, *stream.Message) (*stream.Message, error)) *ClaimCheck_Fetch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function with given fields: ctx, msg
+func (_m *ClaimCheck) Release(ctx context.Context, msg *stream.Message) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *stream.Message) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClaimCheck_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type ClaimCheck_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg *stream.Message
+func (_e *ClaimCheck_Expecter) Release(ctx interface{}, msg interface{}) *ClaimCheck_Release_Call {
+	return &ClaimCheck_Release_Call{Call: _e.mock.On("Release", ctx, msg)}
+}
+
+func (_c *ClaimCheck_Release_Call) Run(run func(ctx context.Context, msg *stream.Message)) *ClaimCheck_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*stream.Message))
+	})
+	return _c
+}
+
+func (_c *ClaimCheck_Release_Call) Return(_a0 error) *ClaimCheck_Release_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClaimCheck_Release_Call) RunAndReturn(run func(context.Context, *stream.Message) error) *ClaimCheck_Release_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewClaimCheck creates a new instance of ClaimCheck. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClaimCheck(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClaimCheck {
+	mock := &ClaimCheck{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
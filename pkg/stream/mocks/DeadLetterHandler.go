@@ -0,0 +1,86 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	stream "github.com/justtrackio/gosoline/pkg/stream"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DeadLetterHandler is an autogenerated mock type for the DeadLetterHandler type
+type DeadLetterHandler struct {
+	mock.Mock
+}
+
+type DeadLetterHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DeadLetterHandler) EXPECT() *DeadLetterHandler_Expecter {
+	return &DeadLetterHandler_Expecter{mock: &_m.Mock}
+}
+
+// Put provides a mock function with given fields: ctx, msg, attempts, reason
+func (_m *DeadLetterHandler) Put(ctx context.Context, msg *stream.Message, attempts int, reason error) error {
+	ret := _m.Called(ctx, msg, attempts, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Put")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *stream.Message, int, error) error); ok {
+		r0 = rf(ctx, msg, attempts, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeadLetterHandler_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type DeadLetterHandler_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg *stream.Message
+//   - attempts int
+//   - reason error
+func (_e *DeadLetterHandler_Expecter) Put(ctx interface{}, msg interface{}, attempts interface{}, reason interface{}) *DeadLetterHandler_Put_Call {
+	return &DeadLetterHandler_Put_Call{Call: _e.mock.On("Put", ctx, msg, attempts, reason)}
+}
+
+func (_c *DeadLetterHandler_Put_Call) Run(run func(ctx context.Context, msg *stream.Message, attempts int, reason error)) *DeadLetterHandler_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*stream.Message), args[2].(int), args[3].(error))
+	})
+	return _c
+}
+
+func (_c *DeadLetterHandler_Put_Call) Return(_a0 error) *DeadLetterHandler_Put_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeadLetterHandler_Put_Call) RunAndReturn(run func(context.Context, *stream.Message, int, error) error) *DeadLetterHandler_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDeadLetterHandler creates a new instance of DeadLetterHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDeadLetterHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DeadLetterHandler {
+	mock := &DeadLetterHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
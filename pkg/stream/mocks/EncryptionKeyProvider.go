@@ -0,0 +1,155 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	stream "github.com/justtrackio/gosoline/pkg/stream"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EncryptionKeyProvider is an autogenerated mock type for the EncryptionKeyProvider type
+type EncryptionKeyProvider struct {
+	mock.Mock
+}
+
+type EncryptionKeyProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EncryptionKeyProvider) EXPECT() *EncryptionKeyProvider_Expecter {
+	return &EncryptionKeyProvider_Expecter{mock: &_m.Mock}
+}
+
+// DecryptDataKey provides a mock function with given fields: ctx, keyId, encrypted
+func (_m *EncryptionKeyProvider) DecryptDataKey(ctx context.Context, keyId string, encrypted []byte) ([]byte, error) {
+	ret := _m.Called(ctx, keyId, encrypted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecryptDataKey")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) ([]byte, error)); ok {
+		return rf(ctx, keyId, encrypted)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) []byte); ok {
+		r0 = rf(ctx, keyId, encrypted)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, keyId, encrypted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EncryptionKeyProvider_DecryptDataKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecryptDataKey'
+type EncryptionKeyProvider_DecryptDataKey_Call struct {
+	*mock.Call
+}
+
+// DecryptDataKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyId string
+//   - encrypted []byte
+func (_e *EncryptionKeyProvider_Expecter) DecryptDataKey(ctx interface{}, keyId interface{}, encrypted interface{}) *EncryptionKeyProvider_DecryptDataKey_Call {
+	return &EncryptionKeyProvider_DecryptDataKey_Call{Call: _e.mock.On("DecryptDataKey", ctx, keyId, encrypted)}
+}
+
+func (_c *EncryptionKeyProvider_DecryptDataKey_Call) Run(run func(ctx context.Context, keyId string, encrypted []byte)) *EncryptionKeyProvider_DecryptDataKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *EncryptionKeyProvider_DecryptDataKey_Call) Return(_a0 []byte, _a1 error) *EncryptionKeyProvider_DecryptDataKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EncryptionKeyProvider_DecryptDataKey_Call) RunAndReturn(run func(context.Context, string, []byte) ([]byte, error)) *EncryptionKeyProvider_DecryptDataKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateDataKey provides a mock function with given fields: ctx
+func (_m *EncryptionKeyProvider) GenerateDataKey(ctx context.Context) (*stream.DataKey, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateDataKey")
+	}
+
+	var r0 *stream.DataKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*stream.DataKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *stream.DataKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stream.DataKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EncryptionKeyProvider_GenerateDataKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateDataKey'
+type EncryptionKeyProvider_GenerateDataKey_Call struct {
+	*mock.Call
+}
+
+// GenerateDataKey is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *EncryptionKeyProvider_Expecter) GenerateDataKey(ctx interface{}) *EncryptionKeyProvider_GenerateDataKey_Call {
+	return &EncryptionKeyProvider_GenerateDataKey_Call{Call: _e.mock.On("GenerateDataKey", ctx)}
+}
+
+func (_c *EncryptionKeyProvider_GenerateDataKey_Call) Run(run func(ctx context.Context)) *EncryptionKeyProvider_GenerateDataKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *EncryptionKeyProvider_GenerateDataKey_Call) Return(_a0 *stream.DataKey, _a1 error) *EncryptionKeyProvider_GenerateDataKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EncryptionKeyProvider_GenerateDataKey_Call) RunAndReturn(run func(context.Context) (*stream.DataKey, error)) *EncryptionKeyProvider_GenerateDataKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEncryptionKeyProvider creates a new instance of EncryptionKeyProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEncryptionKeyProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EncryptionKeyProvider {
+	mock := &EncryptionKeyProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,148 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IdempotencyHandler is an autogenerated mock type for the IdempotencyHandler type
+type IdempotencyHandler struct {
+	mock.Mock
+}
+
+type IdempotencyHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IdempotencyHandler) EXPECT() *IdempotencyHandler_Expecter {
+	return &IdempotencyHandler_Expecter{mock: &_m.Mock}
+}
+
+// IsDuplicate provides a mock function with given fields: ctx, model, attributes
+func (_m *IdempotencyHandler) IsDuplicate(ctx context.Context, model interface{}, attributes map[string]string) (string, bool, error) {
+	ret := _m.Called(ctx, model, attributes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsDuplicate")
+	}
+
+	var r0 string
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, map[string]string) (string, bool, error)); ok {
+		return rf(ctx, model, attributes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, map[string]string) string); ok {
+		r0 = rf(ctx, model, attributes)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, map[string]string) bool); ok {
+		r1 = rf(ctx, model, attributes)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, interface{}, map[string]string) error); ok {
+		r2 = rf(ctx, model, attributes)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// IdempotencyHandler_IsDuplicate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsDuplicate'
+type IdempotencyHandler_IsDuplicate_Call struct {
+	*mock.Call
+}
+
+// IsDuplicate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - model interface{}
+//   - attributes map[string]string
+func (_e *IdempotencyHandler_Expecter) IsDuplicate(ctx interface{}, model interface{}, attributes interface{}) *IdempotencyHandler_IsDuplicate_Call {
+	return &IdempotencyHandler_IsDuplicate_Call{Call: _e.mock.On("IsDuplicate", ctx, model, attributes)}
+}
+
+func (_c *IdempotencyHandler_IsDuplicate_Call) Run(run func(ctx context.Context, model interface{}, attributes map[string]string)) *IdempotencyHandler_IsDuplicate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(interface{}), args[2].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *IdempotencyHandler_IsDuplicate_Call) Return(key string, duplicate bool, err error) *IdempotencyHandler_IsDuplicate_Call {
+	_c.Call.Return(key, duplicate, err)
+	return _c
+}
+
+func (_c *IdempotencyHandler_IsDuplicate_Call) RunAndReturn(run func(context.Context, interface{}, map[string]string) (string, bool, error)) *IdempotencyHandler_IsDuplicate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkConsumed provides a mock function with given fields: ctx, key
+func (_m *IdempotencyHandler) MarkConsumed(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkConsumed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IdempotencyHandler_MarkConsumed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkConsumed'
+type IdempotencyHandler_MarkConsumed_Call struct {
+	*mock.Call
+}
+
+// MarkConsumed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *IdempotencyHandler_Expecter) MarkConsumed(ctx interface{}, key interface{}) *IdempotencyHandler_MarkConsumed_Call {
+	return &IdempotencyHandler_MarkConsumed_Call{Call: _e.mock.On("MarkConsumed", ctx, key)}
+}
+
+func (_c *IdempotencyHandler_MarkConsumed_Call) Run(run func(ctx context.Context, key string)) *IdempotencyHandler_MarkConsumed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IdempotencyHandler_MarkConsumed_Call) Return(_a0 error) *IdempotencyHandler_MarkConsumed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IdempotencyHandler_MarkConsumed_Call) RunAndReturn(run func(context.Context, string) error) *IdempotencyHandler_MarkConsumed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIdempotencyHandler creates a new instance of IdempotencyHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIdempotencyHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IdempotencyHandler {
+	mock := &IdempotencyHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
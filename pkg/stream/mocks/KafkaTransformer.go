@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	stream "github.com/justtrackio/gosoline/pkg/stream"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// KafkaTransformer is an autogenerated mock type for the KafkaTransformer type
+type KafkaTransformer struct {
+	mock.Mock
+}
+
+type KafkaTransformer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *KafkaTransformer) EXPECT() *KafkaTransformer_Expecter {
+	return &KafkaTransformer_Expecter{mock: &_m.Mock}
+}
+
+// Transform provides a mock function with given fields: ctx, msg
+func (_m *KafkaTransformer) Transform(ctx context.Context, msg *stream.Message) ([]stream.WritableMessage, error) {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transform")
+	}
+
+	var r0 []stream.WritableMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *stream.Message) ([]stream.WritableMessage, error)); ok {
+		return rf(ctx, msg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *stream.Message) []stream.WritableMessage); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]stream.WritableMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *stream.Message) error); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KafkaTransformer_Transform_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transform'
+type KafkaTransformer_Transform_Call struct {
+	*mock.Call
+}
+
+// Transform is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg *stream.Message
+func (_e *KafkaTransformer_Expecter) Transform(ctx interface{}, msg interface{}) *KafkaTransformer_Transform_Call {
+	return &KafkaTransformer_Transform_Call{Call: _e.mock.On("Transform", ctx, msg)}
+}
+
+func (_c *KafkaTransformer_Transform_Call) Run(run func(ctx context.Context, msg *stream.Message)) *KafkaTransformer_Transform_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*stream.Message))
+	})
+	return _c
+}
+
+func (_c *KafkaTransformer_Transform_Call) Return(_a0 []stream.WritableMessage, _a1 error) *KafkaTransformer_Transform_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KafkaTransformer_Transform_Call) RunAndReturn(run func(context.Context, *stream.Message) ([]stream.WritableMessage, error)) *KafkaTransformer_Transform_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewKafkaTransformer creates a new instance of KafkaTransformer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKafkaTransformer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KafkaTransformer {
+	mock := &KafkaTransformer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
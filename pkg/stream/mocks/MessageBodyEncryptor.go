@@ -0,0 +1,156 @@
+// Code generated by mockery v2.53.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MessageBodyEncryptor is an autogenerated mock type for the MessageBodyEncryptor type
+type MessageBodyEncryptor struct {
+	mock.Mock
+}
+
+type MessageBodyEncryptor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MessageBodyEncryptor) EXPECT() *MessageBodyEncryptor_Expecter {
+	return &MessageBodyEncryptor_Expecter{mock: &_m.Mock}
+}
+
+// Decrypt provides a mock function with given fields: ctx, body, attributes
+func (_m *MessageBodyEncryptor) Decrypt(ctx context.Context, body []byte, attributes map[string]string) ([]byte, error) {
+	ret := _m.Called(ctx, body, attributes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Decrypt")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, map[string]string) ([]byte, error)); ok {
+		return rf(ctx, body, attributes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, map[string]string) []byte); ok {
+		r0 = rf(ctx, body, attributes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, map[string]string) error); ok {
+		r1 = rf(ctx, body, attributes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MessageBodyEncryptor_Decrypt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Decrypt'
+type MessageBodyEncryptor_Decrypt_Call struct {
+	*mock.Call
+}
+
+// Decrypt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - body []byte
+//   - attributes map[string]string
+func (_e *MessageBodyEncryptor_Expecter) Decrypt(ctx interface{}, body interface{}, attributes interface{}) *MessageBodyEncryptor_Decrypt_Call {
+	return &MessageBodyEncryptor_Decrypt_Call{Call: _e.mock.On("Decrypt", ctx, body, attributes)}
+}
+
+func (_c *MessageBodyEncryptor_Decrypt_Call) Run(run func(ctx context.Context, body []byte, attributes map[string]string)) *MessageBodyEncryptor_Decrypt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte), args[2].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *MessageBodyEncryptor_Decrypt_Call) Return(_a0 []byte, _a1 error) *MessageBodyEncryptor_Decrypt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MessageBodyEncryptor_Decrypt_Call) RunAndReturn(run func(context.Context, []byte, map[string]string) ([]byte, error)) *MessageBodyEncryptor_Decrypt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Encrypt provides a mock function with given fields: ctx, body, attributes
+func (_m *MessageBodyEncryptor) Encrypt(ctx context.Context, body []byte, attributes map[string]string) ([]byte, error) {
+	ret := _m.Called(ctx, body, attributes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Encrypt")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, map[string]string) ([]byte, error)); ok {
+		return rf(ctx, body, attributes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, map[string]string) []byte); ok {
+		r0 = rf(ctx, body, attributes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, map[string]string) error); ok {
+		r1 = rf(ctx, body, attributes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MessageBodyEncryptor_Encrypt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Encrypt'
+type MessageBodyEncryptor_Encrypt_Call struct {
+	*mock.Call
+}
+
+// Encrypt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - body []byte
+//   - attributes map[string]string
+func (_e *MessageBodyEncryptor_Expecter) Encrypt(ctx interface{}, body interface{}, attributes interface{}) *MessageBodyEncryptor_Encrypt_Call {
+	return &MessageBodyEncryptor_Encrypt_Call{Call: _e.mock.On("Encrypt", ctx, body, attributes)}
+}
+
+func (_c *MessageBodyEncryptor_Encrypt_Call) Run(run func(ctx context.Context, body []byte, attributes map[string]string)) *MessageBodyEncryptor_Encrypt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte), args[2].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *MessageBodyEncryptor_Encrypt_Call) Return(_a0 []byte, _a1 error) *MessageBodyEncryptor_Encrypt_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MessageBodyEncryptor_Encrypt_Call) RunAndReturn(run func(context.Context, []byte, map[string]string) ([]byte, error)) *MessageBodyEncryptor_Encrypt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMessageBodyEncryptor creates a new instance of MessageBodyEncryptor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMessageBodyEncryptor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MessageBodyEncryptor {
+	mock := &MessageBodyEncryptor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
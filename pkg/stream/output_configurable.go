@@ -7,6 +7,7 @@ import (
 
 	"github.com/justtrackio/gosoline/pkg/cfg"
 	"github.com/justtrackio/gosoline/pkg/cloud/aws/sqs"
+	"github.com/justtrackio/gosoline/pkg/kafka"
 	kafkaProducer "github.com/justtrackio/gosoline/pkg/kafka/producer"
 	"github.com/justtrackio/gosoline/pkg/log"
 	"github.com/justtrackio/gosoline/pkg/mdl"
@@ -20,20 +21,24 @@ func init() {
 	AddOutputFactory(OutputTypeMultiple, NewConfigurableMultiOutput)
 	AddOutputFactory(OutputTypeNoOp, newNoOpOutput)
 	AddOutputFactory(OutputTypeRedis, newRedisListOutputFromConfig)
+	AddOutputFactory(OutputTypeRedisPubSub, newRedisPubSubOutputFromConfig)
+	AddOutputFactory(OutputTypeRedisStream, newRedisStreamOutputFromConfig)
 	AddOutputFactory(OutputTypeSns, newSnsOutputFromConfig)
 	AddOutputFactory(OutputTypeSqs, newSqsOutputFromConfig)
 }
 
 const (
-	OutputTypeFile     = "file"
-	OutputTypeInMemory = "inMemory"
-	OutputTypeKafka    = "kafka"
-	OutputTypeKinesis  = "kinesis"
-	OutputTypeMultiple = "multiple"
-	OutputTypeNoOp     = "noop"
-	OutputTypeRedis    = "redis"
-	OutputTypeSns      = "sns"
-	OutputTypeSqs      = "sqs"
+	OutputTypeFile        = "file"
+	OutputTypeInMemory    = "inMemory"
+	OutputTypeKafka       = "kafka"
+	OutputTypeKinesis     = "kinesis"
+	OutputTypeMultiple    = "multiple"
+	OutputTypeNoOp        = "noop"
+	OutputTypeRedis       = "redis"
+	OutputTypeRedisPubSub = "redisPubSub"
+	OutputTypeRedisStream = "redisStream"
+	OutputTypeSns         = "sns"
+	OutputTypeSqs         = "sqs"
 )
 
 var outputFactories = map[string]OutputFactory{}
@@ -124,13 +129,59 @@ type KafkaOutputConfiguration struct {
 
 	MaxBatchSize  int   `cfg:"max_batch_size" default:"10000"`
 	MaxBatchBytes int32 `cfg:"max_batch_bytes" default:"1000012"`
+
+	// Transactional makes every batch write atomic. The transactional id is built from the app identity, the name of
+	// the output and the instance, see kafka.BuildFullTransactionalId.
+	Transactional      bool          `cfg:"transactional" default:"false"`
+	TransactionTimeout time.Duration `cfg:"transaction_timeout" default:"40s"`
 }
 
 func newKafkaOutputFromConfig(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Output, *OutputCapabilities, error) {
+	configuration, kafkaSettings, err := ReadKafkaOutputSettings(config, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if configuration.Transactional {
+		if kafkaSettings.TransactionalId, err = kafka.BuildFullTransactionalId(config, name); err != nil {
+			return nil, nil, fmt.Errorf("failed to build transactional id for kafka output %q: %w", name, err)
+		}
+	}
+
+	outputCapabilities := &OutputCapabilities{
+		// we are not using the partitioned producer daemon aggregator.
+		// but the kafka library will partition by the AttributeKafkaKey in the message attributes if it is set.
+		IsPartitionedOutput: false,
+		ProvidesCompression: true,
+		// when using the schema registry, we can not aggregate.
+		// otherwise, we would write something that does not match the schema.
+		// unfortunately, we can also not aggregate when not using the schema registry,
+		// because the producer daemon starts running as a module before the schema registry can be initialized
+		// and therefore the producer daemon can not know if the schema registry is being used.
+		SupportsAggregation: false,
+		MaxBatchSize:        mdl.Box(configuration.MaxBatchSize),
+		MaxMessageSize:      mdl.Box(int(configuration.MaxBatchBytes)),
+		// the kafka library has an internal process for batching and flushing messages.
+		// so we always use the size restrictions from the library to prevent it from re-batching and breaking up what we already batched
+		// and to have just one place for the batch settings.
+		IgnoreProducerDaemonBatchSettings: true,
+	}
+
+	output, err := NewKafkaOutput(ctx, config, logger, kafkaSettings, name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can not create kafka output %s: %w", name, err)
+	}
+
+	return output, outputCapabilities, nil
+}
+
+// ReadKafkaOutputSettings reads the configuration of the kafka output with the given name and the settings of the
+// kafka producer writing to it. The transactional id of the producer is left empty.
+func ReadKafkaOutputSettings(config cfg.Config, name string) (*KafkaOutputConfiguration, *kafkaProducer.Settings, error) {
 	key := ConfigurableOutputKey(name)
 	configuration := &KafkaOutputConfiguration{}
 	if err := config.UnmarshalKey(key, configuration); err != nil {
-		return nil, nil, fmt.Errorf("failed to unmarshal kafka output settings for key %q in newKafkaOutputFromConfig: %w", key, err)
+		return nil, nil, fmt.Errorf("failed to unmarshal kafka output settings for key %q: %w", key, err)
 	}
 
 	if err := configuration.PadFromConfig(config); err != nil {
@@ -155,26 +206,7 @@ func newKafkaOutputFromConfig(ctx context.Context, config cfg.Config, logger log
 		compression = kafkaProducer.CompressionZstd
 	}
 
-	outputCapabilities := &OutputCapabilities{
-		// we are not using the partitioned producer daemon aggregator.
-		// but the kafka library will partition by the AttributeKafkaKey in the message attributes if it is set.
-		IsPartitionedOutput: false,
-		ProvidesCompression: true,
-		// when using the schema registry, we can not aggregate.
-		// otherwise, we would write something that does not match the schema.
-		// unfortunately, we can also not aggregate when not using the schema registry,
-		// because the producer daemon starts running as a module before the schema registry can be initialized
-		// and therefore the producer daemon can not know if the schema registry is being used.
-		SupportsAggregation: false,
-		MaxBatchSize:        mdl.Box(configuration.MaxBatchSize),
-		MaxMessageSize:      mdl.Box(int(configuration.MaxBatchBytes)),
-		// the kafka library has an internal process for batching and flushing messages.
-		// so we always use the size restrictions from the library to prevent it from re-batching and breaking up what we already batched
-		// and to have just one place for the batch settings.
-		IgnoreProducerDaemonBatchSettings: true,
-	}
-
-	output, err := NewKafkaOutput(ctx, config, logger, &kafkaProducer.Settings{
+	return configuration, &kafkaProducer.Settings{
 		ResourceIdentifier: configuration.ResourceIdentifier,
 		Connection:         configuration.Connection,
 		TopicId:            configuration.TopicId,
@@ -183,12 +215,8 @@ func newKafkaOutputFromConfig(ctx context.Context, config cfg.Config, logger log
 		MaxBatchBytes:      configuration.MaxBatchBytes,
 		LingerTimeout:      configuration.LingerTimeout,
 		RequestTimeout:     configuration.RequestTimeout,
-	}, name)
-	if err != nil {
-		return nil, nil, fmt.Errorf("can not create kafka output %s: %w", name, err)
-	}
-
-	return output, outputCapabilities, nil
+		TransactionTimeout: configuration.TransactionTimeout,
+	}, nil
 }
 
 type KinesisOutputConfiguration struct {
@@ -257,6 +285,58 @@ func newRedisListOutputFromConfig(ctx context.Context, config cfg.Config, logger
 	return output, DefaultOutputCapabilities, nil
 }
 
+type redisStreamOutputConfiguration struct {
+	ServerName string `cfg:"server_name" default:"default" validate:"required,min=1"`
+	Key        string `cfg:"key" validate:"required,min=1"`
+	MaxLen     int64  `cfg:"max_len" default:"0" validate:"min=0"`
+	ExactTrim  bool   `cfg:"exact_trim" default:"false"`
+}
+
+func newRedisStreamOutputFromConfig(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Output, *OutputCapabilities, error) {
+	key := ConfigurableOutputKey(name)
+
+	configuration := redisStreamOutputConfiguration{}
+	if err := config.UnmarshalKey(key, &configuration); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal redis stream output settings for key %q in newRedisStreamOutputFromConfig: %w", key, err)
+	}
+
+	output, err := NewRedisStreamOutput(ctx, config, logger, &RedisStreamOutputSettings{
+		ServerName: configuration.ServerName,
+		Key:        configuration.Key,
+		MaxLen:     configuration.MaxLen,
+		ExactTrim:  configuration.ExactTrim,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("can not create redis stream output %s: %w", name, err)
+	}
+
+	return output, DefaultOutputCapabilities, nil
+}
+
+type redisPubSubOutputConfiguration struct {
+	ServerName string `cfg:"server_name" default:"default" validate:"required,min=1"`
+	Channel    string `cfg:"channel" validate:"required,min=1"`
+}
+
+func newRedisPubSubOutputFromConfig(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Output, *OutputCapabilities, error) {
+	key := ConfigurableOutputKey(name)
+
+	configuration := redisPubSubOutputConfiguration{}
+	if err := config.UnmarshalKey(key, &configuration); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal redis pubsub output settings for key %q in newRedisPubSubOutputFromConfig: %w", key, err)
+	}
+
+	output, err := NewRedisPubSubOutput(ctx, config, logger, &RedisPubSubOutputSettings{
+		ServerName: configuration.ServerName,
+		Channel:    configuration.Channel,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("can not create redis pubsub output %s: %w", name, err)
+	}
+
+	return output, DefaultOutputCapabilities, nil
+}
+
 type SnsOutputConfiguration struct {
 	BaseOutputConfiguration
 	cfg.ResourceIdentifier
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/kafka"
 	"github.com/justtrackio/gosoline/pkg/kafka/connection"
 	kafkaProducer "github.com/justtrackio/gosoline/pkg/kafka/producer"
 	schemaRegistry "github.com/justtrackio/gosoline/pkg/kafka/schema-registry"
@@ -16,6 +17,7 @@ type kafkaOutput struct {
 	connection            connection.Settings
 	schemaRegistryService schemaRegistry.Service
 	producer              kafkaProducer.Producer
+	topic                 string
 }
 
 var _ SchemaRegistryAwareOutput = &kafkaOutput{}
@@ -31,12 +33,20 @@ func NewKafkaOutput(ctx context.Context, config cfg.Config, logger log.Logger, s
 		return nil, fmt.Errorf("failed to parse kafka connection settings for connection name %q: %w", settings.Connection, err)
 	}
 
-	schemaRegistryService, err := schemaRegistry.NewService(config, logger, settings.Connection, *conn)
+	schemaRegistryService, err := schemaRegistry.ProvideService(ctx, config, logger, settings.Connection, *conn)
 	if err != nil {
 		return nil, fmt.Errorf("can not create schema registry service: %w", err)
 	}
 
-	return NewKafkaOutputWithInterfaces(logger, *conn, schemaRegistryService, producer), nil
+	topic, err := kafka.BuildFullTopicName(config, settings.ToIdentity(), settings.TopicId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build full topic name for topic id %q: %w", settings.TopicId, err)
+	}
+
+	output := newKafkaOutput(logger, *conn, schemaRegistryService, producer)
+	output.topic = topic
+
+	return output, nil
 }
 
 func NewKafkaOutputWithInterfaces(
@@ -45,6 +55,15 @@ func NewKafkaOutputWithInterfaces(
 	schemaRegistryService schemaRegistry.Service,
 	producer kafkaProducer.Producer,
 ) Output {
+	return newKafkaOutput(logger, connection, schemaRegistryService, producer)
+}
+
+func newKafkaOutput(
+	logger log.Logger,
+	connection connection.Settings,
+	schemaRegistryService schemaRegistry.Service,
+	producer kafkaProducer.Producer,
+) *kafkaOutput {
 	return &kafkaOutput{
 		logger:                logger,
 		connection:            connection,
@@ -84,5 +103,9 @@ func (o *kafkaOutput) Write(ctx context.Context, batch []WritableMessage) error
 }
 
 func (o *kafkaOutput) InitSchemaRegistry(ctx context.Context, settings SchemaSettingsWithEncoding) (MessageBodyEncoder, error) {
+	if settings.Topic == "" {
+		settings.Topic = o.topic
+	}
+
 	return InitKafkaSchemaRegistry(ctx, settings, o.schemaRegistryService)
 }
@@ -0,0 +1,61 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/redis"
+)
+
+// RedisPubSubOutputSettings configure an output publishing the messages to a redis channel. Only the subscribers
+// connected at the time of the write receive a message.
+type RedisPubSubOutputSettings struct {
+	ServerName string
+	Channel    string
+}
+
+type redisPubSubOutput struct {
+	logger   log.Logger
+	client   redis.Client
+	settings *RedisPubSubOutputSettings
+}
+
+func NewRedisPubSubOutput(ctx context.Context, config cfg.Config, logger log.Logger, settings *RedisPubSubOutputSettings) (Output, error) {
+	var err error
+	var client redis.Client
+
+	if client, err = redis.ProvideClient(ctx, config, logger, settings.ServerName); err != nil {
+		return nil, fmt.Errorf("can not create redis client: %w", err)
+	}
+
+	return NewRedisPubSubOutputWithInterfaces(logger, client, settings), nil
+}
+
+func NewRedisPubSubOutputWithInterfaces(logger log.Logger, client redis.Client, settings *RedisPubSubOutputSettings) Output {
+	return &redisPubSubOutput{
+		logger:   logger,
+		client:   client,
+		settings: settings,
+	}
+}
+
+func (o *redisPubSubOutput) WriteOne(ctx context.Context, record WritableMessage) error {
+	return o.Write(ctx, []WritableMessage{record})
+}
+
+func (o *redisPubSubOutput) Write(ctx context.Context, batch []WritableMessage) error {
+	for _, msg := range batch {
+		data, err := msg.MarshalToBytes()
+		if err != nil {
+			return fmt.Errorf("can not marshal message: %w", err)
+		}
+
+		if _, err = o.client.Publish(ctx, o.settings.Channel, data); err != nil {
+			return fmt.Errorf("can not publish message to redis channel %s: %w", o.settings.Channel, err)
+		}
+	}
+
+	return nil
+}
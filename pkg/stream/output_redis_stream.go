@@ -0,0 +1,116 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/metric"
+	"github.com/justtrackio/gosoline/pkg/redis"
+)
+
+const (
+	metricNameRedisStreamOutputWrites = "StreamRedisStreamOutputWrites"
+)
+
+// RedisStreamOutputSettings configure an output adding the messages as entries to a redis stream. The stream is
+// trimmed to about MaxLen entries on every write, a MaxLen of 0 disables the trimming.
+type RedisStreamOutputSettings struct {
+	ServerName string
+	Key        string
+	MaxLen     int64
+	// ExactTrim trims the stream to exactly MaxLen entries instead of whole macro nodes, which is more expensive.
+	ExactTrim bool
+}
+
+type redisStreamOutput struct {
+	logger       log.Logger
+	metricWriter metric.Writer
+	client       redis.Client
+	settings     *RedisStreamOutputSettings
+}
+
+func NewRedisStreamOutput(ctx context.Context, config cfg.Config, logger log.Logger, settings *RedisStreamOutputSettings) (Output, error) {
+	var err error
+	var client redis.Client
+
+	if client, err = redis.ProvideClient(ctx, config, logger, settings.ServerName); err != nil {
+		return nil, fmt.Errorf("can not create redis client: %w", err)
+	}
+
+	defaultMetrics := getRedisStreamOutputDefaultMetrics(settings)
+	mw := metric.NewWriter(defaultMetrics...)
+
+	return NewRedisStreamOutputWithInterfaces(logger, mw, client, settings), nil
+}
+
+func NewRedisStreamOutputWithInterfaces(logger log.Logger, mw metric.Writer, client redis.Client, settings *RedisStreamOutputSettings) Output {
+	return &redisStreamOutput{
+		logger:       logger,
+		metricWriter: mw,
+		client:       client,
+		settings:     settings,
+	}
+}
+
+func (o *redisStreamOutput) WriteOne(ctx context.Context, record WritableMessage) error {
+	return o.Write(ctx, []WritableMessage{record})
+}
+
+func (o *redisStreamOutput) Write(ctx context.Context, batch []WritableMessage) error {
+	for i, msg := range batch {
+		data, err := msg.MarshalToBytes()
+		if err != nil {
+			return fmt.Errorf("can not marshal message: %w", err)
+		}
+
+		_, err = o.client.XAdd(ctx, redis.XAddArgs{
+			Stream: o.settings.Key,
+			MaxLen: o.settings.MaxLen,
+			Approx: !o.settings.ExactTrim,
+			Values: map[string]any{
+				redisStreamFieldMessage: data,
+			},
+		})
+		if err != nil {
+			o.writeStreamWriteMetric(ctx, i)
+
+			return fmt.Errorf("can not add message to redis stream %s: %w", o.settings.Key, err)
+		}
+	}
+
+	o.writeStreamWriteMetric(ctx, len(batch))
+
+	return nil
+}
+
+func (o *redisStreamOutput) writeStreamWriteMetric(ctx context.Context, length int) {
+	data := metric.Data{{
+		Priority:   metric.PriorityHigh,
+		Timestamp:  time.Now(),
+		MetricName: metricNameRedisStreamOutputWrites,
+		Dimensions: map[string]string{
+			"StreamName": fmt.Sprintf("%s-%s", o.settings.ServerName, o.settings.Key),
+		},
+		Unit:  metric.UnitCount,
+		Value: float64(length),
+	}}
+
+	o.metricWriter.Write(ctx, data)
+}
+
+func getRedisStreamOutputDefaultMetrics(settings *RedisStreamOutputSettings) metric.Data {
+	return metric.Data{
+		{
+			Priority:   metric.PriorityHigh,
+			MetricName: metricNameRedisStreamOutputWrites,
+			Dimensions: map[string]string{
+				"StreamName": fmt.Sprintf("%s-%s", settings.ServerName, settings.Key),
+			},
+			Unit:  metric.UnitCount,
+			Value: 0.0,
+		},
+	}
+}
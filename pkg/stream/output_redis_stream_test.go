@@ -0,0 +1,64 @@
+package stream_test
+
+import (
+	"errors"
+	"testing"
+
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	metricMocks "github.com/justtrackio/gosoline/pkg/metric/mocks"
+	"github.com/justtrackio/gosoline/pkg/redis"
+	redisMocks "github.com/justtrackio/gosoline/pkg/redis/mocks"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRedisStreamOutput_Write(t *testing.T) {
+	ctx := t.Context()
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	mw := metricMocks.NewWriter(t)
+	mw.EXPECT().Write(matcher.Context, mock.Anything).Return().Once()
+
+	redisMock := redisMocks.NewClient(t)
+	for _, body := range []string{"foo", "bar"} {
+		data, err := stream.NewMessage(body).MarshalToBytes()
+		assert.NoError(t, err)
+
+		redisMock.EXPECT().XAdd(ctx, redis.XAddArgs{
+			Stream: "my-stream",
+			MaxLen: 1000,
+			Approx: true,
+			Values: map[string]any{"message": data},
+		}).Return("1-0", nil).Once()
+	}
+
+	output := stream.NewRedisStreamOutputWithInterfaces(logger, mw, redisMock, &stream.RedisStreamOutputSettings{
+		Key:    "my-stream",
+		MaxLen: 1000,
+	})
+
+	err := output.Write(ctx, []stream.WritableMessage{
+		stream.NewMessage("foo"),
+		stream.NewMessage("bar"),
+	})
+	assert.NoError(t, err, "there should be no error")
+}
+
+func TestRedisStreamOutput_WriteError(t *testing.T) {
+	ctx := t.Context()
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(t))
+	mw := metricMocks.NewWriter(t)
+	mw.EXPECT().Write(matcher.Context, mock.Anything).Return().Once()
+
+	redisMock := redisMocks.NewClient(t)
+	redisMock.EXPECT().XAdd(ctx, mock.AnythingOfType("redis.XAddArgs")).Return("", errors.New("boom")).Once()
+
+	output := stream.NewRedisStreamOutputWithInterfaces(logger, mw, redisMock, &stream.RedisStreamOutputSettings{
+		Key:       "my-stream",
+		ExactTrim: true,
+	})
+
+	err := output.WriteOne(ctx, stream.NewMessage("foo"))
+	assert.EqualError(t, err, "can not add message to redis stream my-stream: boom")
+}
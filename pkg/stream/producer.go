@@ -21,6 +21,8 @@ type ProducerSettings struct {
 	Output      string                 `cfg:"output"`
 	Encoding    EncodingType           `cfg:"encoding"`
 	Compression CompressionType        `cfg:"compression" default:"none"`
+	Encryption  EncryptionSettings     `cfg:"encryption"`
+	ClaimCheck  ClaimCheckSettings     `cfg:"claim_check"`
 	Daemon      ProducerDaemonSettings `cfg:"daemon"`
 }
 
@@ -46,7 +48,7 @@ func NewProducer(ctx context.Context, config cfg.Config, logger log.Logger, name
 		option(opts)
 	}
 
-	output, err := getOutput(ctx, config, logger, name, settings)
+	output, outputCapabilities, err := getOutput(ctx, config, logger, name, settings)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +74,22 @@ func NewProducer(ctx context.Context, config cfg.Config, logger log.Logger, name
 		encoderSettings.ExternalEncoder = externalEncoder
 	}
 
+	if settings.Encryption.Enabled {
+		if encoderSettings.Encryptor, err = NewMessageBodyEncryptor(ctx, config, logger, settings.Encryption); err != nil {
+			return nil, fmt.Errorf("can not create message body encryptor: %w", err)
+		}
+	}
+
+	if settings.ClaimCheck.Enabled {
+		if settings.ClaimCheck.Threshold == 0 && outputCapabilities.MaxMessageSize == nil {
+			return nil, fmt.Errorf("the claim check of producer %s requires a threshold, as the max message size of its output is unknown", name)
+		}
+
+		if encoderSettings.ClaimCheck, err = NewClaimCheck(ctx, config, logger, settings.ClaimCheck, outputCapabilities.MaxMessageSize); err != nil {
+			return nil, fmt.Errorf("can not create claim check: %w", err)
+		}
+	}
+
 	encoder := NewMessageEncoder(encoderSettings)
 
 	metadata := ProducerMetadata{
@@ -93,29 +111,30 @@ func NewProducerWithInterfaces(encoder MessageEncoder, output Output) *producer
 	}
 }
 
-func getOutput(ctx context.Context, config cfg.Config, logger log.Logger, name string, settings *ProducerSettings) (output Output, err error) {
+func getOutput(ctx context.Context, config cfg.Config, logger log.Logger, name string, settings *ProducerSettings) (output Output, outputCapabilities *OutputCapabilities, err error) {
 	if settings.Daemon.Enabled {
 		output, err = ProvideProducerDaemon(ctx, config, logger, name)
 		if err != nil {
-			return nil, fmt.Errorf("can not create producer daemon %s: %w", name, err)
+			return nil, nil, fmt.Errorf("can not create producer daemon %s: %w", name, err)
 		}
 
 		// the producer daemon will take care of compression for the whole batch, so we don't need to compress individual messages in the producer
 		settings.Compression = CompressionNone
 
-		return output, nil
+		// the capabilities of the output are only known to the producer daemon
+		return output, &OutputCapabilities{}, nil
 	}
 
-	output, outputCapabilities, err := NewConfigurableOutput(ctx, config, logger, settings.Output)
+	output, outputCapabilities, err = NewConfigurableOutput(ctx, config, logger, settings.Output)
 	if err != nil {
-		return nil, fmt.Errorf("can not create output %s: %w", settings.Output, err)
+		return nil, nil, fmt.Errorf("can not create output %s: %w", settings.Output, err)
 	}
 
 	if outputCapabilities.ProvidesCompression {
 		settings.Compression = CompressionNone
 	}
 
-	return output, nil
+	return output, outputCapabilities, nil
 }
 
 func (p *producer) WriteOne(ctx context.Context, model any, attributeSets ...map[string]string) error {
@@ -3,6 +3,9 @@ package stream
 import (
 	"context"
 	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
 	"time"
 
 	"github.com/justtrackio/gosoline/pkg/cfg"
@@ -10,8 +13,9 @@ import (
 )
 
 const (
-	AttributeRetry   = "goso.retry"
-	AttributeRetryId = "goso.retry.id"
+	AttributeRetry        = "goso.retry"
+	AttributeRetryAttempt = "goso.retry.attempt"
+	AttributeRetryId      = "goso.retry.id"
 )
 
 //go:generate go run github.com/vektra/mockery/v2 --name RetryHandler
@@ -20,8 +24,18 @@ type RetryHandler interface {
 }
 
 type RetryHandlerSettings struct {
-	After       time.Duration `cfg:"after" default:"1m"`
-	MaxAttempts int           `cfg:"max_attempts" default:"3"`
+	After time.Duration `cfg:"after" default:"1m"`
+	// MaxAttempts is the number of times a message is consumed before it is handed to the dead letter output (if enabled).
+	MaxAttempts int `cfg:"max_attempts" default:"3"`
+}
+
+// RetryHandlerBackoffSettings are used by retry handlers which schedule the retries themselves. The delay of the first
+// retry is RetryHandlerSettings.After and grows by Multiplier with every further attempt up to MaxAfter. Every delay
+// is randomized by +/- Jitter (as a fraction of the delay) to spread retries of messages failing at the same time.
+type RetryHandlerBackoffSettings struct {
+	Multiplier float64       `cfg:"multiplier" default:"2" validate:"min=1"`
+	MaxAfter   time.Duration `cfg:"max_after" default:"15m"`
+	Jitter     float64       `cfg:"jitter" default:"0.2" validate:"min=0,max=1"`
 }
 
 type RetryHandlerFactory func(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Input, RetryHandler, error)
@@ -46,3 +60,36 @@ func NewRetryHandler(ctx context.Context, config cfg.Config, logger log.Logger,
 func ConfigurableConsumerRetryKey(name string) string {
 	return fmt.Sprintf("%s.retry", ConfigurableConsumerKey(name))
 }
+
+// ReadRetryHandlerSettings reads the settings every retry handler of the consumer with the given name shares.
+func ReadRetryHandlerSettings(config cfg.Config, name string) (RetryHandlerSettings, error) {
+	key := ConfigurableConsumerRetryKey(name)
+	settings := RetryHandlerSettings{}
+
+	if err := config.UnmarshalKey(key, &settings); err != nil {
+		return settings, fmt.Errorf("failed to unmarshal retry handler settings for %s: %w", name, err)
+	}
+
+	return settings, nil
+}
+
+// RetryDelay computes the delay until the next attempt of the given retry message is due.
+func RetryDelay(settings RetryHandlerSettings, backoff RetryHandlerBackoffSettings, msg *Message) time.Duration {
+	// the retry message already carries the number of the upcoming attempt, the first retry is the second attempt
+	retries := 1
+	if attempt, err := strconv.Atoi(msg.Attributes[AttributeRetryAttempt]); err == nil && attempt > 1 {
+		retries = attempt - 1
+	}
+
+	delay := float64(settings.After) * math.Pow(math.Max(backoff.Multiplier, 1), float64(retries-1))
+
+	if backoff.MaxAfter > 0 && delay > float64(backoff.MaxAfter) {
+		delay = float64(backoff.MaxAfter)
+	}
+
+	if backoff.Jitter > 0 {
+		delay += delay * backoff.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(delay)
+}
@@ -0,0 +1,185 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/ddb"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/mdl"
+	"github.com/justtrackio/gosoline/pkg/stream/health"
+	"github.com/justtrackio/gosoline/pkg/uuid"
+)
+
+func init() {
+	retryHandlers["ddb"] = NewRetryHandlerDdb
+}
+
+type RetryHandlerDdbSettings struct {
+	RetryHandlerSettings
+	Backoff      RetryHandlerBackoffSettings `cfg:"backoff"`
+	ClientName   string                      `cfg:"client_name" default:"default"`
+	TableName    string                      `cfg:"table_name" default:"consumer-retry"`
+	PollInterval time.Duration               `cfg:"poll_interval" default:"1s"`
+	BatchSize    int                         `cfg:"batch_size" default:"10" validate:"min=1"`
+	// Retention is the time a message is kept after it became due. Messages which weren't claimed by then expire.
+	Retention   time.Duration              `cfg:"retention" default:"24h"`
+	Healthcheck health.HealthCheckSettings `cfg:"healthcheck"`
+}
+
+// RetryDdbItem is a scheduled retry stored in dynamodb. The items of all consumers of an application share a table,
+// the range key starts with the time the message is due, so the due messages of a consumer can be queried in order.
+type RetryDdbItem struct {
+	Consumer   string            `json:"consumer" ddb:"key=hash"`
+	Id         string            `json:"id" ddb:"key=range"`
+	Body       string            `json:"body"`
+	Attributes map[string]string `json:"attributes"`
+	Ttl        int64             `json:"ttl" ddb:"ttl=enabled"`
+}
+
+// RetryHandlerDdb schedules retries in a dynamodb table. Every instance of the consumer queries the table for due
+// messages and claims them with a conditional delete, so a message is only retried once even if several instances are
+// polling the same table.
+type RetryHandlerDdb struct {
+	*retryPollInput
+	clock    clock.Clock
+	uuid     uuid.Uuid
+	repo     ddb.Repository
+	consumer string
+	settings *RetryHandlerDdbSettings
+}
+
+func NewRetryHandlerDdb(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Input, RetryHandler, error) {
+	var err error
+	var repo ddb.Repository
+	var healthCheckTimer clock.HealthCheckTimer
+
+	key := ConfigurableConsumerRetryKey(name)
+	settings := &RetryHandlerDdbSettings{}
+	if err := config.UnmarshalKey(key, settings); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal retry handler ddb settings for %s: %w", name, err)
+	}
+
+	ddbSettings := &ddb.Settings{
+		ClientName: settings.ClientName,
+		ModelId: mdl.ModelId{
+			Name: settings.TableName,
+		},
+		Main: ddb.MainSettings{
+			Model: &RetryDdbItem{},
+		},
+	}
+
+	if repo, err = ddb.NewRepository(ctx, config, logger, ddbSettings); err != nil {
+		return nil, nil, fmt.Errorf("can not create ddb repository: %w", err)
+	}
+
+	if healthCheckTimer, err = clock.NewHealthCheckTimer(settings.Healthcheck.Timeout); err != nil {
+		return nil, nil, fmt.Errorf("failed to create healthcheck timer: %w", err)
+	}
+
+	handler := NewRetryHandlerDdbWithInterfaces(logger, clock.Provider, healthCheckTimer, uuid.New(), repo, name, settings)
+
+	return handler, handler, nil
+}
+
+func NewRetryHandlerDdbWithInterfaces(
+	logger log.Logger,
+	clock clock.Clock,
+	healthCheckTimer clock.HealthCheckTimer,
+	uuid uuid.Uuid,
+	repo ddb.Repository,
+	consumer string,
+	settings *RetryHandlerDdbSettings,
+) *RetryHandlerDdb {
+	handler := &RetryHandlerDdb{
+		clock:    clock,
+		uuid:     uuid,
+		repo:     repo,
+		consumer: consumer,
+		settings: settings,
+	}
+	handler.retryPollInput = newRetryPollInput(logger, clock, healthCheckTimer, handler.pollDue, handler.requeue, settings.PollInterval)
+
+	return handler
+}
+
+func (r *RetryHandlerDdb) Put(ctx context.Context, msg *Message) error {
+	dueAt := r.clock.Now().Add(RetryDelay(r.settings.RetryHandlerSettings, r.settings.Backoff, msg))
+
+	return r.put(ctx, msg, dueAt)
+}
+
+func (r *RetryHandlerDdb) requeue(ctx context.Context, msgs []*Message) error {
+	for _, msg := range msgs {
+		if err := r.put(ctx, msg, r.clock.Now()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *RetryHandlerDdb) put(ctx context.Context, msg *Message, dueAt time.Time) error {
+	item := &RetryDdbItem{
+		Consumer:   r.consumer,
+		Id:         fmt.Sprintf("%s-%s", retryDdbDuePrefix(dueAt), r.uuid.NewV4()),
+		Body:       msg.Body,
+		Attributes: msg.Attributes,
+		Ttl:        dueAt.Add(r.settings.Retention).Unix(),
+	}
+
+	if _, err := r.repo.PutItem(ctx, r.repo.PutItemBuilder(), item); err != nil {
+		return fmt.Errorf("can not put the retry message into the ddb table: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RetryHandlerDdb) pollDue(ctx context.Context) ([]*Message, bool, error) {
+	items := make([]RetryDdbItem, 0, r.settings.BatchSize)
+
+	// every id of a message due until now is lower than the prefix of the next millisecond
+	qb := r.repo.QueryBuilder().
+		WithHash(r.consumer).
+		WithRangeLt(retryDdbDuePrefix(r.clock.Now().Add(time.Millisecond))).
+		WithLimit(r.settings.BatchSize)
+
+	if _, err := r.repo.Query(ctx, qb, &items); err != nil {
+		return nil, false, fmt.Errorf("can not query due messages from the ddb table: %w", err)
+	}
+
+	msgs := make([]*Message, 0, len(items))
+
+	for _, item := range items {
+		db := r.repo.DeleteItemBuilder().
+			WithHash(item.Consumer).
+			WithRange(item.Id).
+			WithCondition(ddb.AttributeExists("id"))
+
+		res, err := r.repo.DeleteItem(ctx, db, &item)
+		if err != nil {
+			return msgs, false, fmt.Errorf("can not claim due message %s from the ddb table: %w", item.Id, err)
+		}
+
+		// another instance claimed the message already
+		if res.ConditionalCheckFailed {
+			continue
+		}
+
+		msgs = append(msgs, &Message{
+			Attributes: item.Attributes,
+			Body:       item.Body,
+		})
+	}
+
+	// even if another instance claimed some of a full batch, there might be more due messages
+	return msgs, len(items) == r.settings.BatchSize, nil
+}
+
+func retryDdbDuePrefix(dueAt time.Time) string {
+	return fmt.Sprintf("%020d", dueAt.UnixMilli())
+}
@@ -0,0 +1,145 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/ddb"
+	ddbMocks "github.com/justtrackio/gosoline/pkg/ddb/mocks"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	uuidMocks "github.com/justtrackio/gosoline/pkg/uuid/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RetryHandlerDdbTestSuite struct {
+	suite.Suite
+
+	clock   clock.FakeClock
+	uuid    *uuidMocks.Uuid
+	repo    *ddbMocks.Repository
+	handler *stream.RetryHandlerDdb
+}
+
+func TestRetryHandlerDdb(t *testing.T) {
+	suite.Run(t, new(RetryHandlerDdbTestSuite))
+}
+
+func (s *RetryHandlerDdbTestSuite) SetupTest() {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+
+	s.clock = clock.NewFakeClockAt(time.UnixMilli(1_700_000_000_000))
+	s.uuid = uuidMocks.NewUuid(s.T())
+	s.repo = ddbMocks.NewRepository(s.T())
+	s.handler = stream.NewRetryHandlerDdbWithInterfaces(
+		logger,
+		s.clock,
+		clock.NewHealthCheckTimerWithInterfaces(s.clock, time.Minute),
+		s.uuid,
+		s.repo,
+		"test",
+		&stream.RetryHandlerDdbSettings{
+			RetryHandlerSettings: stream.RetryHandlerSettings{
+				After: time.Second,
+			},
+			Backoff: stream.RetryHandlerBackoffSettings{
+				Multiplier: 2,
+			},
+			PollInterval: time.Second,
+			BatchSize:    2,
+			Retention:    time.Hour,
+		},
+	)
+}
+
+func (s *RetryHandlerDdbTestSuite) TestPut() {
+	msg := stream.NewJsonMessage(`"foo"`, map[string]string{
+		stream.AttributeRetryAttempt: "3",
+	})
+
+	qb := ddbMocks.NewPutItemBuilder(s.T())
+
+	s.uuid.EXPECT().NewV4().Return("a0e4b1c2-7d6f-4d3e-9c21-52f3f0a1b2c3").Once()
+	s.repo.EXPECT().PutItemBuilder().Return(qb).Once()
+	s.repo.EXPECT().PutItem(matcher.Context, qb, &stream.RetryDdbItem{
+		Consumer: "test",
+		Id:       "00000001700000002000-a0e4b1c2-7d6f-4d3e-9c21-52f3f0a1b2c3",
+		Body:     `"foo"`,
+		Attributes: map[string]string{
+			stream.AttributeEncoding:     "application/json",
+			stream.AttributeRetryAttempt: "3",
+		},
+		Ttl: 1_700_003_602,
+	}).Return(&ddb.PutItemResult{}, nil).Once()
+
+	err := s.handler.Put(s.T().Context(), msg)
+	s.NoError(err)
+}
+
+func (s *RetryHandlerDdbTestSuite) TestRun() {
+	items := []stream.RetryDdbItem{
+		{Consumer: "test", Id: "00000001699999999000-1", Body: `"foo"`},
+		{Consumer: "test", Id: "00000001700000000000-2", Body: `"bar"`},
+	}
+
+	qb := ddbMocks.NewQueryBuilder(s.T())
+	qb.EXPECT().WithHash("test").Return(qb).Once()
+	qb.EXPECT().WithRangeLt("00000001700000001001").Return(qb).Once()
+	qb.EXPECT().WithLimit(2).Return(qb).Once()
+
+	s.repo.EXPECT().QueryBuilder().Return(qb).Once()
+	s.repo.EXPECT().Query(matcher.Context, qb, mock.AnythingOfType("*[]stream.RetryDdbItem")).
+		Run(func(ctx context.Context, qb ddb.QueryBuilder, result any) {
+			*result.(*[]stream.RetryDdbItem) = items
+		}).
+		Return(&ddb.QueryResult{}, nil).
+		Once()
+
+	s.expectDelete(items[0], false)
+	s.expectDelete(items[1], true)
+
+	qbEmpty := ddbMocks.NewQueryBuilder(s.T())
+	qbEmpty.EXPECT().WithHash("test").Return(qbEmpty).Once()
+	qbEmpty.EXPECT().WithRangeLt("00000001700000001001").Return(qbEmpty).Once()
+	qbEmpty.EXPECT().WithLimit(2).Return(qbEmpty).Once()
+
+	s.repo.EXPECT().QueryBuilder().Return(qbEmpty).Once()
+	polled := make(chan struct{})
+	s.repo.EXPECT().Query(matcher.Context, qbEmpty, mock.AnythingOfType("*[]stream.RetryDdbItem")).
+		Run(func(ctx context.Context, qb ddb.QueryBuilder, result any) {
+			close(polled)
+		}).
+		Return(&ddb.QueryResult{}, nil).
+		Once()
+
+	done := make(chan error)
+	go func() {
+		done <- s.handler.Run(s.T().Context())
+	}()
+
+	s.clock.BlockUntilTickers(1)
+	s.clock.Advance(time.Second)
+
+	s.Equal(`"foo"`, (<-s.handler.Data()).Body)
+
+	<-polled
+
+	s.handler.Stop(s.T().Context())
+	s.NoError(<-done)
+}
+
+func (s *RetryHandlerDdbTestSuite) expectDelete(item stream.RetryDdbItem, conditionalCheckFailed bool) {
+	db := ddbMocks.NewDeleteItemBuilder(s.T())
+	db.EXPECT().WithHash(item.Consumer).Return(db).Once()
+	db.EXPECT().WithRange(item.Id).Return(db).Once()
+	db.EXPECT().WithCondition(mock.Anything).Return(db).Once()
+
+	s.repo.EXPECT().DeleteItemBuilder().Return(db).Once()
+	s.repo.EXPECT().DeleteItem(matcher.Context, db, &item).Return(&ddb.DeleteItemResult{
+		ConditionalCheckFailed: conditionalCheckFailed,
+	}, nil).Once()
+}
@@ -0,0 +1,124 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+// retryPollFunc claims and returns messages which are due for their next attempt and whether there might be more due
+// messages. Messages which got claimed before an error occurred are returned together with the error.
+type retryPollFunc func(ctx context.Context) (msgs []*Message, more bool, err error)
+
+// retryRequeueFunc schedules claimed messages which couldn't be handed to the consumer anymore to be due immediately.
+type retryRequeueFunc func(ctx context.Context, msgs []*Message) error
+
+// retryPollInput is the Input of retry handlers which store the scheduled retries themselves. It polls for due
+// messages in the configured interval.
+type retryPollInput struct {
+	logger           log.Logger
+	clock            clock.Clock
+	healthCheckTimer clock.HealthCheckTimer
+	poll             retryPollFunc
+	requeue          retryRequeueFunc
+	interval         time.Duration
+
+	channel  chan *Message
+	stopped  chan struct{}
+	stopOnce sync.Once
+}
+
+func newRetryPollInput(
+	logger log.Logger,
+	clock clock.Clock,
+	healthCheckTimer clock.HealthCheckTimer,
+	poll retryPollFunc,
+	requeue retryRequeueFunc,
+	interval time.Duration,
+) *retryPollInput {
+	return &retryPollInput{
+		logger:           logger,
+		clock:            clock,
+		healthCheckTimer: healthCheckTimer,
+		poll:             poll,
+		requeue:          requeue,
+		interval:         interval,
+		channel:          make(chan *Message),
+		stopped:          make(chan struct{}),
+	}
+}
+
+func (i *retryPollInput) Run(ctx context.Context) error {
+	defer close(i.channel)
+
+	ticker := i.clock.NewTicker(i.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-i.stopped:
+			return nil
+		case <-ticker.Chan():
+		}
+
+		if err := i.pollDue(ctx); err != nil && !exec.IsRequestCanceled(err) {
+			i.logger.Error(ctx, "can not poll due retry messages: %w", err)
+		}
+	}
+}
+
+func (i *retryPollInput) pollDue(ctx context.Context) error {
+	for {
+		msgs, more, err := i.poll(ctx)
+
+		for j, msg := range msgs {
+			select {
+			case i.channel <- msg:
+			case <-ctx.Done():
+				return i.requeueUndelivered(ctx, msgs[j:])
+			case <-i.stopped:
+				return i.requeueUndelivered(ctx, msgs[j:])
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		i.healthCheckTimer.MarkHealthy()
+
+		if !more {
+			return nil
+		}
+	}
+}
+
+// requeueUndelivered puts the messages back as they are claimed already and would get lost otherwise.
+func (i *retryPollInput) requeueUndelivered(ctx context.Context, msgs []*Message) error {
+	if err := i.requeue(context.WithoutCancel(ctx), msgs); err != nil {
+		return fmt.Errorf("can not requeue %d undelivered retry messages: %w", len(msgs), err)
+	}
+
+	return nil
+}
+
+func (i *retryPollInput) Stop(context.Context) {
+	i.stopOnce.Do(func() {
+		close(i.stopped)
+	})
+}
+
+func (i *retryPollInput) Data() <-chan *Message {
+	return i.channel
+}
+
+func (i *retryPollInput) IsHealthy() bool {
+	return i.healthCheckTimer.IsHealthy()
+}
@@ -0,0 +1,154 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/redis"
+	"github.com/justtrackio/gosoline/pkg/stream/health"
+)
+
+func init() {
+	retryHandlers["redis"] = NewRetryHandlerRedis
+}
+
+type RetryHandlerRedisSettings struct {
+	RetryHandlerSettings
+	Backoff      RetryHandlerBackoffSettings `cfg:"backoff"`
+	ServerName   string                      `cfg:"server_name" default:"default"`
+	Key          string                      `cfg:"key"`
+	PollInterval time.Duration               `cfg:"poll_interval" default:"1s"`
+	BatchSize    int                         `cfg:"batch_size" default:"10" validate:"min=1"`
+	Healthcheck  health.HealthCheckSettings  `cfg:"healthcheck"`
+}
+
+// RetryHandlerRedis schedules retries in a redis sorted set using the time the message is due as score. Every instance
+// of the consumer polls the set for due messages and claims them by removing them from the set, so a message is only
+// retried once even if several instances are polling the same set.
+type RetryHandlerRedis struct {
+	*retryPollInput
+	logger   log.Logger
+	clock    clock.Clock
+	client   redis.Client
+	settings *RetryHandlerRedisSettings
+}
+
+func NewRetryHandlerRedis(ctx context.Context, config cfg.Config, logger log.Logger, name string) (Input, RetryHandler, error) {
+	var err error
+	var client redis.Client
+	var healthCheckTimer clock.HealthCheckTimer
+
+	key := ConfigurableConsumerRetryKey(name)
+	settings := &RetryHandlerRedisSettings{}
+	if err := config.UnmarshalKey(key, settings); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal retry handler redis settings for %s: %w", name, err)
+	}
+
+	if settings.Key == "" {
+		settings.Key = fmt.Sprintf("consumer-retry-%s", name)
+	}
+
+	if client, err = redis.ProvideClient(ctx, config, logger, settings.ServerName); err != nil {
+		return nil, nil, fmt.Errorf("can not create redis client: %w", err)
+	}
+
+	if healthCheckTimer, err = clock.NewHealthCheckTimer(settings.Healthcheck.Timeout); err != nil {
+		return nil, nil, fmt.Errorf("failed to create healthcheck timer: %w", err)
+	}
+
+	handler := NewRetryHandlerRedisWithInterfaces(logger, clock.Provider, healthCheckTimer, client, settings)
+
+	return handler, handler, nil
+}
+
+func NewRetryHandlerRedisWithInterfaces(
+	logger log.Logger,
+	clock clock.Clock,
+	healthCheckTimer clock.HealthCheckTimer,
+	client redis.Client,
+	settings *RetryHandlerRedisSettings,
+) *RetryHandlerRedis {
+	handler := &RetryHandlerRedis{
+		logger:   logger,
+		clock:    clock,
+		client:   client,
+		settings: settings,
+	}
+	handler.retryPollInput = newRetryPollInput(logger, clock, healthCheckTimer, handler.pollDue, handler.requeue, settings.PollInterval)
+
+	return handler
+}
+
+func (r *RetryHandlerRedis) Put(ctx context.Context, msg *Message) error {
+	dueAt := r.clock.Now().Add(RetryDelay(r.settings.RetryHandlerSettings, r.settings.Backoff, msg))
+
+	return r.put(ctx, msg, dueAt)
+}
+
+func (r *RetryHandlerRedis) requeue(ctx context.Context, msgs []*Message) error {
+	for _, msg := range msgs {
+		if err := r.put(ctx, msg, r.clock.Now()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *RetryHandlerRedis) put(ctx context.Context, msg *Message, dueAt time.Time) error {
+	member, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("can not marshal the retry message: %w", err)
+	}
+
+	if _, err = r.client.ZAdd(ctx, r.settings.Key, float64(dueAt.UnixMilli()), string(member)); err != nil {
+		return fmt.Errorf("can not add the retry message to the sorted set %s: %w", r.settings.Key, err)
+	}
+
+	return nil
+}
+
+func (r *RetryHandlerRedis) pollDue(ctx context.Context) ([]*Message, bool, error) {
+	members, err := r.client.ZRangeArgs(ctx, redis.ZRangeArgs{
+		Key:     r.settings.Key,
+		Start:   "-inf",
+		Stop:    strconv.FormatInt(r.clock.Now().UnixMilli(), 10),
+		ByScore: true,
+		Count:   int64(r.settings.BatchSize),
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("can not read due messages from the sorted set %s: %w", r.settings.Key, err)
+	}
+
+	msgs := make([]*Message, 0, len(members))
+
+	for _, member := range members {
+		removed, err := r.client.ZRem(ctx, r.settings.Key, member)
+		if err != nil {
+			return msgs, false, fmt.Errorf("can not claim due message from the sorted set %s: %w", r.settings.Key, err)
+		}
+
+		// another instance claimed the message already
+		if removed == 0 {
+			continue
+		}
+
+		msg := &Message{}
+		if err := json.Unmarshal([]byte(member), msg); err != nil {
+			r.logger.Error(ctx, "can not unmarshal retry message from the sorted set %s: %w", r.settings.Key, err)
+
+			continue
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	// even if another instance claimed some of a full batch, there might be more due messages
+	return msgs, len(members) == r.settings.BatchSize, nil
+}
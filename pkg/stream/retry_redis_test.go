@@ -0,0 +1,132 @@
+package stream_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/redis"
+	redisMocks "github.com/justtrackio/gosoline/pkg/redis/mocks"
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/justtrackio/gosoline/pkg/test/matcher"
+	"github.com/stretchr/testify/suite"
+)
+
+type RetryHandlerRedisTestSuite struct {
+	suite.Suite
+
+	clock   clock.FakeClock
+	client  *redisMocks.Client
+	handler *stream.RetryHandlerRedis
+}
+
+func TestRetryHandlerRedis(t *testing.T) {
+	suite.Run(t, new(RetryHandlerRedisTestSuite))
+}
+
+func (s *RetryHandlerRedisTestSuite) SetupTest() {
+	logger := logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+
+	s.clock = clock.NewFakeClockAt(time.UnixMilli(1_700_000_000_000))
+	s.client = redisMocks.NewClient(s.T())
+	s.handler = stream.NewRetryHandlerRedisWithInterfaces(
+		logger,
+		s.clock,
+		clock.NewHealthCheckTimerWithInterfaces(s.clock, time.Minute),
+		s.client,
+		&stream.RetryHandlerRedisSettings{
+			RetryHandlerSettings: stream.RetryHandlerSettings{
+				After: time.Second,
+			},
+			Backoff: stream.RetryHandlerBackoffSettings{
+				Multiplier: 2,
+			},
+			Key:          "consumer-retry-test",
+			PollInterval: time.Second,
+			BatchSize:    2,
+		},
+	)
+}
+
+func (s *RetryHandlerRedisTestSuite) TestPut() {
+	msg := stream.NewJsonMessage(`"foo"`, map[string]string{
+		stream.AttributeRetryAttempt: "3",
+	})
+
+	s.client.EXPECT().
+		ZAdd(matcher.Context, "consumer-retry-test", float64(1_700_000_002_000), `{"attributes":{"encoding":"application/json","goso.retry.attempt":"3"},"body":"\"foo\""}`).
+		Return(1, nil).
+		Once()
+
+	err := s.handler.Put(s.T().Context(), msg)
+	s.NoError(err)
+}
+
+func (s *RetryHandlerRedisTestSuite) TestRun() {
+	first := `{"attributes":{"goso.retry.attempt":"2"},"body":"\"foo\""}`
+	second := `{"attributes":{"goso.retry.attempt":"2"},"body":"\"bar\""}`
+	third := `{"attributes":{"goso.retry.attempt":"2"},"body":"\"baz\""}`
+
+	s.client.EXPECT().ZRangeArgs(matcher.Context, redis.ZRangeArgs{
+		Key:     "consumer-retry-test",
+		Start:   "-inf",
+		Stop:    "1700000001000",
+		ByScore: true,
+		Count:   2,
+	}).Return([]string{first, second}, nil).Once()
+	s.client.EXPECT().ZRem(matcher.Context, "consumer-retry-test", first).Return(1, nil).Once()
+	s.client.EXPECT().ZRem(matcher.Context, "consumer-retry-test", second).Return(0, nil).Once()
+
+	s.client.EXPECT().ZRangeArgs(matcher.Context, redis.ZRangeArgs{
+		Key:     "consumer-retry-test",
+		Start:   "-inf",
+		Stop:    "1700000001000",
+		ByScore: true,
+		Count:   2,
+	}).Return([]string{third}, nil).Once()
+	s.client.EXPECT().ZRem(matcher.Context, "consumer-retry-test", third).Return(1, nil).Once()
+
+	done := make(chan error)
+	go func() {
+		done <- s.handler.Run(s.T().Context())
+	}()
+
+	s.clock.BlockUntilTickers(1)
+	s.clock.Advance(time.Second)
+
+	s.Equal(`"foo"`, (<-s.handler.Data()).Body)
+	s.Equal(`"baz"`, (<-s.handler.Data()).Body)
+
+	s.handler.Stop(s.T().Context())
+	s.NoError(<-done)
+}
+
+func (s *RetryHandlerRedisTestSuite) TestRunRequeuesUndeliveredOnStop() {
+	first := `{"attributes":{"goso.retry.attempt":"2"},"body":"\"foo\""}`
+	second := `{"attributes":{"goso.retry.attempt":"2"},"body":"\"bar\""}`
+
+	s.client.EXPECT().ZRangeArgs(matcher.Context, redis.ZRangeArgs{
+		Key:     "consumer-retry-test",
+		Start:   "-inf",
+		Stop:    "1700000001000",
+		ByScore: true,
+		Count:   2,
+	}).Return([]string{first, second}, nil).Once()
+	s.client.EXPECT().ZRem(matcher.Context, "consumer-retry-test", first).Return(1, nil).Once()
+	s.client.EXPECT().ZRem(matcher.Context, "consumer-retry-test", second).Return(1, nil).Once()
+	s.client.EXPECT().ZAdd(matcher.Context, "consumer-retry-test", float64(1_700_000_001_000), second).Return(1, nil).Once()
+
+	done := make(chan error)
+	go func() {
+		done <- s.handler.Run(s.T().Context())
+	}()
+
+	s.clock.BlockUntilTickers(1)
+	s.clock.Advance(time.Second)
+
+	s.Equal(`"foo"`, (<-s.handler.Data()).Body)
+
+	s.handler.Stop(s.T().Context())
+	s.NoError(<-done)
+}
@@ -0,0 +1,53 @@
+package stream_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryDelay(t *testing.T) {
+	settings := stream.RetryHandlerSettings{
+		After: time.Second,
+	}
+	backoff := stream.RetryHandlerBackoffSettings{
+		Multiplier: 2,
+		MaxAfter:   5 * time.Second,
+	}
+
+	for attempt, expected := range map[string]time.Duration{
+		"":  time.Second,
+		"2": time.Second,
+		"3": 2 * time.Second,
+		"4": 4 * time.Second,
+		"5": 5 * time.Second,
+	} {
+		msg := stream.NewJsonMessage(`"foo"`, map[string]string{
+			stream.AttributeRetryAttempt: attempt,
+		})
+
+		assert.Equal(t, expected, stream.RetryDelay(settings, backoff, msg), "attempt %q", attempt)
+	}
+}
+
+func TestRetryDelay_Jitter(t *testing.T) {
+	settings := stream.RetryHandlerSettings{
+		After: 10 * time.Second,
+	}
+	backoff := stream.RetryHandlerBackoffSettings{
+		Multiplier: 2,
+		Jitter:     0.2,
+	}
+	msg := stream.NewJsonMessage(`"foo"`, map[string]string{
+		stream.AttributeRetryAttempt: "3",
+	})
+
+	for range 100 {
+		delay := stream.RetryDelay(settings, backoff, msg)
+
+		assert.GreaterOrEqual(t, delay, 16*time.Second)
+		assert.LessOrEqual(t, delay, 24*time.Second)
+	}
+}
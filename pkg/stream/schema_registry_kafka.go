@@ -43,7 +43,10 @@ func InitKafkaSchemaRegistry(
 
 		options = append(options, encodeFn, decodeFn)
 	case schemaRegistry.Json:
-		jsonEncoder := NewJsonEncoder()
+		jsonEncoder, err := NewJsonSchemaEncoder(settings.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create json schema encoder: %w", err)
+		}
 
 		encodeFn = sr.EncodeFn(func(v any) ([]byte, error) {
 			return jsonEncoder.Encode(v)
@@ -73,14 +76,28 @@ func InitKafkaSchemaRegistry(
 		return nil, fmt.Errorf("unknown schema type: %s", schemaType)
 	}
 
+	subject := settings.Subject
+	if subject == "" {
+		var err error
+		if subject, err = schemaRegistry.BuildSubject(settings.SubjectNameStrategy, settings.Topic, settings.Schema, schemaType, settings.Model); err != nil {
+			return nil, fmt.Errorf("failed to build subject for topic %s: %w", settings.Topic, err)
+		}
+	}
+
+	if settings.CheckCompatibility {
+		if err := schemaRegistryService.CheckCompatibility(ctx, subject, settings.Schema, schemaType); err != nil {
+			return nil, err
+		}
+	}
+
 	getSchemaId := schemaRegistryService.GetSubjectSchemaId
 	if settings.AutoRegister {
 		getSchemaId = schemaRegistryService.GetOrCreateSubjectSchemaId
 	}
 
-	schemaId, err := getSchemaId(ctx, settings.Subject, settings.Schema, schemaType)
+	schemaId, err := getSchemaId(ctx, subject, settings.Schema, schemaType)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get subject schema id from registry: %w", err)
+		return nil, fmt.Errorf("failed to get schema id of subject %s from registry: %w", subject, err)
 	}
 
 	serde := schemaRegistry.NewSerde()
@@ -6,6 +6,7 @@ import (
 	schemaRegistry "github.com/justtrackio/gosoline/pkg/kafka/schema-registry"
 	schemaRegistryMocks "github.com/justtrackio/gosoline/pkg/kafka/schema-registry/mocks"
 	"github.com/justtrackio/gosoline/pkg/stream"
+	"github.com/justtrackio/gosoline/pkg/stream/testdata"
 	"github.com/justtrackio/gosoline/pkg/test/matcher"
 	"github.com/stretchr/testify/assert"
 )
@@ -53,3 +54,65 @@ func TestInitKafkaSchemaRegistry_UsesGetOrCreateWhenAutoRegisterEnabled(t *testi
 	assert.NoError(t, err)
 	assert.NotNil(t, encoder)
 }
+
+func TestInitKafkaSchemaRegistry_DerivesSubjectFromStrategy(t *testing.T) {
+	service := schemaRegistryMocks.NewService(t)
+	settings := stream.SchemaSettingsWithEncoding{
+		Topic:               "topic",
+		SubjectNameStrategy: schemaRegistry.TopicRecordNameStrategy,
+		Schema:              `syntax = "proto3"; message TestEncodingMessage { int32 id = 1; string data = 2; }`,
+		Encoding:            stream.EncodingProtobuf,
+		Model:               &testdata.TestEncodingMessage{},
+	}
+
+	service.EXPECT().GetSubjectSchemaId(matcher.Context, "topic-TestEncodingMessage", settings.Schema, schemaRegistry.Protobuf).Return(13, nil).Once()
+
+	encoder, err := stream.InitKafkaSchemaRegistry(t.Context(), settings, service)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, encoder)
+}
+
+func TestInitKafkaSchemaRegistry_FailsOnIncompatibleSchema(t *testing.T) {
+	service := schemaRegistryMocks.NewService(t)
+	settings := stream.SchemaSettingsWithEncoding{
+		Topic:              "topic",
+		Schema:             `{"type":"object"}`,
+		Encoding:           stream.EncodingJson,
+		AutoRegister:       true,
+		CheckCompatibility: true,
+		Model:              &struct{}{},
+	}
+
+	service.EXPECT().CheckCompatibility(matcher.Context, "topic-value", settings.Schema, schemaRegistry.Json).Return(assert.AnError).Once()
+
+	encoder, err := stream.InitKafkaSchemaRegistry(t.Context(), settings, service)
+
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Nil(t, encoder)
+}
+
+func TestInitKafkaSchemaRegistry_ValidatesJsonSchemaOnEncode(t *testing.T) {
+	type model struct {
+		Id int `json:"id"`
+	}
+
+	service := schemaRegistryMocks.NewService(t)
+	settings := stream.SchemaSettingsWithEncoding{
+		Subject:  "test-subject",
+		Schema:   `{"type":"object","properties":{"id":{"type":"integer","minimum":1}},"required":["id"]}`,
+		Encoding: stream.EncodingJson,
+		Model:    &model{},
+	}
+
+	service.EXPECT().GetSubjectSchemaId(matcher.Context, settings.Subject, settings.Schema, schemaRegistry.Json).Return(11, nil).Once()
+
+	encoder, err := stream.InitKafkaSchemaRegistry(t.Context(), settings, service)
+	assert.NoError(t, err)
+
+	_, err = encoder.Encode(&model{Id: 1})
+	assert.NoError(t, err)
+
+	_, err = encoder.Encode(&model{Id: 0})
+	assert.ErrorContains(t, err, "message does not match the json schema: id: Must be greater than or equal to 1")
+}
@@ -1,28 +1,43 @@
 package stream
 
+import schemaRegistry "github.com/justtrackio/gosoline/pkg/kafka/schema-registry"
+
 type SchemaSettingsWithEncoding struct {
+	Topic                string
 	Subject              string
+	SubjectNameStrategy  schemaRegistry.SubjectNameStrategy
 	Schema               string
 	Encoding             EncodingType
 	AutoRegister         bool
+	CheckCompatibility   bool
 	ProtobufMessageIndex []int
 	Model                any
 }
 
 type SchemaSettings struct {
-	Subject              string
-	Schema               string
-	AutoRegister         bool
+	// Topic is the full name of the kafka topic the subject is derived from. Kafka inputs and outputs use their own
+	// topic if empty.
+	Topic string
+	// Subject is derived from the topic and the schema using the SubjectNameStrategy if empty.
+	Subject             string
+	SubjectNameStrategy schemaRegistry.SubjectNameStrategy
+	Schema              string
+	AutoRegister        bool
+	// CheckCompatibility fails the startup if the schema is not compatible with the latest schema of the subject.
+	CheckCompatibility   bool
 	ProtobufMessageIndex []int
 	Model                any
 }
 
 func (s SchemaSettings) WithEncoding(encoding EncodingType) SchemaSettingsWithEncoding {
 	return SchemaSettingsWithEncoding{
+		Topic:                s.Topic,
 		Subject:              s.Subject,
+		SubjectNameStrategy:  s.SubjectNameStrategy,
 		Schema:               s.Schema,
 		Encoding:             encoding,
 		AutoRegister:         s.AutoRegister,
+		CheckCompatibility:   s.CheckCompatibility,
 		ProtobufMessageIndex: s.ProtobufMessageIndex,
 		Model:                s.Model,
 	}
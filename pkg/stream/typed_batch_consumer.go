@@ -26,10 +26,16 @@ type untypedBatchConsumerCallback[M any] struct {
 	consumerCallback BatchConsumerCallback[M]
 }
 
+type untypedIdempotentBatchConsumerCallback[M any] struct {
+	untypedBatchConsumerCallback[M]
+	untypedIdempotencyKeyAwareCallback[M]
+}
+
 var (
 	_ InitializeableCallback               = untypedBatchConsumerCallback[any]{}
 	_ RunnableUntypedBatchConsumerCallback = untypedBatchConsumerCallback[any]{}
 	_ SchemaSettingsAwareCallback          = untypedBatchConsumerCallback[any]{}
+	_ IdempotencyKeyAwareCallback          = untypedIdempotentBatchConsumerCallback[any]{}
 )
 
 func NewTypedBatchConsumer[M any](name string, callbackFactory BatchConsumerCallbackFactory[M]) kernel.ModuleFactory {
@@ -50,9 +56,18 @@ func EraseBatchConsumerCallbackFactoryTypes[M any](callbackFactory BatchConsumer
 }
 
 func EraseBatchConsumerCallbackTypes[M any](consumerCallback BatchConsumerCallback[M]) UntypedBatchConsumerCallback {
-	return untypedBatchConsumerCallback[M]{
+	untyped := untypedBatchConsumerCallback[M]{
 		consumerCallback: consumerCallback,
 	}
+
+	if keyAware, ok := consumerCallback.(TypedIdempotencyKeyAwareCallback[M]); ok {
+		return untypedIdempotentBatchConsumerCallback[M]{
+			untypedBatchConsumerCallback:       untyped,
+			untypedIdempotencyKeyAwareCallback: untypedIdempotencyKeyAwareCallback[M]{callback: keyAware},
+		}
+	}
+
+	return untyped
 }
 
 func (u untypedBatchConsumerCallback[M]) GetModel(_ map[string]string) (any, error) {
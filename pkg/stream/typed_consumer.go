@@ -25,10 +25,16 @@ type untypedConsumerCallback[M any] struct {
 	consumerCallback ConsumerCallback[M]
 }
 
+type untypedIdempotentConsumerCallback[M any] struct {
+	untypedConsumerCallback[M]
+	untypedIdempotencyKeyAwareCallback[M]
+}
+
 var (
 	_ InitializeableCallback          = untypedConsumerCallback[any]{}
 	_ RunnableUntypedConsumerCallback = untypedConsumerCallback[any]{}
 	_ SchemaSettingsAwareCallback     = untypedConsumerCallback[any]{}
+	_ IdempotencyKeyAwareCallback     = untypedIdempotentConsumerCallback[any]{}
 )
 
 func NewConsumer[M any](name string, callbackFactory ConsumerCallbackFactory[M]) kernel.ModuleFactory {
@@ -49,9 +55,18 @@ func EraseConsumerCallbackFactoryTypes[M any](callbackFactory ConsumerCallbackFa
 }
 
 func EraseConsumerCallbackTypes[M any](consumerCallback ConsumerCallback[M]) UntypedConsumerCallback {
-	return untypedConsumerCallback[M]{
+	untyped := untypedConsumerCallback[M]{
 		consumerCallback: consumerCallback,
 	}
+
+	if keyAware, ok := consumerCallback.(TypedIdempotencyKeyAwareCallback[M]); ok {
+		return untypedIdempotentConsumerCallback[M]{
+			untypedConsumerCallback:            untyped,
+			untypedIdempotencyKeyAwareCallback: untypedIdempotencyKeyAwareCallback[M]{callback: keyAware},
+		}
+	}
+
+	return untyped
 }
 
 func (u untypedConsumerCallback[M]) GetModel(_ map[string]string) (any, error) {
@@ -0,0 +1,40 @@
+// Package subject stores the authenticated subject of a request in a [context.Context]. It has no dependencies on a
+// server implementation, so packages like db-repo can read the subject without depending on gin or grpc.
+package subject
+
+import (
+	"context"
+	"fmt"
+)
+
+type subjectKeyType int
+
+var subjectKey = new(subjectKeyType)
+
+type Subject struct {
+	Name            string
+	Anonymous       bool
+	AuthenticatedBy string
+	Attributes      map[string]any
+}
+
+// ContextWithSubject returns a copy of the context carrying the subject.
+func ContextWithSubject(ctx context.Context, subject *Subject) context.Context {
+	return context.WithValue(ctx, subjectKey, subject)
+}
+
+// GetSubject returns the subject of the context and panics if there is none.
+func GetSubject(ctx context.Context) *Subject {
+	if subject, ok := LookupSubject(ctx); ok {
+		return subject
+	}
+
+	panic(fmt.Errorf("there is no subject in the context"))
+}
+
+// LookupSubject returns the subject of the context and whether there is one.
+func LookupSubject(ctx context.Context) (*Subject, bool) {
+	subject, ok := ctx.Value(subjectKey).(*Subject)
+
+	return subject, ok
+}
@@ -0,0 +1,61 @@
+package env
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/stretchr/testify/assert"
+)
+
+type postgresComponent struct {
+	baseComponent
+	client      *sqlx.DB
+	credentials postgresCredentials
+	binding     ContainerBinding
+}
+
+func (c *postgresComponent) CfgOptions() []cfg.Option {
+	return []cfg.Option{
+		cfg.WithConfigMap(map[string]any{
+			"db": map[string]any{
+				c.name: map[string]any{
+					"uri.host":           c.binding.host,
+					"uri.user":           c.credentials.UserName,
+					"uri.password":       c.credentials.UserPassword,
+					"uri.database":       c.credentials.DatabaseName,
+					"uri.port":           c.binding.port,
+					"parameters.sslmode": "disable",
+					"migrations.enabled": true,
+				},
+			},
+		}),
+	}
+}
+
+func (c *postgresComponent) Client() *sqlx.DB {
+	return c.client
+}
+
+func (c *postgresComponent) Exec(qry string, args ...any) {
+	_, err := c.client.Exec(qry, args...)
+	if err != nil {
+		assert.FailNow(c.t, err.Error(), "failed to execute query")
+
+		return
+	}
+}
+
+func (c *postgresComponent) AssertRowCount(table string, expectedCount int) {
+	qry, args, err := squirrel.Select("COUNT(*)").From(table).PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		assert.FailNow(c.t, err.Error(), "can not generate qry to count rows in table %s", table)
+	}
+
+	var actualCount int
+	err = c.client.Get(&actualCount, qry, args...)
+	if err != nil {
+		assert.FailNow(c.t, err.Error(), "can not count rows in table %s", table)
+	}
+
+	assert.Equal(c.t, expectedCount, actualCount, "row count doesn't match for table %s", table)
+}
@@ -0,0 +1,87 @@
+package env
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	schemaRegistry "github.com/justtrackio/gosoline/pkg/kafka/schema-registry"
+	"github.com/twmb/franz-go/pkg/sr"
+)
+
+type SchemaRegistryComponent struct {
+	baseComponent
+	connection string
+	registry   *FakeSchemaRegistry
+	server     *httptest.Server
+	client     schemaRegistry.Client
+}
+
+func (c *SchemaRegistryComponent) SetT(t *testing.T) {
+	c.baseComponent.SetT(t)
+
+	t.Cleanup(c.server.Close)
+}
+
+func (c *SchemaRegistryComponent) CfgOptions() []cfg.Option {
+	key := fmt.Sprintf("kafka.connection.%s.schema_registry_address", c.connection)
+
+	return []cfg.Option{
+		cfg.WithConfigSetting(key, c.Address()),
+	}
+}
+
+func (c *SchemaRegistryComponent) Address() string {
+	return c.server.URL
+}
+
+func (c *SchemaRegistryComponent) Client() schemaRegistry.Client {
+	return c.client
+}
+
+func (c *SchemaRegistryComponent) Registry() *FakeSchemaRegistry {
+	return c.registry
+}
+
+// RegisterSchema registers the schema of the given type (avro, json or protobuf) for the subject.
+func (c *SchemaRegistryComponent) RegisterSchema(subject string, typ schemaRegistry.SchemaType, schema string) sr.SubjectSchema {
+	schemaType, err := schemaTypeOf(string(typ), "")
+	if err != nil {
+		c.failNow(err.Error(), "can not register schema for subject %s", subject)
+	}
+
+	return c.registry.Register(subject, sr.Schema{
+		Schema: schema,
+		Type:   schemaType,
+	})
+}
+
+// RegisterSchemaFromFile registers the schema in the file for the subject, the schema type is derived from the file
+// extension: .avsc for avro, .json for json and .proto for protobuf.
+func (c *SchemaRegistryComponent) RegisterSchemaFromFile(subject string, path string) sr.SubjectSchema {
+	schema, err := new(schemaRegistryFactory).readSchema(schemaRegistrySchemaSettings{
+		Subject: subject,
+		Path:    path,
+	})
+	if err != nil {
+		c.failNow(err.Error(), "can not register schema from file %s for subject %s", path, subject)
+	}
+
+	return c.registry.Register(subject, schema)
+}
+
+// Subjects returns the names of all subjects known to the registry, including the ones registered by the application.
+func (c *SchemaRegistryComponent) Subjects() []string {
+	return c.registry.Subjects()
+}
+
+// LatestSchema returns the latest version of the subject or fails the test if the subject doesn't exist.
+func (c *SchemaRegistryComponent) LatestSchema(subject string) sr.SubjectSchema {
+	schemas := c.registry.Schemas(subject)
+	if len(schemas) == 0 {
+		c.failNow(fmt.Sprintf("there is no schema for subject %s", subject))
+	}
+
+	return schemas[len(schemas)-1]
+}
@@ -0,0 +1,58 @@
+package env
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/stretchr/testify/assert"
+)
+
+const sqliteVfs = "memdb"
+
+type sqliteComponent struct {
+	baseComponent
+	client   *sqlx.DB
+	database string
+}
+
+func (c *sqliteComponent) CfgOptions() []cfg.Option {
+	return []cfg.Option{
+		cfg.WithConfigMap(map[string]any{
+			"db": map[string]any{
+				c.name: map[string]any{
+					"uri.database":       c.database,
+					"parameters.vfs":     sqliteVfs,
+					"migrations.enabled": true,
+				},
+			},
+		}),
+	}
+}
+
+func (c *sqliteComponent) Client() *sqlx.DB {
+	return c.client
+}
+
+func (c *sqliteComponent) Exec(qry string, args ...any) {
+	_, err := c.client.Exec(qry, args...)
+	if err != nil {
+		assert.FailNow(c.t, err.Error(), "failed to execute query")
+
+		return
+	}
+}
+
+func (c *sqliteComponent) AssertRowCount(table string, expectedCount int) {
+	qry, args, err := squirrel.Select("COUNT(*)").From(table).ToSql()
+	if err != nil {
+		assert.FailNow(c.t, err.Error(), "can not generate qry to count rows in table %s", table)
+	}
+
+	var actualCount int
+	err = c.client.Get(&actualCount, qry, args...)
+	if err != nil {
+		assert.FailNow(c.t, err.Error(), "can not count rows in table %s", table)
+	}
+
+	assert.Equal(c.t, expectedCount, actualCount, "row count doesn't match for table %s", table)
+}
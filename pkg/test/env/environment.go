@@ -212,6 +212,10 @@ func (e *Environment) Kafka(name string) *KafkaComponent {
 	return e.Component(componentKafka, name).(*KafkaComponent)
 }
 
+func (e *Environment) SchemaRegistry(name string) *SchemaRegistryComponent {
+	return e.Component(componentSchemaRegistry, name).(*SchemaRegistryComponent)
+}
+
 func (e *Environment) Mailpit(name string) *mailpitComponent {
 	return e.Component(componentMailpit, name).(*mailpitComponent)
 }
@@ -224,6 +228,14 @@ func (e *Environment) MySql(name string) *mysqlComponent {
 	return e.Component(componentMySql, name).(*mysqlComponent)
 }
 
+func (e *Environment) Postgres(name string) *postgresComponent {
+	return e.Component(componentPostgres, name).(*postgresComponent)
+}
+
+func (e *Environment) Sqlite(name string) *sqliteComponent {
+	return e.Component(componentSqlite, name).(*sqliteComponent)
+}
+
 func (e *Environment) Otel(name string) *OtelComponent {
 	return e.Component(componentOtel, name).(*OtelComponent)
 }
@@ -0,0 +1,283 @@
+package env
+
+import (
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/uuid"
+	_ "github.com/lib/pq"
+)
+
+func init() {
+	componentFactories[componentPostgres] = new(postgresFactory)
+}
+
+const componentPostgres = "postgres"
+
+type postgresCredentials struct {
+	DatabaseName string `cfg:"database_name" default:"gosoline"`
+	UserName     string `cfg:"user_name"     default:"gosoline"`
+	UserPassword string `cfg:"user_password" default:"gosoline"`
+}
+
+type postgresSettings struct {
+	ComponentBaseSettings
+	ComponentContainerSettings
+	ContainerBindingSettings
+	Credentials          postgresCredentials `cfg:"credentials"`
+	UseExternalContainer bool                `cfg:"use_external_container" default:"false"`
+}
+
+type postgresFactory struct {
+	lck         sync.Mutex
+	connections map[string]*sqlx.DB
+}
+
+func (f *postgresFactory) Detect(config cfg.Config, manager *ComponentsConfigManager) error {
+	if !config.IsSet("db") {
+		return nil
+	}
+
+	if !manager.ShouldAutoDetect(componentPostgres) {
+		return nil
+	}
+
+	if has, err := manager.HasType(componentPostgres); err != nil {
+		return fmt.Errorf("failed to check if component exists: %w", err)
+	} else if has {
+		return nil
+	}
+
+	components, err := config.GetStringMap("db")
+	if err != nil {
+		return fmt.Errorf("can not get db components: %w", err)
+	}
+
+	for name := range components {
+		driver, err := config.Get(fmt.Sprintf("db.%s.driver", name))
+		if err != nil {
+			return fmt.Errorf("can not get driver for component %s: %w", name, err)
+		}
+
+		if driver != componentPostgres {
+			continue
+		}
+
+		settings := &postgresSettings{}
+		if err := UnmarshalSettings(config, settings, componentPostgres, "default"); err != nil {
+			return fmt.Errorf("can not unmarshal postgres settings for component %s: %w", name, err)
+		}
+		settings.Type = componentPostgres
+		settings.Name = name
+
+		if err := manager.Add(settings); err != nil {
+			return fmt.Errorf("can not add default postgres component: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (f *postgresFactory) GetSettingsSchema() ComponentBaseSettingsAware {
+	return &postgresSettings{}
+}
+
+func (f *postgresFactory) DescribeContainers(settings any) ComponentContainerDescriptions {
+	return ComponentContainerDescriptions{
+		"main": {
+			ContainerConfig:  f.configureContainer(settings),
+			HealthCheck:      f.healthCheck(settings),
+			ShutdownCallback: f.dropDatabase(settings),
+		},
+	}
+}
+
+func (f *postgresFactory) configureContainer(settings any) *ContainerConfig {
+	s := settings.(*postgresSettings)
+
+	if s.UseExternalContainer {
+		// When using an external instance we need to generate a new database name
+		// to avoid conflicts with other tests using the same external container
+		s.Credentials.DatabaseName = uuid.New().NewV4()
+
+		return &ContainerConfig{
+			RunnerType:   RunnerTypeExternal,
+			ExternalHost: s.Host,
+			PortBindings: PortBindings{
+				"main": {
+					ContainerPort: s.Port,
+					HostPort:      s.Port,
+					Protocol:      "tcp",
+				},
+			},
+		}
+	}
+
+	env := map[string]string{
+		"POSTGRES_DB":       s.Credentials.DatabaseName,
+		"POSTGRES_USER":     s.Credentials.UserName,
+		"POSTGRES_PASSWORD": s.Credentials.UserPassword,
+	}
+
+	if len(s.Tmpfs) == 0 {
+		s.Tmpfs = append(s.Tmpfs, TmpfsSettings{
+			Path: "/var/lib/postgresql/data",
+		})
+	}
+
+	return &ContainerConfig{
+		Auth:       s.Image.Auth,
+		Repository: s.Image.Repository,
+		Tag:        s.Image.Tag,
+		Tmpfs:      s.Tmpfs,
+		Env:        env,
+		Cmd:        []string{"-c", "fsync=off", "-c", "max_connections=1000"},
+		PortBindings: PortBindings{
+			"main": {
+				ContainerPort: 5432,
+				HostPort:      s.Port,
+				Protocol:      "tcp",
+			},
+		},
+	}
+}
+
+func (f *postgresFactory) healthCheck(settings any) ComponentHealthCheck {
+	return func(container *Container) error {
+		s := settings.(*postgresSettings)
+		binding := container.bindings["main"]
+
+		client, err := f.connection(s, binding)
+		if err != nil {
+			return fmt.Errorf("can not create client: %w", err)
+		}
+
+		return client.Ping()
+	}
+}
+
+func (f *postgresFactory) Component(_ cfg.Config, _ log.Logger, containers map[string]*Container, settings any) (Component, error) {
+	s := settings.(*postgresSettings)
+	binding := containers["main"].bindings["main"]
+
+	con, err := f.connection(s, binding)
+	if err != nil {
+		return nil, fmt.Errorf("can not create client: %w", err)
+	}
+
+	component := &postgresComponent{
+		baseComponent: baseComponent{
+			name: s.Name,
+		},
+		client:      con,
+		credentials: s.Credentials,
+		binding:     binding,
+	}
+
+	return component, nil
+}
+
+func (f *postgresFactory) connection(settings *postgresSettings, binding ContainerBinding) (*sqlx.DB, error) {
+	dsn := f.dsn(settings, binding, settings.Credentials.DatabaseName)
+
+	f.lck.Lock()
+	defer f.lck.Unlock()
+
+	if f.connections == nil {
+		f.connections = make(map[string]*sqlx.DB)
+	}
+
+	if _, ok := f.connections[dsn]; !ok {
+		if err := f.setup(settings, binding); err != nil {
+			return nil, fmt.Errorf("can not prepare database: %w", err)
+		}
+
+		client, err := sqlx.Open("postgres", dsn)
+		if err != nil {
+			return nil, fmt.Errorf("can not create client: %w", err)
+		}
+
+		f.connections[dsn] = client
+	}
+
+	return f.connections[dsn], nil
+}
+
+// setup creates the test database if it doesn't exist yet, which is the case for external containers.
+func (f *postgresFactory) setup(settings *postgresSettings, binding ContainerBinding) error {
+	client, err := sqlx.Open("postgres", f.dsn(settings, binding, "postgres"))
+	if err != nil {
+		return fmt.Errorf("can not create maintenance client: %w", err)
+	}
+
+	var exists bool
+	if err = client.Get(&exists, "SELECT EXISTS(SELECT 1 FROM pg_database WHERE datname = $1)", settings.Credentials.DatabaseName); err != nil {
+		return fmt.Errorf("can not check if database exists: %w", err)
+	}
+
+	if !exists {
+		createDB := fmt.Sprintf(`CREATE DATABASE "%s"`, settings.Credentials.DatabaseName)
+
+		if _, err = client.Exec(createDB); err != nil {
+			return fmt.Errorf("can not create database: %w", err)
+		}
+	}
+
+	if err = client.Close(); err != nil {
+		return fmt.Errorf("can not close connection: %w", err)
+	}
+
+	return nil
+}
+
+func (f *postgresFactory) dropDatabase(settings any) ComponentShutdownCallback {
+	return func(container *Container) func() error {
+		return func() error {
+			s := settings.(*postgresSettings)
+			binding := container.bindings["main"]
+
+			f.lck.Lock()
+			defer f.lck.Unlock()
+
+			// postgres doesn't allow to drop the database we are connected to, so we close our connection
+			// and drop it from the maintenance database instead
+			dsn := f.dsn(s, binding, s.Credentials.DatabaseName)
+			if con, ok := f.connections[dsn]; ok {
+				if err := con.Close(); err != nil {
+					return fmt.Errorf("can not close connection: %w", err)
+				}
+
+				delete(f.connections, dsn)
+			}
+
+			client, err := sqlx.Open("postgres", f.dsn(s, binding, "postgres"))
+			if err != nil {
+				return fmt.Errorf("can not connect to maintenance database: %w", err)
+			}
+
+			dropDatabase := fmt.Sprintf(`DROP DATABASE IF EXISTS "%s" WITH (FORCE)`, s.Credentials.DatabaseName)
+
+			if _, err = client.Exec(dropDatabase); err != nil {
+				return fmt.Errorf("can not drop database: %w", err)
+			}
+
+			return client.Close()
+		}
+	}
+}
+
+func (f *postgresFactory) dsn(settings *postgresSettings, binding ContainerBinding, database string) string {
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(settings.Credentials.UserName, settings.Credentials.UserPassword),
+		Host:     fmt.Sprintf("%s:%v", binding.host, binding.port),
+		Path:     database,
+		RawQuery: "sslmode=disable",
+	}
+
+	return dsn.String()
+}
@@ -0,0 +1,156 @@
+package env
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	schemaRegistry "github.com/justtrackio/gosoline/pkg/kafka/schema-registry"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/twmb/franz-go/pkg/sr"
+)
+
+func init() {
+	componentFactories[componentSchemaRegistry] = new(schemaRegistryFactory)
+}
+
+const componentSchemaRegistry = "schemaRegistry"
+
+type schemaRegistrySettings struct {
+	ComponentBaseSettings
+	Schemas []schemaRegistrySchemaSettings `cfg:"schemas"`
+}
+
+type schemaRegistrySchemaSettings struct {
+	Subject string `cfg:"subject" validate:"required"`
+	Path    string `cfg:"path" validate:"required"`
+	// Type is one of avro, json or protobuf. If empty, it is derived from the file extension: .avsc for avro, .json for
+	// json and .proto for protobuf.
+	Type string `cfg:"type"`
+}
+
+type schemaRegistryFactory struct{}
+
+var _ componentFactory = &schemaRegistryFactory{}
+
+// Detect adds a fake schema registry for every kafka connection with a schema registry address, as long as there is
+// no kafka component which provides a real schema registry.
+func (f *schemaRegistryFactory) Detect(config cfg.Config, manager *ComponentsConfigManager) error {
+	if !manager.ShouldAutoDetect(componentSchemaRegistry) {
+		return nil
+	}
+
+	for _, typ := range []string{componentSchemaRegistry, componentKafka} {
+		if has, err := manager.HasType(typ); err != nil {
+			return fmt.Errorf("failed to check if component exists: %w", err)
+		} else if has {
+			return nil
+		}
+	}
+
+	connections, err := config.GetStringMap("kafka.connection", map[string]any{})
+	if err != nil {
+		return fmt.Errorf("can not get kafka connections: %w", err)
+	}
+
+	for name := range connections {
+		if !config.IsSet(fmt.Sprintf("kafka.connection.%s.schema_registry_address", name)) {
+			continue
+		}
+
+		settings := &schemaRegistrySettings{}
+		if err := UnmarshalSettings(config, settings, componentSchemaRegistry, name); err != nil {
+			return fmt.Errorf("can not unmarshal schema registry settings for connection %s: %w", name, err)
+		}
+
+		if err := manager.Add(settings); err != nil {
+			return fmt.Errorf("can not add schema registry for connection %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (f *schemaRegistryFactory) GetSettingsSchema() ComponentBaseSettingsAware {
+	return &schemaRegistrySettings{}
+}
+
+func (f *schemaRegistryFactory) DescribeContainers(_ any) ComponentContainerDescriptions {
+	return nil
+}
+
+func (f *schemaRegistryFactory) Component(_ cfg.Config, _ log.Logger, _ map[string]*Container, settings any) (Component, error) {
+	s := settings.(*schemaRegistrySettings)
+	registry := NewFakeSchemaRegistry()
+
+	for _, schemaSettings := range s.Schemas {
+		schema, err := f.readSchema(schemaSettings)
+		if err != nil {
+			return nil, fmt.Errorf("can not read schema of subject %s: %w", schemaSettings.Subject, err)
+		}
+
+		registry.Register(schemaSettings.Subject, schema)
+	}
+
+	server := httptest.NewServer(registry)
+
+	client, err := schemaRegistry.NewClient(server.URL)
+	if err != nil {
+		server.Close()
+
+		return nil, fmt.Errorf("failed to create schema registry client: %w", err)
+	}
+
+	return &SchemaRegistryComponent{
+		connection: s.Name,
+		registry:   registry,
+		server:     server,
+		client:     client,
+	}, nil
+}
+
+func (f *schemaRegistryFactory) readSchema(settings schemaRegistrySchemaSettings) (sr.Schema, error) {
+	schemaType, err := schemaTypeOf(settings.Type, settings.Path)
+	if err != nil {
+		return sr.Schema{}, err
+	}
+
+	bytes, err := os.ReadFile(settings.Path)
+	if err != nil {
+		return sr.Schema{}, fmt.Errorf("can not read schema file: %w", err)
+	}
+
+	return sr.Schema{
+		Schema: string(bytes),
+		Type:   schemaType,
+	}, nil
+}
+
+func schemaTypeOf(typ string, path string) (sr.SchemaType, error) {
+	if typ == "" {
+		switch filepath.Ext(path) {
+		case ".avsc":
+			typ = string(schemaRegistry.Avro)
+		case ".json":
+			typ = string(schemaRegistry.Json)
+		case ".proto":
+			typ = string(schemaRegistry.Protobuf)
+		default:
+			return 0, fmt.Errorf("can not derive the schema type from the extension of %s, please set the type", path)
+		}
+	}
+
+	switch schemaRegistry.SchemaType(strings.ToLower(typ)) {
+	case schemaRegistry.Avro:
+		return sr.TypeAvro, nil
+	case schemaRegistry.Json:
+		return sr.TypeJSON, nil
+	case schemaRegistry.Protobuf:
+		return sr.TypeProtobuf, nil
+	default:
+		return 0, fmt.Errorf("unknown schema type %s", typ)
+	}
+}
@@ -0,0 +1,113 @@
+package env
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/justtrackio/gosoline/pkg/cfg"
+	"github.com/justtrackio/gosoline/pkg/db"
+	"github.com/justtrackio/gosoline/pkg/log"
+	"github.com/justtrackio/gosoline/pkg/uuid"
+)
+
+func init() {
+	componentFactories[componentSqlite] = new(sqliteFactory)
+}
+
+const componentSqlite = "sqlite"
+
+type sqliteSettings struct {
+	ComponentBaseSettings
+}
+
+// sqliteFactory provides in-memory sqlite databases, so no container is needed. The database lives as long as
+// there is a connection to it, which is held by the component.
+type sqliteFactory struct{}
+
+func (f *sqliteFactory) Detect(config cfg.Config, manager *ComponentsConfigManager) error {
+	if !config.IsSet("db") {
+		return nil
+	}
+
+	if !manager.ShouldAutoDetect(componentSqlite) {
+		return nil
+	}
+
+	if has, err := manager.HasType(componentSqlite); err != nil {
+		return fmt.Errorf("failed to check if component exists: %w", err)
+	} else if has {
+		return nil
+	}
+
+	components, err := config.GetStringMap("db")
+	if err != nil {
+		return fmt.Errorf("can not get db components: %w", err)
+	}
+
+	for name := range components {
+		driver, err := config.Get(fmt.Sprintf("db.%s.driver", name))
+		if err != nil {
+			return fmt.Errorf("can not get driver for component %s: %w", name, err)
+		}
+
+		if driver != db.DriverSqlite {
+			continue
+		}
+
+		settings := &sqliteSettings{}
+		if err := UnmarshalSettings(config, settings, componentSqlite, "default"); err != nil {
+			return fmt.Errorf("can not unmarshal sqlite settings for component %s: %w", name, err)
+		}
+		settings.Type = componentSqlite
+		settings.Name = name
+
+		if err := manager.Add(settings); err != nil {
+			return fmt.Errorf("can not add default sqlite component: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (f *sqliteFactory) GetSettingsSchema() ComponentBaseSettingsAware {
+	return &sqliteSettings{}
+}
+
+func (f *sqliteFactory) DescribeContainers(_ any) ComponentContainerDescriptions {
+	return nil
+}
+
+func (f *sqliteFactory) Component(_ cfg.Config, _ log.Logger, _ map[string]*Container, settings any) (Component, error) {
+	s := settings.(*sqliteSettings)
+
+	// every component gets its own database, the leading slash makes the memdb vfs share it between connections
+	database := fmt.Sprintf("/%s", uuid.New().NewV4())
+	dsn := fmt.Sprintf("file:%s?%s", database, url.Values{
+		"vfs":     {sqliteVfs},
+		"_pragma": {"foreign_keys(1)"},
+	}.Encode())
+
+	client, err := sqlx.Open(db.DriverSqlite, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("can not create client: %w", err)
+	}
+
+	// the database is gone as soon as the last connection is closed
+	client.SetConnMaxIdleTime(0)
+	client.SetConnMaxLifetime(0)
+
+	if err = client.Ping(); err != nil {
+		return nil, fmt.Errorf("can not open in-memory database: %w", err)
+	}
+
+	component := &sqliteComponent{
+		baseComponent: baseComponent{
+			name: s.Name,
+		},
+		client:   client,
+		database: database,
+	}
+
+	return component, nil
+}
@@ -0,0 +1,380 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/justtrackio/gosoline/pkg/encoding/json"
+	"github.com/twmb/franz-go/pkg/sr"
+)
+
+// FakeSchemaRegistry is an in memory implementation of the subset of the confluent schema registry api which is used
+// by the schema registry client of the kafka package. It allows testing schema registry aware inputs and outputs
+// without running a registry container. Schemas are not checked for compatibility, every schema is reported to be
+// compatible with the versions of an existing subject.
+type FakeSchemaRegistry struct {
+	lck      sync.Mutex
+	mux      *http.ServeMux
+	schemas  []sr.Schema
+	subjects map[string][]int
+}
+
+type fakeSchemaRegistryError struct {
+	status int
+	err    *sr.Error
+}
+
+func NewFakeSchemaRegistry() *FakeSchemaRegistry {
+	registry := &FakeSchemaRegistry{
+		mux:      http.NewServeMux(),
+		schemas:  make([]sr.Schema, 0),
+		subjects: make(map[string][]int),
+	}
+
+	registry.mux.HandleFunc("GET /config", registry.handleConfig)
+	registry.mux.HandleFunc("GET /subjects", registry.handleSubjects)
+	registry.mux.HandleFunc("POST /subjects/{subject}", registry.handleLookupSchema)
+	registry.mux.HandleFunc("POST /subjects/{subject}/versions", registry.handleCreateSchema)
+	registry.mux.HandleFunc("GET /subjects/{subject}/versions/{version}", registry.handleSchemaByVersion)
+	registry.mux.HandleFunc("GET /schemas/ids/{id}", registry.handleSchemaById)
+	registry.mux.HandleFunc("GET /schemas/ids/{id}/versions", registry.handleSchemaUsagesById)
+	registry.mux.HandleFunc("POST /compatibility/subjects/{subject}/versions/{version}", registry.handleCheckCompatibility)
+
+	return registry
+}
+
+func (r *FakeSchemaRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mux.ServeHTTP(w, req)
+}
+
+// Register adds the schema to the subject like the registry would do it for a client. Registering a schema which is
+// already part of the subject returns the existing version.
+func (r *FakeSchemaRegistry) Register(subject string, schema sr.Schema) sr.SubjectSchema {
+	r.lck.Lock()
+	defer r.lck.Unlock()
+
+	if subjectSchema, ok := r.lookup(subject, schema); ok {
+		return subjectSchema
+	}
+
+	id, ok := r.findSchema(schema)
+	if !ok {
+		r.schemas = append(r.schemas, schema)
+		id = len(r.schemas)
+	}
+
+	r.subjects[subject] = append(r.subjects[subject], id)
+
+	return r.subjectSchema(subject, len(r.subjects[subject]))
+}
+
+// Subjects returns the sorted names of all subjects with at least one registered schema.
+func (r *FakeSchemaRegistry) Subjects() []string {
+	r.lck.Lock()
+	defer r.lck.Unlock()
+
+	subjects := make([]string, 0, len(r.subjects))
+	for subject := range r.subjects {
+		subjects = append(subjects, subject)
+	}
+
+	slices.Sort(subjects)
+
+	return subjects
+}
+
+// Schemas returns all versions of the subject, the oldest version first.
+func (r *FakeSchemaRegistry) Schemas(subject string) []sr.SubjectSchema {
+	r.lck.Lock()
+	defer r.lck.Unlock()
+
+	schemas := make([]sr.SubjectSchema, 0, len(r.subjects[subject]))
+	for version := range r.subjects[subject] {
+		schemas = append(schemas, r.subjectSchema(subject, version+1))
+	}
+
+	return schemas
+}
+
+func (r *FakeSchemaRegistry) handleConfig(w http.ResponseWriter, _ *http.Request) {
+	r.writeJson(w, map[string]string{
+		"compatibilityLevel": sr.CompatBackward.String(),
+	})
+}
+
+func (r *FakeSchemaRegistry) handleSubjects(w http.ResponseWriter, _ *http.Request) {
+	r.writeJson(w, r.Subjects())
+}
+
+func (r *FakeSchemaRegistry) handleLookupSchema(w http.ResponseWriter, req *http.Request) {
+	subject := req.PathValue("subject")
+
+	schema, err := r.readSchema(req)
+	if err != nil {
+		r.writeError(w, err)
+
+		return
+	}
+
+	r.lck.Lock()
+	defer r.lck.Unlock()
+
+	if _, ok := r.subjects[subject]; !ok {
+		r.writeError(w, r.notFound(sr.ErrSubjectNotFound))
+
+		return
+	}
+
+	subjectSchema, ok := r.lookup(subject, schema)
+	if !ok {
+		r.writeError(w, r.notFound(sr.ErrSchemaNotFound))
+
+		return
+	}
+
+	r.writeJson(w, subjectSchema)
+}
+
+func (r *FakeSchemaRegistry) handleCreateSchema(w http.ResponseWriter, req *http.Request) {
+	schema, err := r.readSchema(req)
+	if err != nil {
+		r.writeError(w, err)
+
+		return
+	}
+
+	subjectSchema := r.Register(req.PathValue("subject"), schema)
+
+	r.writeJson(w, map[string]int{
+		"id": subjectSchema.ID,
+	})
+}
+
+func (r *FakeSchemaRegistry) handleSchemaByVersion(w http.ResponseWriter, req *http.Request) {
+	subject := req.PathValue("subject")
+
+	r.lck.Lock()
+	defer r.lck.Unlock()
+
+	version, err := r.version(subject, req.PathValue("version"))
+	if err != nil {
+		r.writeError(w, err)
+
+		return
+	}
+
+	r.writeJson(w, r.subjectSchema(subject, version))
+}
+
+func (r *FakeSchemaRegistry) handleSchemaById(w http.ResponseWriter, req *http.Request) {
+	r.lck.Lock()
+	defer r.lck.Unlock()
+
+	id, err := r.id(req.PathValue("id"))
+	if err != nil {
+		r.writeError(w, err)
+
+		return
+	}
+
+	r.writeJson(w, r.schemas[id-1])
+}
+
+func (r *FakeSchemaRegistry) handleSchemaUsagesById(w http.ResponseWriter, req *http.Request) {
+	type subjectVersion struct {
+		Subject string `json:"subject"`
+		Version int    `json:"version"`
+	}
+
+	r.lck.Lock()
+	defer r.lck.Unlock()
+
+	id, err := r.id(req.PathValue("id"))
+	if err != nil {
+		r.writeError(w, err)
+
+		return
+	}
+
+	usages := make([]subjectVersion, 0)
+	for subject, ids := range r.subjects {
+		for i, schemaId := range ids {
+			if schemaId == id {
+				usages = append(usages, subjectVersion{Subject: subject, Version: i + 1})
+			}
+		}
+	}
+
+	r.writeJson(w, usages)
+}
+
+func (r *FakeSchemaRegistry) handleCheckCompatibility(w http.ResponseWriter, req *http.Request) {
+	subject := req.PathValue("subject")
+
+	if _, err := r.readSchema(req); err != nil {
+		r.writeError(w, err)
+
+		return
+	}
+
+	r.lck.Lock()
+	defer r.lck.Unlock()
+
+	if _, err := r.version(subject, req.PathValue("version")); err != nil {
+		r.writeError(w, err)
+
+		return
+	}
+
+	r.writeJson(w, sr.CheckCompatibilityResult{
+		Is: true,
+	})
+}
+
+func (r *FakeSchemaRegistry) readSchema(req *http.Request) (sr.Schema, *fakeSchemaRegistryError) {
+	schema := sr.Schema{}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return schema, r.invalidSchema(fmt.Errorf("can not read request body: %w", err))
+	}
+
+	if err = json.Unmarshal(body, &schema); err != nil {
+		return schema, r.invalidSchema(fmt.Errorf("can not decode schema: %w", err))
+	}
+
+	if schema.Schema == "" {
+		return schema, r.invalidSchema(errors.New("the schema is empty"))
+	}
+
+	return schema, nil
+}
+
+func (r *FakeSchemaRegistry) lookup(subject string, schema sr.Schema) (sr.SubjectSchema, bool) {
+	for i, id := range r.subjects[subject] {
+		if r.equal(r.schemas[id-1], schema) {
+			return r.subjectSchema(subject, i+1), true
+		}
+	}
+
+	return sr.SubjectSchema{}, false
+}
+
+func (r *FakeSchemaRegistry) findSchema(schema sr.Schema) (int, bool) {
+	for i, existing := range r.schemas {
+		if r.equal(existing, schema) {
+			return i + 1, true
+		}
+	}
+
+	return 0, false
+}
+
+func (r *FakeSchemaRegistry) subjectSchema(subject string, version int) sr.SubjectSchema {
+	id := r.subjects[subject][version-1]
+
+	return sr.SubjectSchema{
+		Subject: subject,
+		Version: version,
+		ID:      id,
+		Schema:  r.schemas[id-1],
+	}
+}
+
+func (r *FakeSchemaRegistry) version(subject string, version string) (int, *fakeSchemaRegistryError) {
+	versions, ok := r.subjects[subject]
+	if !ok {
+		return 0, r.notFound(sr.ErrSubjectNotFound)
+	}
+
+	if version == "latest" || version == "-1" {
+		return len(versions), nil
+	}
+
+	parsed, err := strconv.Atoi(version)
+	if err != nil || parsed < 1 || parsed > len(versions) {
+		return 0, r.notFound(sr.ErrVersionNotFound)
+	}
+
+	return parsed, nil
+}
+
+func (r *FakeSchemaRegistry) id(id string) (int, *fakeSchemaRegistryError) {
+	parsed, err := strconv.Atoi(id)
+	if err != nil || parsed < 1 || parsed > len(r.schemas) {
+		return 0, r.notFound(sr.ErrSchemaNotFound)
+	}
+
+	return parsed, nil
+}
+
+// equal compares the schemas ignoring formatting and the order of json keys, the real registry compares the
+// canonical forms of the schemas instead.
+func (r *FakeSchemaRegistry) equal(a sr.Schema, b sr.Schema) bool {
+	return a.Type == b.Type && r.canonical(a.Schema) == r.canonical(b.Schema) && slices.Equal(a.References, b.References)
+}
+
+func (r *FakeSchemaRegistry) canonical(schema string) string {
+	var document any
+
+	if err := json.Unmarshal([]byte(schema), &document); err != nil {
+		// not a json document, so this is a protobuf schema
+		return strings.TrimSpace(schema)
+	}
+
+	canonical, err := json.Marshal(document)
+	if err != nil {
+		return strings.TrimSpace(schema)
+	}
+
+	return string(canonical)
+}
+
+func (r *FakeSchemaRegistry) notFound(err *sr.Error) *fakeSchemaRegistryError {
+	return &fakeSchemaRegistryError{
+		status: http.StatusNotFound,
+		err:    err,
+	}
+}
+
+func (r *FakeSchemaRegistry) invalidSchema(err error) *fakeSchemaRegistryError {
+	return &fakeSchemaRegistryError{
+		status: http.StatusUnprocessableEntity,
+		err: &sr.Error{
+			Code:        sr.ErrInvalidSchema.Code,
+			Name:        sr.ErrInvalidSchema.Name,
+			Description: err.Error(),
+		},
+	}
+}
+
+func (r *FakeSchemaRegistry) writeError(w http.ResponseWriter, err *fakeSchemaRegistryError) {
+	r.writeJsonWithStatus(w, err.status, sr.ResponseError{
+		ErrorCode: err.err.Code,
+		Message:   err.err.Description,
+	})
+}
+
+func (r *FakeSchemaRegistry) writeJson(w http.ResponseWriter, body any) {
+	r.writeJsonWithStatus(w, http.StatusOK, body)
+}
+
+func (r *FakeSchemaRegistry) writeJsonWithStatus(w http.ResponseWriter, status int, body any) {
+	bytes, err := json.Marshal(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	w.WriteHeader(status)
+
+	_, _ = w.Write(bytes)
+}
@@ -0,0 +1,84 @@
+package env_test
+
+import (
+	"testing"
+
+	"github.com/justtrackio/gosoline/pkg/exec"
+	"github.com/justtrackio/gosoline/pkg/kafka/connection"
+	schemaRegistry "github.com/justtrackio/gosoline/pkg/kafka/schema-registry"
+	"github.com/justtrackio/gosoline/pkg/test/env"
+	"github.com/stretchr/testify/suite"
+	"github.com/twmb/franz-go/pkg/sr"
+)
+
+const userSchema = `{"type":"record","namespace":"com.example","name":"User","fields":[{"name":"id","type":"int"},{"name":"name","type":"string"}]}`
+
+type SchemaRegistryTestSuite struct {
+	suite.Suite
+	environment *env.Environment
+	component   *env.SchemaRegistryComponent
+	service     schemaRegistry.Service
+}
+
+func TestSchemaRegistryTestSuite(t *testing.T) {
+	suite.Run(t, new(SchemaRegistryTestSuite))
+}
+
+func (s *SchemaRegistryTestSuite) SetupTest() {
+	var err error
+
+	s.environment, err = env.NewEnvironment(s.T(), env.WithConfigFile("testdata/config.schema_registry.yml"))
+	s.Require().NoError(err)
+
+	s.component = s.environment.SchemaRegistry("default")
+	s.service = schemaRegistry.NewServiceWithInterfaces(s.component.Client(), exec.NewDefaultExecutor())
+}
+
+func (s *SchemaRegistryTestSuite) TestConfig() {
+	settings, err := connection.ParseSettings(s.environment.Config(), "default")
+	s.NoError(err)
+	s.Equal(s.component.Address(), settings.SchemaRegistryAddress)
+}
+
+func (s *SchemaRegistryTestSuite) TestSchemaFromTestdata() {
+	id, err := s.service.GetSubjectSchemaId(s.T().Context(), "users-value", userSchema, schemaRegistry.Avro)
+	s.NoError(err)
+	s.Equal(1, id)
+
+	s.Equal([]string{"users-value"}, s.component.Subjects())
+}
+
+func (s *SchemaRegistryTestSuite) TestGetOrCreateSubjectSchemaId() {
+	jsonSchema := `{"title":"com.example.Order","type":"object","properties":{"id":{"type":"integer"}}}`
+
+	id, err := s.service.GetOrCreateSubjectSchemaId(s.T().Context(), "orders-value", jsonSchema, schemaRegistry.Json)
+	s.NoError(err)
+	s.Equal(2, id)
+
+	// the same schema registered for another subject keeps its id
+	id, err = s.service.GetOrCreateSubjectSchemaId(s.T().Context(), "com.example.Order", jsonSchema, schemaRegistry.Json)
+	s.NoError(err)
+	s.Equal(2, id)
+
+	latest := s.component.LatestSchema("orders-value")
+	s.Equal(1, latest.Version)
+	s.Equal(sr.TypeJSON, latest.Type)
+	s.Equal([]string{"com.example.Order", "orders-value", "users-value"}, s.component.Subjects())
+}
+
+func (s *SchemaRegistryTestSuite) TestGetSubjectSchemaIdUnknownSubject() {
+	_, err := s.service.GetSubjectSchemaId(s.T().Context(), "unknown-value", userSchema, schemaRegistry.Avro)
+	s.EqualError(err, "failed to lookup subject schema: Subject does not exist")
+}
+
+func (s *SchemaRegistryTestSuite) TestCheckCompatibility() {
+	s.component.RegisterSchema("users-value", schemaRegistry.Avro, `{"type":"record","namespace":"com.example","name":"User","fields":[{"name":"id","type":"int"}]}`)
+
+	err := s.service.CheckCompatibility(s.T().Context(), "users-value", userSchema, schemaRegistry.Avro)
+	s.NoError(err)
+
+	err = s.service.CheckCompatibility(s.T().Context(), "unknown-value", userSchema, schemaRegistry.Avro)
+	s.NoError(err)
+
+	s.Len(s.component.Registry().Schemas("users-value"), 2)
+}
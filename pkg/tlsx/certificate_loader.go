@@ -0,0 +1,110 @@
+package tlsx
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+// certificateLoader provides the certificate for tls handshakes. Certificates read from files are checked for changes
+// at most once per reload interval, so rotated certificates are used without restarting the server.
+type certificateLoader struct {
+	logger   log.Logger
+	clock    clock.Clock
+	settings Settings
+
+	lck         sync.Mutex
+	certificate *tls.Certificate
+	certPem     []byte
+	keyPem      []byte
+	checkedAt   time.Time
+}
+
+func newCertificateLoader(logger log.Logger, clock clock.Clock, settings Settings) (*certificateLoader, error) {
+	loader := &certificateLoader{
+		logger:   logger,
+		clock:    clock,
+		settings: settings,
+	}
+
+	switch {
+	case settings.CertFile != "" || settings.KeyFile != "":
+		if settings.CertFile == "" || settings.KeyFile == "" {
+			return nil, fmt.Errorf("the cert file and the key file have to be configured together")
+		}
+
+		if _, err := loader.reload(); err != nil {
+			return nil, err
+		}
+	case settings.Cert != "" && settings.Key != "":
+		certificate, err := tls.X509KeyPair([]byte(settings.Cert), []byte(settings.Key))
+		if err != nil {
+			return nil, fmt.Errorf("can not parse tls certificate: %w", err)
+		}
+
+		loader.certificate = &certificate
+	default:
+		return nil, fmt.Errorf("tls requires either a cert and key file or a PEM encoded cert and key")
+	}
+
+	return loader, nil
+}
+
+func (l *certificateLoader) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	l.lck.Lock()
+	defer l.lck.Unlock()
+
+	if l.settings.CertFile == "" || l.settings.ReloadInterval == 0 || l.clock.Since(l.checkedAt) < l.settings.ReloadInterval {
+		return l.certificate, nil
+	}
+
+	reloaded, err := l.reload()
+	if err != nil {
+		// keep serving the old certificate, the files might be in the middle of getting replaced
+		l.logger.Warn(context.Background(), "can not reload tls certificate: %s", err)
+
+		return l.certificate, nil
+	}
+
+	if reloaded {
+		l.logger.Info(context.Background(), "reloaded tls certificate from %s", l.settings.CertFile)
+	}
+
+	return l.certificate, nil
+}
+
+func (l *certificateLoader) reload() (bool, error) {
+	l.checkedAt = l.clock.Now()
+
+	certPem, err := os.ReadFile(l.settings.CertFile)
+	if err != nil {
+		return false, fmt.Errorf("can not read tls cert file: %w", err)
+	}
+
+	keyPem, err := os.ReadFile(l.settings.KeyFile)
+	if err != nil {
+		return false, fmt.Errorf("can not read tls key file: %w", err)
+	}
+
+	if bytes.Equal(certPem, l.certPem) && bytes.Equal(keyPem, l.keyPem) {
+		return false, nil
+	}
+
+	certificate, err := tls.X509KeyPair(certPem, keyPem)
+	if err != nil {
+		return false, fmt.Errorf("can not parse tls certificate: %w", err)
+	}
+
+	l.certificate = &certificate
+	l.certPem = certPem
+	l.keyPem = keyPem
+
+	return true, nil
+}
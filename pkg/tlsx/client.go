@@ -0,0 +1,108 @@
+package tlsx
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+// ClientSettings configure the tls of connections to a server. The server certificate is verified against the
+// configured certificate authorities or the system pool. A client certificate for mutual tls is optional.
+type ClientSettings struct {
+	Enabled bool `cfg:"enabled" default:"false"`
+	// ServerName is the name the server certificate is verified for. It defaults to the host of the dialed address.
+	ServerName string `cfg:"server_name"`
+	// InsecureSkipVerify disables the verification of the server certificate. Only use it for local development.
+	InsecureSkipVerify bool `cfg:"insecure_skip_verify" default:"false"`
+	// CaFile and Ca are the PEM encoded certificate authorities the server certificate is verified against.
+	CaFile string `cfg:"ca_file"`
+	Ca     string `cfg:"ca"`
+	// CertFile and KeyFile or Cert and Key are the PEM encoded client certificate (chain) and private key.
+	CertFile string `cfg:"cert_file"`
+	KeyFile  string `cfg:"key_file"`
+	Cert     string `cfg:"cert"`
+	Key      string `cfg:"key"`
+	// MinVersion is the minimum accepted tls version.
+	MinVersion string `cfg:"min_version" default:"tls1.2" validate:"oneof=tls1.0 tls1.1 tls1.2 tls1.3"`
+	// ReloadInterval is the minimum time between two checks of the client certificate files for changes.
+	ReloadInterval time.Duration `cfg:"reload_interval" default:"1m" validate:"min=0"`
+}
+
+// NewClientConfig creates the client side tls config of the settings. It returns nil if tls is not enabled.
+func NewClientConfig(logger log.Logger, settings ClientSettings) (*tls.Config, error) {
+	return NewClientConfigWithInterfaces(logger, clock.Provider, settings)
+}
+
+func NewClientConfigWithInterfaces(logger log.Logger, clock clock.Clock, settings ClientSettings) (*tls.Config, error) {
+	if !settings.Enabled {
+		return nil, nil
+	}
+
+	var err error
+	var minVersion uint16
+	var rootCas *x509.CertPool
+
+	if minVersion, err = parseVersion(settings.MinVersion); err != nil {
+		return nil, err
+	}
+
+	if rootCas, err = parseRootCas(settings); err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{
+		ServerName:         settings.ServerName,
+		InsecureSkipVerify: settings.InsecureSkipVerify, //nolint:gosec // opt-in via explicit config
+		MinVersion:         minVersion,
+		RootCAs:            rootCas,
+	}
+
+	if settings.CertFile == "" && settings.KeyFile == "" && settings.Cert == "" && settings.Key == "" {
+		return config, nil
+	}
+
+	loader, err := newCertificateLoader(logger, clock, Settings{
+		CertFile:       settings.CertFile,
+		KeyFile:        settings.KeyFile,
+		Cert:           settings.Cert,
+		Key:            settings.Key,
+		ReloadInterval: settings.ReloadInterval,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can not load client certificate: %w", err)
+	}
+
+	config.GetClientCertificate = func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		return loader.GetCertificate(nil)
+	}
+
+	return config, nil
+}
+
+func parseRootCas(settings ClientSettings) (*x509.CertPool, error) {
+	ca := []byte(settings.Ca)
+	if settings.CaFile != "" {
+		var err error
+
+		if ca, err = os.ReadFile(settings.CaFile); err != nil {
+			return nil, fmt.Errorf("can not read ca file: %w", err)
+		}
+	}
+
+	// use the system pool
+	if len(ca) == 0 {
+		return nil, nil
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("the ca does not contain any PEM encoded certificate")
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,64 @@
+package tlsx_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	"github.com/justtrackio/gosoline/pkg/tlsx"
+)
+
+func (s *ConfigTestSuite) TestClientConfigDisabled() {
+	config, err := tlsx.NewClientConfigWithInterfaces(s.logger, s.clock, tlsx.ClientSettings{})
+	s.NoError(err)
+	s.Nil(config)
+}
+
+func (s *ConfigTestSuite) TestClientConfig() {
+	caPem, _ := s.certificate("ca")
+	s.writeCertificate("client")
+
+	config, err := tlsx.NewClientConfigWithInterfaces(s.logger, s.clock, tlsx.ClientSettings{
+		Enabled:    true,
+		ServerName: "redis.local",
+		Ca:         string(caPem),
+		CertFile:   s.certFile,
+		KeyFile:    s.keyFile,
+		MinVersion: "tls1.3",
+	})
+	s.Require().NoError(err)
+	s.Equal("redis.local", config.ServerName)
+	s.Equal(uint16(tls.VersionTLS13), config.MinVersion)
+	s.False(config.InsecureSkipVerify)
+	s.NotNil(config.RootCAs)
+
+	certificate, err := config.GetClientCertificate(&tls.CertificateRequestInfo{})
+	s.Require().NoError(err)
+
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	s.Require().NoError(err)
+	s.Equal("client", leaf.Subject.CommonName)
+}
+
+func (s *ConfigTestSuite) TestClientConfigSystemPool() {
+	config, err := tlsx.NewClientConfigWithInterfaces(s.logger, s.clock, tlsx.ClientSettings{
+		Enabled: true,
+	})
+	s.Require().NoError(err)
+	s.Nil(config.RootCAs)
+	s.Nil(config.GetClientCertificate)
+	s.Equal(uint16(tls.VersionTLS12), config.MinVersion)
+}
+
+func (s *ConfigTestSuite) TestClientConfigInvalid() {
+	_, err := tlsx.NewClientConfigWithInterfaces(s.logger, s.clock, tlsx.ClientSettings{
+		Enabled: true,
+		Ca:      "not a certificate",
+	})
+	s.EqualError(err, "the ca does not contain any PEM encoded certificate")
+
+	_, err = tlsx.NewClientConfigWithInterfaces(s.logger, s.clock, tlsx.ClientSettings{
+		Enabled:  true,
+		CertFile: s.certFile,
+	})
+	s.EqualError(err, "can not load client certificate: the cert file and the key file have to be configured together")
+}
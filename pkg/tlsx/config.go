@@ -0,0 +1,138 @@
+package tlsx
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	"github.com/justtrackio/gosoline/pkg/log"
+)
+
+var (
+	versions = map[string]uint16{
+		"tls1.0": tls.VersionTLS10,
+		"tls1.1": tls.VersionTLS11,
+		"tls1.2": tls.VersionTLS12,
+		"tls1.3": tls.VersionTLS13,
+	}
+	clientAuthTypes = map[string]tls.ClientAuthType{
+		ClientAuthNone:             tls.NoClientCert,
+		ClientAuthRequest:          tls.RequestClientCert,
+		ClientAuthRequire:          tls.RequireAnyClientCert,
+		ClientAuthVerifyIfGiven:    tls.VerifyClientCertIfGiven,
+		ClientAuthRequireAndVerify: tls.RequireAndVerifyClientCert,
+	}
+)
+
+// NewConfig creates the server side tls config of the settings. Certificates read from files are reloaded on the
+// next handshake after the files changed.
+func NewConfig(logger log.Logger, settings Settings) (*tls.Config, error) {
+	return NewConfigWithInterfaces(logger, clock.Provider, settings)
+}
+
+func NewConfigWithInterfaces(logger log.Logger, clock clock.Clock, settings Settings) (*tls.Config, error) {
+	var err error
+	var loader *certificateLoader
+	var minVersion uint16
+	var cipherSuites []uint16
+	var clientAuth tls.ClientAuthType
+	var clientCas *x509.CertPool
+
+	if loader, err = newCertificateLoader(logger, clock, settings); err != nil {
+		return nil, err
+	}
+
+	if minVersion, err = parseVersion(settings.MinVersion); err != nil {
+		return nil, err
+	}
+
+	if cipherSuites, err = parseCipherSuites(settings.CipherSuites); err != nil {
+		return nil, err
+	}
+
+	if clientAuth, clientCas, err = parseClientAuth(settings.ClientAuth); err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		GetCertificate: loader.GetCertificate,
+		MinVersion:     minVersion,
+		CipherSuites:   cipherSuites,
+		ClientAuth:     clientAuth,
+		ClientCAs:      clientCas,
+	}, nil
+}
+
+func parseVersion(version string) (uint16, error) {
+	if version == "" {
+		return tls.VersionTLS12, nil
+	}
+
+	if parsed, ok := versions[version]; ok {
+		return parsed, nil
+	}
+
+	return 0, fmt.Errorf("unknown tls version %s", version)
+}
+
+func parseCipherSuites(names []string) ([]uint16, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	known := make(map[string]uint16)
+	for _, suite := range tls.CipherSuites() {
+		known[suite.Name] = suite.ID
+	}
+
+	ids := make([]uint16, 0, len(names))
+	for _, name := range names {
+		id, ok := known[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown or insecure cipher suite %s", name)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func parseClientAuth(settings ClientAuthSettings) (tls.ClientAuthType, *x509.CertPool, error) {
+	mode := settings.Mode
+	if mode == "" {
+		mode = ClientAuthNone
+	}
+
+	clientAuth, ok := clientAuthTypes[mode]
+	if !ok {
+		return tls.NoClientCert, nil, fmt.Errorf("unknown client auth mode %s", mode)
+	}
+
+	ca := []byte(settings.Ca)
+	if settings.CaFile != "" {
+		var err error
+
+		if ca, err = os.ReadFile(settings.CaFile); err != nil {
+			return tls.NoClientCert, nil, fmt.Errorf("can not read client ca file: %w", err)
+		}
+	}
+
+	verify := clientAuth == tls.VerifyClientCertIfGiven || clientAuth == tls.RequireAndVerifyClientCert
+	if len(ca) == 0 {
+		if verify {
+			return tls.NoClientCert, nil, fmt.Errorf("the client auth mode %s requires a ca to verify client certificates", mode)
+		}
+
+		return clientAuth, nil, nil
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return tls.NoClientCert, nil, fmt.Errorf("the client ca does not contain any PEM encoded certificate")
+	}
+
+	return clientAuth, pool, nil
+}
@@ -0,0 +1,165 @@
+package tlsx_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/justtrackio/gosoline/pkg/clock"
+	logMocks "github.com/justtrackio/gosoline/pkg/log/mocks"
+	"github.com/justtrackio/gosoline/pkg/tlsx"
+	"github.com/stretchr/testify/suite"
+)
+
+type ConfigTestSuite struct {
+	suite.Suite
+	clock    clock.FakeClock
+	logger   logMocks.LoggerMock
+	certFile string
+	keyFile  string
+}
+
+func TestConfigTestSuite(t *testing.T) {
+	suite.Run(t, new(ConfigTestSuite))
+}
+
+func (s *ConfigTestSuite) SetupTest() {
+	dir := s.T().TempDir()
+
+	s.clock = clock.NewFakeClock()
+	s.logger = logMocks.NewLoggerMock(logMocks.WithMockAll, logMocks.WithTestingT(s.T()))
+	s.certFile = filepath.Join(dir, "tls.crt")
+	s.keyFile = filepath.Join(dir, "tls.key")
+}
+
+func (s *ConfigTestSuite) TestPemSettings() {
+	certPem, keyPem := s.certificate("pem")
+
+	config, err := tlsx.NewConfigWithInterfaces(s.logger, s.clock, tlsx.Settings{
+		Cert:         string(certPem),
+		Key:          string(keyPem),
+		MinVersion:   "tls1.3",
+		CipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"},
+	})
+	s.NoError(err)
+	s.Equal(uint16(tls.VersionTLS13), config.MinVersion)
+	s.Equal([]uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256}, config.CipherSuites)
+	s.Equal(tls.NoClientCert, config.ClientAuth)
+	s.Equal("pem", s.commonName(config))
+}
+
+func (s *ConfigTestSuite) TestReloadCertificateFiles() {
+	s.writeCertificate("first")
+
+	config, err := tlsx.NewConfigWithInterfaces(s.logger, s.clock, tlsx.Settings{
+		CertFile:       s.certFile,
+		KeyFile:        s.keyFile,
+		ReloadInterval: time.Minute,
+	})
+	s.NoError(err)
+	s.Equal("first", s.commonName(config))
+
+	s.writeCertificate("second")
+	s.Equal("first", s.commonName(config), "the files should not be checked before the reload interval passed")
+
+	s.clock.Advance(time.Minute)
+	s.Equal("second", s.commonName(config))
+
+	s.NoError(os.WriteFile(s.keyFile, []byte("broken"), 0o600))
+	s.clock.Advance(time.Minute)
+	s.Equal("second", s.commonName(config), "the old certificate should be kept if the files are invalid")
+}
+
+func (s *ConfigTestSuite) TestClientAuth() {
+	certPem, keyPem := s.certificate("ca")
+
+	config, err := tlsx.NewConfigWithInterfaces(s.logger, s.clock, tlsx.Settings{
+		Cert: string(certPem),
+		Key:  string(keyPem),
+		ClientAuth: tlsx.ClientAuthSettings{
+			Mode: tlsx.ClientAuthRequireAndVerify,
+			Ca:   string(certPem),
+		},
+	})
+	s.NoError(err)
+	s.Equal(tls.RequireAndVerifyClientCert, config.ClientAuth)
+	s.NotNil(config.ClientCAs)
+
+	_, err = tlsx.NewConfigWithInterfaces(s.logger, s.clock, tlsx.Settings{
+		Cert: string(certPem),
+		Key:  string(keyPem),
+		ClientAuth: tlsx.ClientAuthSettings{
+			Mode: tlsx.ClientAuthRequireAndVerify,
+		},
+	})
+	s.EqualError(err, "the client auth mode require_and_verify requires a ca to verify client certificates")
+}
+
+func (s *ConfigTestSuite) TestInvalidSettings() {
+	_, err := tlsx.NewConfigWithInterfaces(s.logger, s.clock, tlsx.Settings{})
+	s.EqualError(err, "tls requires either a cert and key file or a PEM encoded cert and key")
+
+	_, err = tlsx.NewConfigWithInterfaces(s.logger, s.clock, tlsx.Settings{CertFile: s.certFile})
+	s.EqualError(err, "the cert file and the key file have to be configured together")
+
+	certPem, keyPem := s.certificate("invalid")
+	_, err = tlsx.NewConfigWithInterfaces(s.logger, s.clock, tlsx.Settings{
+		Cert:         string(certPem),
+		Key:          string(keyPem),
+		CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"},
+	})
+	s.EqualError(err, "unknown or insecure cipher suite TLS_RSA_WITH_RC4_128_SHA")
+}
+
+func (s *ConfigTestSuite) commonName(config *tls.Config) string {
+	certificate, err := config.GetCertificate(&tls.ClientHelloInfo{})
+	s.Require().NoError(err)
+
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	s.Require().NoError(err)
+
+	return leaf.Subject.CommonName
+}
+
+func (s *ConfigTestSuite) writeCertificate(commonName string) {
+	certPem, keyPem := s.certificate(commonName)
+
+	s.Require().NoError(os.WriteFile(s.certFile, certPem, 0o600))
+	s.Require().NoError(os.WriteFile(s.keyFile, keyPem, 0o600))
+}
+
+func (s *ConfigTestSuite) certificate(commonName string) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	s.Require().NoError(err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	s.Require().NoError(err)
+
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+
+	return certPem, keyPem
+}
@@ -0,0 +1,41 @@
+package tlsx
+
+import "time"
+
+const (
+	ClientAuthNone             = "none"
+	ClientAuthRequest          = "request"
+	ClientAuthRequire          = "require"
+	ClientAuthVerifyIfGiven    = "verify_if_given"
+	ClientAuthRequireAndVerify = "require_and_verify"
+)
+
+// Settings configure the tls termination of a server. The certificate and key are either read from files, which are
+// reloaded when they change, or given as PEM encoded values, e.g. injected from a secret into the config.
+type Settings struct {
+	Enabled bool `cfg:"enabled" default:"false"`
+	// CertFile and KeyFile are the paths of the PEM encoded certificate (chain) and private key.
+	CertFile string `cfg:"cert_file"`
+	KeyFile  string `cfg:"key_file"`
+	// Cert and Key are the PEM encoded certificate (chain) and private key. They are used if no files are configured.
+	Cert string `cfg:"cert"`
+	Key  string `cfg:"key"`
+	// MinVersion is the minimum accepted tls version.
+	MinVersion string `cfg:"min_version" default:"tls1.2" validate:"oneof=tls1.0 tls1.1 tls1.2 tls1.3"`
+	// CipherSuites restricts the cipher suites of tls 1.2 and below to the given ones, e.g. TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256.
+	// The cipher suites of tls 1.3 are not configurable. An empty list uses the go defaults.
+	CipherSuites []string `cfg:"cipher_suites"`
+	// ReloadInterval is the minimum time between two checks of the certificate files for changes. A value of 0 disables
+	// the reloading.
+	ReloadInterval time.Duration `cfg:"reload_interval" default:"1m" validate:"min=0"`
+	// ClientAuth configures the verification of client certificates for mutual tls.
+	ClientAuth ClientAuthSettings `cfg:"client_auth"`
+}
+
+type ClientAuthSettings struct {
+	// Mode is one of none, request, require, verify_if_given or require_and_verify.
+	Mode string `cfg:"mode" default:"none" validate:"oneof=none request require verify_if_given require_and_verify"`
+	// CaFile and Ca are the PEM encoded certificate authorities client certificates are verified against.
+	CaFile string `cfg:"ca_file"`
+	Ca     string `cfg:"ca"`
+}